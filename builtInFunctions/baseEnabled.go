@@ -8,9 +8,10 @@ import (
 var log = logger.GetOrCreate("vmCommon/builtInFunctions")
 
 type baseEnabled struct {
-	function        string
-	activationEpoch uint32
-	flagActivated   atomic.Flag
+	function          string
+	activationEpoch   uint32
+	deactivationEpoch uint32
+	flagActivated     atomic.Flag
 }
 
 // IsActive returns true if function is activated
@@ -18,9 +19,11 @@ func (b *baseEnabled) IsActive() bool {
 	return b.flagActivated.IsSet()
 }
 
-// EpochConfirmed is called whenever a new epoch is confirmed
+// EpochConfirmed is called whenever a new epoch is confirmed. A zero deactivation epoch means the function is never
+// deactivated
 func (b *baseEnabled) EpochConfirmed(epoch uint32, _ uint64) {
-	b.flagActivated.Toggle(epoch >= b.activationEpoch)
+	isDeactivated := b.deactivationEpoch > 0 && epoch >= b.deactivationEpoch
+	b.flagActivated.Toggle(epoch >= b.activationEpoch && !isDeactivated)
 	log.Debug("built in function", "name: ", b.function, "enabled", b.flagActivated.IsSet())
 }
 
@@ -51,6 +51,7 @@ func (c *changeOwnerAddress) ProcessBuiltinFunction(
 	if len(vmInput.Arguments[0]) != len(vmInput.CallerAddr) {
 		return nil, ErrInvalidAddressLength
 	}
+	traceGas(vmInput, gasComponentFunction, 1, c.gasCost)
 	if vmInput.GasProvided < c.gasCost {
 		return nil, ErrNotEnoughGas
 	}
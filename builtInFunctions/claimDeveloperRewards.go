@@ -54,6 +54,7 @@ func (c *claimDeveloperRewards) ProcessBuiltinFunction(
 	if !bytes.Equal(vmInput.CallerAddr, acntDst.GetOwnerAddress()) {
 		return nil, ErrOperationNotPermitted
 	}
+	traceGas(vmInput, gasComponentFunction, 1, c.gasCost)
 	if vmInput.GasProvided < c.gasCost {
 		return nil, ErrNotEnoughGas
 	}
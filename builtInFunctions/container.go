@@ -8,11 +8,12 @@ import (
 	"github.com/Dharitri-org/me-vm-common/container"
 )
 
-var _ vmcommon.BuiltInFunctionContainer = (*functionContainer)(nil)
+var _ vmcommon.VersionedBuiltInFunctionContainer = (*functionContainer)(nil)
 
 // functionContainer is an interceptors holder organized by type
 type functionContainer struct {
-	objects *container.MutexMap
+	objects       *container.MutexMap
+	epochNotifier vmcommon.EpochNotifier
 }
 
 // NewBuiltInFunctionContainer will create a new instance of a container
@@ -22,7 +23,21 @@ func NewBuiltInFunctionContainer() *functionContainer {
 	}
 }
 
-// Get returns the object stored at a certain key.
+// NewVersionedBuiltInFunctionContainer will create a new instance of a container which can also hold several
+// versions of a function, selected by the current epoch as reported by the epoch notifier
+func NewVersionedBuiltInFunctionContainer(epochNotifier vmcommon.EpochNotifier) (*functionContainer, error) {
+	if check.IfNil(epochNotifier) {
+		return nil, ErrNilEpochHandler
+	}
+
+	return &functionContainer{
+		objects:       container.NewMutexMap(),
+		epochNotifier: epochNotifier,
+	}, nil
+}
+
+// Get returns the object stored at a certain key. For a versioned function, the version valid in the current
+// epoch is returned, or an inactive function if no version is valid in the current epoch.
 // Returns an error if the element does not exist
 func (f *functionContainer) Get(key string) (vmcommon.BuiltinFunction, error) {
 	value, ok := f.objects.Get(key)
@@ -30,12 +45,85 @@ func (f *functionContainer) Get(key string) (vmcommon.BuiltinFunction, error) {
 		return nil, fmt.Errorf("%w in function container for key %v", ErrInvalidContainerKey, key)
 	}
 
-	function, ok := value.(vmcommon.BuiltinFunction)
+	switch function := value.(type) {
+	case *versionedBuiltInFunction:
+		return function.currentVersion(key), nil
+	case vmcommon.BuiltinFunction:
+		return function, nil
+	default:
+		return nil, ErrWrongTypeInContainer
+	}
+}
+
+// GetAllVersions returns all the versions of the function stored at a certain key, regardless of the current epoch.
+// A function which is not versioned is returned as its single version
+func (f *functionContainer) GetAllVersions(key string) ([]vmcommon.BuiltinFunction, error) {
+	value, ok := f.objects.Get(key)
 	if !ok {
+		return nil, fmt.Errorf("%w in function container for key %v", ErrInvalidContainerKey, key)
+	}
+
+	switch function := value.(type) {
+	case *versionedBuiltInFunction:
+		return function.allVersions(), nil
+	case vmcommon.BuiltinFunction:
+		return []vmcommon.BuiltinFunction{function}, nil
+	default:
 		return nil, ErrWrongTypeInContainer
 	}
+}
 
-	return function, nil
+// AddVersion will add a version of the function at a given key, valid from the activation epoch until, excluding,
+// the deactivation epoch. A zero deactivation epoch means the version is never deactivated. Returns an error if the
+// epochs overlap the ones of another version or if the key holds a function which is not versioned
+func (f *functionContainer) AddVersion(key string, function vmcommon.BuiltinFunction, activationEpoch uint32, deactivationEpoch uint32) error {
+	if check.IfNil(function) {
+		return ErrNilContainerElement
+	}
+	if len(key) == 0 {
+		return ErrEmptyFunctionName
+	}
+	if check.IfNil(f.epochNotifier) {
+		return ErrNilEpochHandler
+	}
+
+	value, ok := f.objects.Get(key)
+	if !ok {
+		versioned := newVersionedBuiltInFunction(f.epochNotifier)
+		err := versioned.addVersion(function, activationEpoch, deactivationEpoch)
+		if err != nil {
+			return err
+		}
+
+		f.objects.Set(key, versioned)
+		return nil
+	}
+
+	versioned, ok := value.(*versionedBuiltInFunction)
+	if !ok {
+		return ErrContainerKeyAlreadyExists
+	}
+
+	return versioned.addVersion(function, activationEpoch, deactivationEpoch)
+}
+
+// wrap replaces the function stored at a certain key, or all its versions, with the wrapped ones
+func (f *functionContainer) wrap(key string, wrapFunc func(function vmcommon.BuiltinFunction) vmcommon.BuiltinFunction) error {
+	value, ok := f.objects.Get(key)
+	if !ok {
+		return fmt.Errorf("%w in function container for key %v", ErrInvalidContainerKey, key)
+	}
+
+	switch function := value.(type) {
+	case *versionedBuiltInFunction:
+		function.wrapVersions(wrapFunc)
+		return nil
+	case vmcommon.BuiltinFunction:
+		f.objects.Set(key, wrapFunc(function))
+		return nil
+	default:
+		return ErrWrongTypeInContainer
+	}
 }
 
 // Add will add an object at a given key. Returns
@@ -56,7 +144,8 @@ func (f *functionContainer) Add(key string, function vmcommon.BuiltinFunction) e
 	return nil
 }
 
-// Replace will add (or replace if it already exists) an object at a given key
+// Replace will add (or replace if it already exists) an object at a given key. All the versions of a versioned
+// function are replaced
 func (f *functionContainer) Replace(key string, function vmcommon.BuiltinFunction) error {
 	if check.IfNil(function) {
 		return ErrNilContainerElement
@@ -0,0 +1,134 @@
+package builtInFunctions
+
+import (
+	"fmt"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+)
+
+// BuiltInFunctionDependencies holds the components shared by all the built in functions, which are handed to the
+// constructors of the custom built in functions
+type BuiltInFunctionDependencies struct {
+	Marshalizer           vmcommon.Marshalizer
+	Accounts              vmcommon.AccountsAdapter
+	GlobalSettingsHandler vmcommon.DCTGlobalSettingsHandler
+	RolesHandler          vmcommon.DCTRoleHandler
+	ShardCoordinator      vmcommon.Coordinator
+	EpochNotifier         vmcommon.EpochNotifier
+	GasConfig             *vmcommon.GasCost
+}
+
+// BuiltInFunctionConstructor creates a custom built in function out of the shared dependencies
+type BuiltInFunctionConstructor func(dependencies BuiltInFunctionDependencies) (vmcommon.BuiltinFunction, error)
+
+// CustomBuiltInFunction defines a built in function which is not part of this package, but is created and added to
+// the container by the factory. A non-zero activation epoch keeps the function inactive until that epoch.
+// Several entries with the same name, or an entry with a non-zero deactivation epoch, are added as versions of the
+// function, the container selecting the one valid in the current epoch
+type CustomBuiltInFunction struct {
+	Name              string
+	Constructor       BuiltInFunctionConstructor
+	ActivationEpoch   uint32
+	DeactivationEpoch uint32
+}
+
+func checkCustomBuiltInFunctions(customBuiltInFunctions []CustomBuiltInFunction) error {
+	for _, customBuiltInFunction := range customBuiltInFunctions {
+		if len(customBuiltInFunction.Name) == 0 {
+			return ErrEmptyFunctionName
+		}
+		if customBuiltInFunction.Constructor == nil {
+			return ErrNilBuiltInFunctionConstructor
+		}
+		if customBuiltInFunction.DeactivationEpoch > 0 && customBuiltInFunction.DeactivationEpoch <= customBuiltInFunction.ActivationEpoch {
+			return fmt.Errorf("%w for %s", ErrInvalidEnableEpochs, customBuiltInFunction.Name)
+		}
+	}
+
+	return nil
+}
+
+// epochGatedBuiltInFunction keeps a built in function inactive outside of its activation and deactivation epochs
+type epochGatedBuiltInFunction struct {
+	vmcommon.BuiltinFunction
+	*baseEnabled
+}
+
+func newEpochGatedBuiltInFunction(
+	name string,
+	builtInFunc vmcommon.BuiltinFunction,
+	enableEpochs BuiltInFunctionEnableEpochs,
+	epochNotifier vmcommon.EpochNotifier,
+) *epochGatedBuiltInFunction {
+	e := &epochGatedBuiltInFunction{
+		BuiltinFunction: builtInFunc,
+		baseEnabled: &baseEnabled{
+			function:          name,
+			activationEpoch:   enableEpochs.ActivationEpoch,
+			deactivationEpoch: enableEpochs.DeactivationEpoch,
+		},
+	}
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e
+}
+
+// IsActive returns true if the function is enabled in the current epoch and the wrapped function is active
+func (e *epochGatedBuiltInFunction) IsActive() bool {
+	return e.baseEnabled.IsActive() && e.BuiltinFunction.IsActive()
+}
+
+// SetPayableHandler forwards the payable handler if the wrapped function accepts one
+func (e *epochGatedBuiltInFunction) SetPayableHandler(payableHandler vmcommon.PayableHandler) error {
+	acceptPayableHandler, ok := e.BuiltinFunction.(vmcommon.AcceptPayableHandler)
+	if !ok {
+		return nil
+	}
+
+	return acceptPayableHandler.SetPayableHandler(payableHandler)
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (e *epochGatedBuiltInFunction) IsInterfaceNil() bool {
+	return e == nil
+}
+
+func (b *builtInFuncFactory) addCustomBuiltInFunctions(dependencies BuiltInFunctionDependencies) error {
+	numEntriesPerName := make(map[string]int)
+	for _, customBuiltInFunction := range b.customBuiltInFunctions {
+		numEntriesPerName[customBuiltInFunction.Name]++
+	}
+
+	for _, customBuiltInFunction := range b.customBuiltInFunctions {
+		newFunc, err := customBuiltInFunction.Constructor(dependencies)
+		if err != nil {
+			return err
+		}
+		isVersioned := numEntriesPerName[customBuiltInFunction.Name] > 1 || customBuiltInFunction.DeactivationEpoch > 0
+		if isVersioned {
+			err = b.builtInFunctions.AddVersion(
+				customBuiltInFunction.Name,
+				newFunc,
+				customBuiltInFunction.ActivationEpoch,
+				customBuiltInFunction.DeactivationEpoch,
+			)
+			if err != nil {
+				return fmt.Errorf("%w for %s", err, customBuiltInFunction.Name)
+			}
+
+			continue
+		}
+
+		if customBuiltInFunction.ActivationEpoch > 0 && newFunc != nil {
+			enableEpochs := BuiltInFunctionEnableEpochs{ActivationEpoch: customBuiltInFunction.ActivationEpoch}
+			newFunc = newEpochGatedBuiltInFunction(customBuiltInFunction.Name, newFunc, enableEpochs, b.epochNotifier)
+		}
+
+		err = b.builtInFunctions.Add(customBuiltInFunction.Name, newFunc)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"testing"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type payableBuiltInFunctionStub struct {
+	mock.BuiltInFunctionStub
+	payableHandler vmcommon.PayableHandler
+}
+
+func (p *payableBuiltInFunctionStub) SetPayableHandler(payableHandler vmcommon.PayableHandler) error {
+	p.payableHandler = payableHandler
+	return nil
+}
+
+func createFactoryArgs(customBuiltInFunctions []CustomBuiltInFunction, epochNotifier vmcommon.EpochNotifier) ArgsCreateBuiltInFunctionContainer {
+	return ArgsCreateBuiltInFunctionContainer{
+		GasMap:                 createGasScheduleMap(1),
+		MapDNSAddresses:        make(map[string]struct{}),
+		Marshalizer:            &mock.MarshalizerMock{},
+		Accounts:               &mock.AccountsStub{},
+		ShardCoordinator:       mock.NewMultiShardsCoordinatorMock(2),
+		EpochNotifier:          epochNotifier,
+		CustomBuiltInFunctions: customBuiltInFunctions,
+	}
+}
+
+func TestNewBuiltInFunctionsFactory_InvalidCustomBuiltInFunctions(t *testing.T) {
+	t.Parallel()
+
+	constructor := func(_ BuiltInFunctionDependencies) (vmcommon.BuiltinFunction, error) {
+		return &mock.BuiltInFunctionStub{}, nil
+	}
+
+	_, err := NewBuiltInFunctionsFactory(createFactoryArgs([]CustomBuiltInFunction{{Constructor: constructor}}, &mock.EpochNotifierStub{}))
+	assert.Equal(t, ErrEmptyFunctionName, err)
+
+	_, err = NewBuiltInFunctionsFactory(createFactoryArgs([]CustomBuiltInFunction{{Name: "custom"}}, &mock.EpochNotifierStub{}))
+	assert.Equal(t, ErrNilBuiltInFunctionConstructor, err)
+}
+
+func TestBuiltInFuncFactory_CreateBuiltInFunctionContainerWithCustomBuiltInFunctions(t *testing.T) {
+	t.Parallel()
+
+	var receivedDependencies BuiltInFunctionDependencies
+	alwaysActive := &payableBuiltInFunctionStub{}
+	gated := &mock.BuiltInFunctionStub{}
+	customBuiltInFunctions := []CustomBuiltInFunction{
+		{
+			Name: "CustomAlwaysActive",
+			Constructor: func(dependencies BuiltInFunctionDependencies) (vmcommon.BuiltinFunction, error) {
+				receivedDependencies = dependencies
+				return alwaysActive, nil
+			},
+		},
+		{
+			Name: "CustomGated",
+			Constructor: func(_ BuiltInFunctionDependencies) (vmcommon.BuiltinFunction, error) {
+				return gated, nil
+			},
+			ActivationEpoch: 5,
+		},
+	}
+
+	var epochHandlers []vmcommon.EpochSubscriberHandler
+	epochNotifier := &mock.EpochNotifierStub{
+		RegisterNotifyHandlerCalled: func(handler vmcommon.EpochSubscriberHandler) {
+			epochHandlers = append(epochHandlers, handler)
+			handler.EpochConfirmed(0, 0)
+		},
+	}
+	factory, err := NewBuiltInFunctionsFactory(createFactoryArgs(customBuiltInFunctions, epochNotifier))
+	require.Nil(t, err)
+
+	container, err := factory.CreateBuiltInFunctionContainer()
+	require.Nil(t, err)
+	require.NotNil(t, receivedDependencies.GlobalSettingsHandler)
+	require.NotNil(t, receivedDependencies.RolesHandler)
+	assert.Equal(t, uint64(1), receivedDependencies.GasConfig.BuiltInCost.DCTTransfer)
+
+	builtInFunc, err := container.Get("CustomAlwaysActive")
+	require.Nil(t, err)
+	assert.True(t, builtInFunc.(*interceptedBuiltInFunction).BuiltinFunction == alwaysActive)
+
+	builtInFunc, err = container.Get("CustomGated")
+	require.Nil(t, err)
+	assert.False(t, builtInFunc.IsActive())
+	for _, handler := range epochHandlers {
+		handler.EpochConfirmed(5, 0)
+	}
+	assert.True(t, builtInFunc.IsActive())
+
+	payableHandler := &mock.PayableHandlerStub{}
+	err = SetPayableHandler(container, payableHandler)
+	require.Nil(t, err)
+	assert.True(t, alwaysActive.payableHandler == payableHandler)
+}
+
+func TestBuiltInFuncFactory_CreateBuiltInFunctionContainerCustomErrors(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	customBuiltInFunctions := []CustomBuiltInFunction{{
+		Name: "Custom",
+		Constructor: func(_ BuiltInFunctionDependencies) (vmcommon.BuiltinFunction, error) {
+			return nil, expectedErr
+		},
+	}}
+	factory, _ := NewBuiltInFunctionsFactory(createFactoryArgs(customBuiltInFunctions, &mock.EpochNotifierStub{}))
+	_, err := factory.CreateBuiltInFunctionContainer()
+	assert.Equal(t, expectedErr, err)
+
+	customBuiltInFunctions = []CustomBuiltInFunction{{
+		Name: vmcommon.BuiltInFunctionDCTTransfer,
+		Constructor: func(_ BuiltInFunctionDependencies) (vmcommon.BuiltinFunction, error) {
+			return &mock.BuiltInFunctionStub{}, nil
+		},
+	}}
+	factory, _ = NewBuiltInFunctionsFactory(createFactoryArgs(customBuiltInFunctions, &mock.EpochNotifierStub{}))
+	_, err = factory.CreateBuiltInFunctionContainer()
+	assert.Equal(t, ErrContainerKeyAlreadyExists, err)
+}
@@ -0,0 +1,125 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/atomic"
+	"github.com/Dharitri-org/me-vm-common/check"
+)
+
+type dctAddMintAllowance struct {
+	*baseEnabled
+	accounts vmcommon.AccountsAdapter
+}
+
+// NewDCTAddMintAllowanceFunc returns the dct add mint allowance built-in function component. The dct system smart
+// contract grants every shard a part of the max supply of a token, the mints done in the shard consuming it
+func NewDCTAddMintAllowanceFunc(
+	accounts vmcommon.AccountsAdapter,
+	activationEpoch uint32,
+	epochNotifier vmcommon.EpochNotifier,
+) (*dctAddMintAllowance, error) {
+	if check.IfNil(accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, ErrNilEpochHandler
+	}
+
+	e := &dctAddMintAllowance{
+		accounts: accounts,
+	}
+
+	e.baseEnabled = &baseEnabled{
+		function:        vmcommon.BuiltInFunctionDCTAddMintAllowance,
+		activationEpoch: activationEpoch,
+		flagActivated:   atomic.Flag{},
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *dctAddMintAllowance) SetNewGasConfig(_ *vmcommon.GasCost) {
+}
+
+// ProcessBuiltinFunction resolves DCT add mint allowance function call
+// Requires 2 arguments:
+// arg0 - token identifier
+// arg1 - mint allowance added to the shard, a positive value
+func (e *dctAddMintAllowance) ProcessBuiltinFunction(
+	_, _ vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if vmInput == nil {
+		return nil, ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) != 2 {
+		return nil, ErrInvalidArguments
+	}
+	if len(vmInput.Arguments[1]) > vmcommon.MaxLenForDCTIssueMint {
+		return nil, ErrInvalidArguments
+	}
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	if value.Sign() <= 0 {
+		return nil, ErrNegativeValue
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmcommon.DCTSCAddress) {
+		return nil, ErrAddressIsNotDCTSystemSC
+	}
+	if !vmcommon.IsSystemAccountAddress(vmInput.RecipientAddr) {
+		return nil, ErrOnlySystemAccountAccepted
+	}
+
+	systemSCAccount, err := e.getSystemAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	mintAllowanceKey := getMintAllowanceKey(vmInput.Arguments[0])
+	mintAllowanceBytes, err := systemSCAccount.AccountDataHandler().RetrieveValue(mintAllowanceKey)
+	if err != nil {
+		return nil, err
+	}
+
+	mintAllowance := big.NewInt(0).SetBytes(mintAllowanceBytes)
+	mintAllowance.Add(mintAllowance, value)
+	err = systemSCAccount.AccountDataHandler().SaveKeyValue(mintAllowanceKey, mintAllowance.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.accounts.SaveAccount(systemSCAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
+	return vmOutput, nil
+}
+
+func (e *dctAddMintAllowance) getSystemAccount() (vmcommon.UserAccountHandler, error) {
+	systemSCAccount, err := e.accounts.LoadAccount(vmcommon.SystemAccountAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	userAcc, ok := systemSCAccount.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	return userAcc, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *dctAddMintAllowance) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,105 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDCTAddMintAllowanceFunc(t *testing.T) {
+	t.Parallel()
+
+	addMintAllowanceFunc, err := NewDCTAddMintAllowanceFunc(nil, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(addMintAllowanceFunc))
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+
+	addMintAllowanceFunc, err = NewDCTAddMintAllowanceFunc(&mock.AccountsStub{}, 0, nil)
+	assert.True(t, check.IfNil(addMintAllowanceFunc))
+	assert.Equal(t, ErrNilEpochHandler, err)
+
+	addMintAllowanceFunc, err = NewDCTAddMintAllowanceFunc(&mock.AccountsStub{}, 1, &mock.EpochNotifierStub{})
+	assert.False(t, check.IfNil(addMintAllowanceFunc))
+	assert.Nil(t, err)
+	assert.False(t, addMintAllowanceFunc.IsActive())
+
+	addMintAllowanceFunc.EpochConfirmed(1, 0)
+	assert.True(t, addMintAllowanceFunc.IsActive())
+}
+
+func TestDCTAddMintAllowance_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	addMintAllowanceFunc, _ := NewDCTAddMintAllowanceFunc(&mock.AccountsStub{}, 0, &mock.EpochNotifierStub{})
+	_, err := addMintAllowanceFunc.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, ErrNilVmInput, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue: big.NewInt(1),
+		},
+	}
+	_, err = addMintAllowanceFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrBuiltInFunctionCalledWithValue, err)
+
+	input.CallValue = big.NewInt(0)
+	input.Arguments = [][]byte{[]byte("token")}
+	_, err = addMintAllowanceFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{[]byte("token"), make([]byte, vmcommon.MaxLenForDCTIssueMint+1)}
+	_, err = addMintAllowanceFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{[]byte("token"), {0}}
+	_, err = addMintAllowanceFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrNegativeValue, err)
+
+	input.Arguments = [][]byte{[]byte("token"), big.NewInt(100).Bytes()}
+	_, err = addMintAllowanceFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrAddressIsNotDCTSystemSC, err)
+
+	input.CallerAddr = vmcommon.DCTSCAddress
+	_, err = addMintAllowanceFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrOnlySystemAccountAccepted, err)
+}
+
+func TestDCTAddMintAllowance_ProcessBuiltInFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	supplyHandler, systemAccount := createSupplyHandlerWithSystemAccount()
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return systemAccount, nil
+		},
+	}
+	addMintAllowanceFunc, _ := NewDCTAddMintAllowanceFunc(accounts, 0, &mock.EpochNotifierStub{})
+
+	token := []byte("token")
+	_ = systemAccount.AccountDataHandler().SaveKeyValue(getMaxSupplyKey(token), big.NewInt(1000).Bytes())
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: vmcommon.DCTSCAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  [][]byte{token, big.NewInt(100).Bytes()},
+		},
+		RecipientAddr: vmcommon.SystemAccountAddress,
+	}
+	vmOutput, err := addMintAllowanceFunc.ProcessBuiltinFunction(nil, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+
+	input.Arguments[1] = big.NewInt(50).Bytes()
+	_, err = addMintAllowanceFunc.ProcessBuiltinFunction(nil, nil, input)
+	require.Nil(t, err)
+
+	mintAllowance, _ := systemAccount.AccountDataHandler().RetrieveValue(getMintAllowanceKey(token))
+	assert.Equal(t, big.NewInt(150).Bytes(), mintAllowance)
+	assert.Equal(t, ErrMaxSupplyExceeded, supplyHandler.UseMintAllowance(token, big.NewInt(151)))
+	assert.Nil(t, supplyHandler.UseMintAllowance(token, big.NewInt(150)))
+}
@@ -0,0 +1,130 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+	"sync"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/atomic"
+	"github.com/Dharitri-org/me-vm-common/check"
+)
+
+var allowanceKeyPrefix = []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTAllowanceIdentifier + vmcommon.DCTKeyIdentifier)
+
+type dctApprove struct {
+	*baseEnabled
+	funcGasCost  uint64
+	mutExecution sync.RWMutex
+}
+
+// NewDCTApproveFunc returns the dct approve built-in function component
+func NewDCTApproveFunc(
+	funcGasCost uint64,
+	activationEpoch uint32,
+	epochNotifier vmcommon.EpochNotifier,
+) (*dctApprove, error) {
+	if check.IfNil(epochNotifier) {
+		return nil, ErrNilEpochHandler
+	}
+
+	e := &dctApprove{
+		funcGasCost:  funcGasCost,
+		mutExecution: sync.RWMutex{},
+	}
+
+	e.baseEnabled = &baseEnabled{
+		function:        vmcommon.BuiltInFunctionDCTApprove,
+		activationEpoch: activationEpoch,
+		flagActivated:   atomic.Flag{},
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *dctApprove) SetNewGasConfig(gasCost *vmcommon.GasCost) {
+	if gasCost == nil {
+		return
+	}
+
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.DCTApprove
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves DCT approve function call. The allowance replaces the previous one and is saved
+// in the account of the owner
+// Requires 3 arguments:
+// arg0 - token identifier
+// arg1 - spender address
+// arg2 - amount the spender is allowed to transfer, 0 removes the allowance
+func (e *dctApprove) ProcessBuiltinFunction(
+	acntSnd, _ vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	err := checkDCTNFTCreateBurnAddInput(acntSnd, vmInput, e.funcGasCost)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) != 3 {
+		return nil, ErrInvalidArguments
+	}
+	if len(vmInput.Arguments[2]) > vmcommon.MaxLenForDCTIssueMint {
+		return nil, ErrInvalidArguments
+	}
+
+	tokenID := vmInput.Arguments[0]
+	spender := vmInput.Arguments[1]
+	if len(spender) != len(vmInput.CallerAddr) {
+		return nil, ErrInvalidArguments
+	}
+	if bytes.Equal(spender, vmInput.CallerAddr) {
+		return nil, ErrInvalidArguments
+	}
+
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	err = saveAllowance(acntSnd, tokenID, spender, value)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: vmInput.GasProvided - e.funcGasCost}
+	addDCTEntryInVMOutput(vmOutput, []byte(vmcommon.BuiltInFunctionDCTApprove), tokenID, value, vmInput.CallerAddr, spender)
+
+	return vmOutput, nil
+}
+
+func getAllowance(acnt vmcommon.UserAccountHandler, tokenID []byte, spender []byte) (*big.Int, error) {
+	allowanceData, err := acnt.AccountDataHandler().RetrieveValue(getAllowanceKey(tokenID, spender))
+	if err != nil {
+		return nil, err
+	}
+
+	return big.NewInt(0).SetBytes(allowanceData), nil
+}
+
+func saveAllowance(acnt vmcommon.UserAccountHandler, tokenID []byte, spender []byte, value *big.Int) error {
+	if value.Cmp(zero) <= 0 {
+		return acnt.AccountDataHandler().SaveKeyValue(getAllowanceKey(tokenID, spender), nil)
+	}
+
+	return acnt.AccountDataHandler().SaveKeyValue(getAllowanceKey(tokenID, spender), value.Bytes())
+}
+
+func getAllowanceKey(tokenID []byte, spender []byte) []byte {
+	allowanceKey := make([]byte, 0, len(allowanceKeyPrefix)+len(tokenID)+len(spender))
+	allowanceKey = append(allowanceKey, allowanceKeyPrefix...)
+	allowanceKey = append(allowanceKey, tokenID...)
+	return append(allowanceKey, spender...)
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *dctApprove) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,114 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createApproveInput(owner []byte, spender []byte, value *big.Int) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  owner,
+			CallValue:   big.NewInt(0),
+			GasProvided: 100,
+			Arguments:   [][]byte{[]byte("TKN"), spender, value.Bytes()},
+		},
+		RecipientAddr: owner,
+	}
+}
+
+func TestNewDCTApproveFunc(t *testing.T) {
+	t.Parallel()
+
+	e, err := NewDCTApproveFunc(10, 0, nil)
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilEpochHandler, err)
+
+	e, err = NewDCTApproveFunc(10, 1, &mock.EpochNotifierStub{})
+	assert.False(t, check.IfNil(e))
+	assert.Nil(t, err)
+	assert.False(t, e.IsActive())
+
+	e.EpochConfirmed(1, 0)
+	assert.True(t, e.IsActive())
+}
+
+func TestDCTApprove_SetNewGasConfig(t *testing.T) {
+	t.Parallel()
+
+	e, _ := NewDCTApproveFunc(10, 0, &mock.EpochNotifierStub{})
+	e.SetNewGasConfig(nil)
+	assert.Equal(t, uint64(10), e.funcGasCost)
+
+	e.SetNewGasConfig(&vmcommon.GasCost{BuiltInCost: vmcommon.BuiltInCost{DCTApprove: 37}})
+	assert.Equal(t, uint64(37), e.funcGasCost)
+}
+
+func TestDCTApprove_ProcessBuiltinFunctionInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	e, _ := NewDCTApproveFunc(10, 0, &mock.EpochNotifierStub{})
+	owner := mock.NewUserAccount([]byte("owner"))
+
+	output, err := e.ProcessBuiltinFunction(owner, nil, nil)
+	assert.Nil(t, output)
+	assert.Equal(t, ErrNilVmInput, err)
+
+	input := createApproveInput(owner.AddressBytes(), []byte("spndr"), big.NewInt(10))
+	input.Arguments = input.Arguments[:2]
+	output, err = e.ProcessBuiltinFunction(owner, nil, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, ErrInvalidArguments)
+
+	input = createApproveInput(owner.AddressBytes(), []byte("spender"), big.NewInt(10))
+	output, err = e.ProcessBuiltinFunction(owner, nil, input)
+	assert.Nil(t, output)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	input = createApproveInput(owner.AddressBytes(), owner.AddressBytes(), big.NewInt(10))
+	output, err = e.ProcessBuiltinFunction(owner, nil, input)
+	assert.Nil(t, output)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	input = createApproveInput(owner.AddressBytes(), []byte("spndr"), big.NewInt(10))
+	input.GasProvided = 1
+	output, err = e.ProcessBuiltinFunction(owner, nil, input)
+	assert.Nil(t, output)
+	assert.Equal(t, ErrNotEnoughGas, err)
+}
+
+func TestDCTApprove_ProcessBuiltinFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	e, _ := NewDCTApproveFunc(10, 0, &mock.EpochNotifierStub{})
+	owner := mock.NewUserAccount([]byte("owner"))
+	spender := []byte("spndr")
+
+	output, err := e.ProcessBuiltinFunction(owner, nil, createApproveInput(owner.AddressBytes(), spender, big.NewInt(50)))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(90), output.GasRemaining)
+	require.Equal(t, 1, len(output.Logs))
+	assert.Equal(t, []byte(vmcommon.BuiltInFunctionDCTApprove), output.Logs[0].Identifier)
+	assert.Equal(t, owner.AddressBytes(), output.Logs[0].Address)
+	assert.Equal(t, spender, output.Logs[0].Topics[2])
+
+	allowance, err := getAllowance(owner, []byte("TKN"), spender)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(50), allowance)
+
+	_, err = e.ProcessBuiltinFunction(owner, nil, createApproveInput(owner.AddressBytes(), spender, big.NewInt(20)))
+	require.Nil(t, err)
+	allowance, _ = getAllowance(owner, []byte("TKN"), spender)
+	assert.Equal(t, big.NewInt(20), allowance)
+
+	_, err = e.ProcessBuiltinFunction(owner, nil, createApproveInput(owner.AddressBytes(), spender, big.NewInt(0)))
+	require.Nil(t, err)
+	allowance, _ = getAllowance(owner, []byte("TKN"), spender)
+	assert.Equal(t, 0, allowance.Sign())
+}
@@ -11,11 +11,13 @@ import (
 
 type dctBurn struct {
 	baseAlwaysActive
-	funcGasCost  uint64
-	marshalizer  vmcommon.Marshalizer
-	keyPrefix    []byte
-	pauseHandler vmcommon.DCTPauseHandler
-	mutExecution sync.RWMutex
+	funcGasCost          uint64
+	marshalizer          vmcommon.Marshalizer
+	keyPrefix            []byte
+	pauseHandler         vmcommon.DCTPauseHandler
+	supplyHandler        vmcommon.DCTSupplyHandler
+	lockedBalanceHandler vmcommon.DCTLockedBalanceHandler
+	mutExecution         sync.RWMutex
 }
 
 // NewDCTBurnFunc returns the dct burn built-in function component
@@ -23,6 +25,8 @@ func NewDCTBurnFunc(
 	funcGasCost uint64,
 	marshalizer vmcommon.Marshalizer,
 	pauseHandler vmcommon.DCTPauseHandler,
+	supplyHandler vmcommon.DCTSupplyHandler,
+	lockedBalanceHandler vmcommon.DCTLockedBalanceHandler,
 ) (*dctBurn, error) {
 	if check.IfNil(marshalizer) {
 		return nil, ErrNilMarshalizer
@@ -30,12 +34,20 @@ func NewDCTBurnFunc(
 	if check.IfNil(pauseHandler) {
 		return nil, ErrNilPauseHandler
 	}
+	if check.IfNil(supplyHandler) {
+		return nil, ErrNilSupplyHandler
+	}
+	if check.IfNil(lockedBalanceHandler) {
+		return nil, ErrNilLockedBalanceHandler
+	}
 
 	e := &dctBurn{
-		funcGasCost:  funcGasCost,
-		marshalizer:  marshalizer,
-		keyPrefix:    []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
-		pauseHandler: pauseHandler,
+		funcGasCost:          funcGasCost,
+		marshalizer:          marshalizer,
+		keyPrefix:            []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
+		pauseHandler:         pauseHandler,
+		supplyHandler:        supplyHandler,
+		lockedBalanceHandler: lockedBalanceHandler,
 	}
 
 	return e, nil
@@ -80,11 +92,17 @@ func (e *dctBurn) ProcessBuiltinFunction(
 
 	dctTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
 
+	traceGas(vmInput, gasComponentFunction, 1, e.funcGasCost)
 	if vmInput.GasProvided < e.funcGasCost {
 		return nil, ErrNotEnoughGas
 	}
 
-	err = addToDCTBalance(acntSnd, dctTokenKey, big.NewInt(0).Neg(value), e.marshalizer, e.pauseHandler, vmInput.ReturnCallAfterError)
+	err = addToDCTBalance(acntSnd, dctTokenKey, big.NewInt(0).Neg(value), e.marshalizer, e.pauseHandler, e.lockedBalanceHandler, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.supplyHandler.UpdateSupply(vmInput.Arguments[0], 0, big.NewInt(0).Neg(value))
 	if err != nil {
 		return nil, err
 	}
@@ -14,7 +14,7 @@ func TestDCTBurn_ProcessBuiltInFunctionErrors(t *testing.T) {
 	t.Parallel()
 
 	pauseHandler := &mock.PauseHandlerStub{}
-	burnFunc, _ := NewDCTBurnFunc(10, &mock.MarshalizerMock{}, pauseHandler)
+	burnFunc, _ := NewDCTBurnFunc(10, &mock.MarshalizerMock{}, pauseHandler, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{})
 	_, err := burnFunc.ProcessBuiltinFunction(nil, nil, nil)
 	assert.Equal(t, err, ErrNilVmInput)
 
@@ -60,7 +60,7 @@ func TestDCTBurn_ProcessBuiltInFunctionSenderBurns(t *testing.T) {
 
 	marshalizer := &mock.MarshalizerMock{}
 	pauseHandler := &mock.PauseHandlerStub{}
-	burnFunc, _ := NewDCTBurnFunc(10, marshalizer, pauseHandler)
+	burnFunc, _ := NewDCTBurnFunc(10, marshalizer, pauseHandler, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{})
 
 	input := &vmcommon.ContractCallInput{
 		VMInput: vmcommon.VMInput{
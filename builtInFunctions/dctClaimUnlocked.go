@@ -0,0 +1,130 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"sync"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/atomic"
+	"github.com/Dharitri-org/me-vm-common/check"
+)
+
+type dctClaimUnlocked struct {
+	*baseEnabled
+	funcGasCost          uint64
+	marshalizer          vmcommon.Marshalizer
+	keyPrefix            []byte
+	lockedBalanceHandler vmcommon.DCTLockedBalanceHandler
+	mutExecution         sync.RWMutex
+}
+
+// NewDCTClaimUnlockedFunc returns the dct claim unlocked built-in function component
+func NewDCTClaimUnlockedFunc(
+	funcGasCost uint64,
+	marshalizer vmcommon.Marshalizer,
+	lockedBalanceHandler vmcommon.DCTLockedBalanceHandler,
+	activationEpoch uint32,
+	epochNotifier vmcommon.EpochNotifier,
+) (*dctClaimUnlocked, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(lockedBalanceHandler) {
+		return nil, ErrNilLockedBalanceHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, ErrNilEpochHandler
+	}
+
+	e := &dctClaimUnlocked{
+		funcGasCost:          funcGasCost,
+		marshalizer:          marshalizer,
+		keyPrefix:            []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
+		lockedBalanceHandler: lockedBalanceHandler,
+		mutExecution:         sync.RWMutex{},
+	}
+
+	e.baseEnabled = &baseEnabled{
+		function:        vmcommon.BuiltInFunctionDCTClaimUnlocked,
+		activationEpoch: activationEpoch,
+		flagActivated:   atomic.Flag{},
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *dctClaimUnlocked) SetNewGasConfig(gasCost *vmcommon.GasCost) {
+	if gasCost == nil {
+		return
+	}
+
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.DCTClaimUnlocked
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves DCT claim unlocked function call. The unlocked amounts are already spendable, the
+// call only removes them from the locked amounts kept in the token data
+// Requires 1 argument:
+// arg0 - token identifier
+func (e *dctClaimUnlocked) ProcessBuiltinFunction(
+	acntSnd, _ vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return nil, ErrNilVmInput
+	}
+	if vmInput.CallValue == nil {
+		return nil, ErrNilValue
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) != 1 {
+		return nil, ErrInvalidArguments
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return nil, ErrInvalidRcvAddr
+	}
+	if check.IfNil(acntSnd) {
+		return nil, ErrNilUserAccount
+	}
+	traceGas(vmInput, gasComponentFunction, 1, e.funcGasCost)
+	if vmInput.GasProvided < e.funcGasCost {
+		return nil, ErrNotEnoughGas
+	}
+
+	tokenID := vmInput.Arguments[0]
+	dctTokenKey := append(e.keyPrefix, tokenID...)
+	dctData, err := getDCTDataFromKey(acntSnd, dctTokenKey, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	unlocked, err := e.lockedBalanceHandler.ClaimUnlocked(dctData)
+	if err != nil {
+		return nil, err
+	}
+	if unlocked.Cmp(zero) > 0 {
+		err = saveDCTData(acntSnd, dctData, dctTokenKey, e.marshalizer)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: vmInput.GasProvided - e.funcGasCost}
+	addDCTEntryInVMOutput(vmOutput, []byte(vmcommon.BuiltInFunctionDCTClaimUnlocked), tokenID, unlocked, vmInput.CallerAddr)
+
+	return vmOutput, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *dctClaimUnlocked) IsInterfaceNil() bool {
+	return e == nil
+}
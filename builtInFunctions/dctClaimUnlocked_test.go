@@ -0,0 +1,113 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createClaimUnlockedInput(caller []byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			CallValue:   big.NewInt(0),
+			GasProvided: 100,
+			Arguments:   [][]byte{[]byte("TKN")},
+		},
+		RecipientAddr: caller,
+	}
+}
+
+func TestNewDCTClaimUnlockedFunc(t *testing.T) {
+	t.Parallel()
+
+	e, err := NewDCTClaimUnlockedFunc(10, nil, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	e, err = NewDCTClaimUnlockedFunc(10, &mock.MarshalizerMock{}, nil, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilLockedBalanceHandler, err)
+
+	e, err = NewDCTClaimUnlockedFunc(10, &mock.MarshalizerMock{}, &mock.LockedBalanceHandlerStub{}, 0, nil)
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilEpochHandler, err)
+
+	e, err = NewDCTClaimUnlockedFunc(10, &mock.MarshalizerMock{}, &mock.LockedBalanceHandlerStub{}, 1, &mock.EpochNotifierStub{})
+	assert.False(t, check.IfNil(e))
+	assert.Nil(t, err)
+	assert.False(t, e.IsActive())
+}
+
+func TestDCTClaimUnlocked_ProcessBuiltinFunctionInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	e, _ := NewDCTClaimUnlockedFunc(10, &mock.MarshalizerMock{}, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	acnt := mock.NewUserAccount([]byte("addr"))
+
+	output, err := e.ProcessBuiltinFunction(acnt, nil, nil)
+	assert.Nil(t, output)
+	assert.Equal(t, ErrNilVmInput, err)
+
+	input := createClaimUnlockedInput(acnt.AddressBytes())
+	input.CallValue = big.NewInt(1)
+	output, err = e.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Nil(t, output)
+	assert.Equal(t, ErrBuiltInFunctionCalledWithValue, err)
+
+	input = createClaimUnlockedInput(acnt.AddressBytes())
+	input.Arguments = append(input.Arguments, []byte("extra"))
+	output, err = e.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Nil(t, output)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	input = createClaimUnlockedInput(acnt.AddressBytes())
+	input.RecipientAddr = []byte("other")
+	output, err = e.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Nil(t, output)
+	assert.Equal(t, ErrInvalidRcvAddr, err)
+
+	output, err = e.ProcessBuiltinFunction(nil, nil, createClaimUnlockedInput(acnt.AddressBytes()))
+	assert.Nil(t, output)
+	assert.Equal(t, ErrNilUserAccount, err)
+
+	input = createClaimUnlockedInput(acnt.AddressBytes())
+	input.GasProvided = 1
+	output, err = e.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Nil(t, output)
+	assert.Equal(t, ErrNotEnoughGas, err)
+}
+
+func TestDCTClaimUnlocked_ProcessBuiltinFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	lockedBalanceHandler, _ := NewDCTLockedBalanceHandler(&mock.MarshalizerMock{}, &mock.EpochNotifierStub{})
+	e, _ := NewDCTClaimUnlockedFunc(10, &mock.MarshalizerMock{}, lockedBalanceHandler, 0, &mock.EpochNotifierStub{})
+	acnt := mock.NewUserAccount([]byte("addr"))
+	dctTokenKey := append(keyPrefix, []byte("TKN")...)
+	dctData := &dct.DCToken{Value: big.NewInt(100)}
+	_ = lockedBalanceHandler.AddLockedBalance(dctData, big.NewInt(30), 2)
+	_ = lockedBalanceHandler.AddLockedBalance(dctData, big.NewInt(50), 4)
+	_ = saveDCTData(acnt, dctData, dctTokenKey, e.marshalizer)
+
+	lockedBalanceHandler.EpochConfirmed(3, 0)
+	output, err := e.ProcessBuiltinFunction(acnt, nil, createClaimUnlockedInput(acnt.AddressBytes()))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(90), output.GasRemaining)
+	require.Equal(t, 1, len(output.Logs))
+	assert.Equal(t, [][]byte{[]byte("TKN"), big.NewInt(30).Bytes()}, output.Logs[0].Topics)
+
+	dctData, _ = getDCTDataFromKey(acnt, dctTokenKey, e.marshalizer)
+	assert.Equal(t, big.NewInt(100), dctData.Value)
+	locked, _ := lockedBalanceHandler.GetLockedBalance(dctData)
+	assert.Equal(t, big.NewInt(50), locked)
+	lockedBalance := &dct.DCTLockedBalance{}
+	_ = e.marshalizer.Unmarshal(lockedBalance, dctData.Reserved)
+	assert.Equal(t, 1, len(lockedBalance.LockedAmounts))
+}
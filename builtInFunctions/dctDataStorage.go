@@ -0,0 +1,261 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/atomic"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+)
+
+// ArgsNewDCTDataStorage defines the arguments needed for the dct NFT data storage component
+type ArgsNewDCTDataStorage struct {
+	Accounts                vmcommon.AccountsAdapter
+	GlobalSettingsHandler   vmcommon.DCTGlobalSettingsHandler
+	Marshalizer             vmcommon.Marshalizer
+	SaveToSystemEnableEpoch uint32
+	EpochNotifier           vmcommon.EpochNotifier
+}
+
+type dctDataStorage struct {
+	accounts                vmcommon.AccountsAdapter
+	globalSettingsHandler   vmcommon.DCTGlobalSettingsHandler
+	marshalizer             vmcommon.Marshalizer
+	saveToSystemEnableEpoch uint32
+	flagSaveToSystemAccount atomic.Flag
+}
+
+// NewDCTDataStorage returns the component which handles the storage of the NFT/SFT tokens. After the activation
+// epoch the token metadata is saved once per token and nonce on the system account, while the holders keep only
+// the value and the properties
+func NewDCTDataStorage(args ArgsNewDCTDataStorage) (*dctDataStorage, error) {
+	if check.IfNil(args.Accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.GlobalSettingsHandler) {
+		return nil, ErrNilGlobalSettingsHandler
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, ErrNilEpochHandler
+	}
+
+	e := &dctDataStorage{
+		accounts:                args.Accounts,
+		globalSettingsHandler:   args.GlobalSettingsHandler,
+		marshalizer:             args.Marshalizer,
+		saveToSystemEnableEpoch: args.SaveToSystemEnableEpoch,
+		flagSaveToSystemAccount: atomic.Flag{},
+	}
+	args.EpochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// GetDCTNFTTokenOnSender returns the token held by the sender. Errors if the sender does not hold the token
+func (e *dctDataStorage) GetDCTNFTTokenOnSender(
+	acnt vmcommon.UserAccountHandler,
+	dctTokenKey []byte,
+	nonce uint64,
+) (*dct.DCToken, error) {
+	dctData, isNew, err := e.GetDCTNFTTokenOnDestination(acnt, dctTokenKey, nonce)
+	if err != nil {
+		return nil, err
+	}
+	if isNew {
+		return nil, ErrNewNFTDataOnSenderAddress
+	}
+
+	return dctData, nil
+}
+
+// GetDCTNFTTokenOnDestination returns the token held by the account, together with its metadata. The returned bool
+// is true if the account does not hold the token. Errors if the metadata of a held NFT is missing from the system
+// account
+func (e *dctDataStorage) GetDCTNFTTokenOnDestination(
+	acnt vmcommon.UserAccountHandler,
+	dctTokenKey []byte,
+	nonce uint64,
+) (*dct.DCToken, bool, error) {
+	dctNFTTokenKey := computeDCTNFTTokenKey(dctTokenKey, nonce)
+	dctData := &dct.DCToken{Value: big.NewInt(0), Type: uint32(vmcommon.Fungible)}
+	marshaledData, err := acnt.AccountDataHandler().RetrieveValue(dctNFTTokenKey)
+	if err != nil || len(marshaledData) == 0 {
+		return dctData, true, nil
+	}
+
+	err = e.marshalizer.Unmarshal(dctData, marshaledData)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// tokens saved before the activation epoch still hold the metadata in the account
+	if !e.flagSaveToSystemAccount.IsSet() || nonce == 0 || dctData.TokenMetaData != nil {
+		return dctData, false, nil
+	}
+
+	dctMetaData, err := e.getDCTMetaDataFromSystemAccount(dctNFTTokenKey)
+	if err != nil {
+		return nil, false, err
+	}
+	dctData.TokenMetaData = dctMetaData
+
+	return dctData, false, nil
+}
+
+// CheckDCTNFTTokenOnDestination returns ErrWrongNFTOnDestination if the token held by the destination and the
+// transferred one have different metadata hashes. After the activation epoch the held token is identified by its key
+// and nonce only: the transferred metadata might be newer than the copy on the system account of the destination
+// shard, for example recreated with a new hash, and replaces that copy when the token is saved
+func (e *dctDataStorage) CheckDCTNFTTokenOnDestination(currentDCTData *dct.DCToken, transferredDCTData *dct.DCToken) error {
+	if e.flagSaveToSystemAccount.IsSet() {
+		return nil
+	}
+	if currentDCTData.TokenMetaData == nil || transferredDCTData.TokenMetaData == nil {
+		return nil
+	}
+	if !bytes.Equal(currentDCTData.TokenMetaData.Hash, transferredDCTData.TokenMetaData.Hash) {
+		return ErrWrongNFTOnDestination
+	}
+
+	return nil
+}
+
+// SaveDCTNFTToken saves the token in the account. After the activation epoch the metadata is saved on the system
+// account, overwriting the copy already there whenever the metadata changed. Returns the marshaled token, including
+// its metadata
+func (e *dctDataStorage) SaveDCTNFTToken(
+	acnt vmcommon.UserAccountHandler,
+	dctTokenKey []byte,
+	nonce uint64,
+	dctData *dct.DCToken,
+	isReturnWithError bool,
+) ([]byte, error) {
+	err := checkFrozeAndPause(acnt.AddressBytes(), dctTokenKey, dctData, e.globalSettingsHandler, isReturnWithError)
+	if err != nil {
+		return nil, err
+	}
+
+	dctNFTTokenKey := computeDCTNFTTokenKey(dctTokenKey, nonce)
+
+	if dctData.Value.Cmp(zero) <= 0 {
+		return nil, acnt.AccountDataHandler().SaveKeyValue(dctNFTTokenKey, nil)
+	}
+
+	marshaledData, err := e.marshalizer.Marshal(dctData)
+	if err != nil {
+		return nil, err
+	}
+
+	if !e.flagSaveToSystemAccount.IsSet() || dctData.TokenMetaData == nil {
+		return marshaledData, acnt.AccountDataHandler().SaveKeyValue(dctNFTTokenKey, marshaledData)
+	}
+
+	err = e.saveDCTMetaDataToSystemAccount(dctNFTTokenKey, dctData)
+	if err != nil {
+		return nil, err
+	}
+
+	dctDataOnAccount := &dct.DCToken{
+		Type:       dctData.Type,
+		Value:      dctData.Value,
+		Properties: dctData.Properties,
+		Reserved:   dctData.Reserved,
+	}
+	marshaledDataOnAccount, err := e.marshalizer.Marshal(dctDataOnAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshaledData, acnt.AccountDataHandler().SaveKeyValue(dctNFTTokenKey, marshaledDataOnAccount)
+}
+
+func (e *dctDataStorage) saveDCTMetaDataToSystemAccount(
+	dctNFTTokenKey []byte,
+	dctData *dct.DCToken,
+) error {
+	systemSCAccount, err := e.getSystemAccount()
+	if err != nil {
+		return err
+	}
+
+	dctDataOnSystemAcc := &dct.DCToken{
+		Type:          dctData.Type,
+		Value:         big.NewInt(0),
+		TokenMetaData: dctData.TokenMetaData,
+	}
+	marshaledData, err := e.marshalizer.Marshal(dctDataOnSystemAcc)
+	if err != nil {
+		return err
+	}
+
+	currentData, err := systemSCAccount.AccountDataHandler().RetrieveValue(dctNFTTokenKey)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(currentData, marshaledData) {
+		return nil
+	}
+
+	err = systemSCAccount.AccountDataHandler().SaveKeyValue(dctNFTTokenKey, marshaledData)
+	if err != nil {
+		return err
+	}
+
+	return e.accounts.SaveAccount(systemSCAccount)
+}
+
+func (e *dctDataStorage) getDCTMetaDataFromSystemAccount(dctNFTTokenKey []byte) (*dct.MetaData, error) {
+	systemSCAccount, err := e.getSystemAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	marshaledData, err := systemSCAccount.AccountDataHandler().RetrieveValue(dctNFTTokenKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(marshaledData) == 0 {
+		return nil, ErrMissingMetaDataOnSystemAccount
+	}
+
+	dctDataOnSystemAcc := &dct.DCToken{}
+	err = e.marshalizer.Unmarshal(dctDataOnSystemAcc, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+	if dctDataOnSystemAcc.TokenMetaData == nil {
+		return nil, ErrMissingMetaDataOnSystemAccount
+	}
+
+	return dctDataOnSystemAcc.TokenMetaData, nil
+}
+
+func (e *dctDataStorage) getSystemAccount() (vmcommon.UserAccountHandler, error) {
+	systemSCAccount, err := e.accounts.LoadAccount(vmcommon.SystemAccountAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	userAcc, ok := systemSCAccount.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	return userAcc, nil
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (e *dctDataStorage) EpochConfirmed(epoch uint32, _ uint64) {
+	e.flagSaveToSystemAccount.Toggle(epoch >= e.saveToSystemEnableEpoch)
+	log.Debug("dct NFT metadata saved on system account", "enabled", e.flagSaveToSystemAccount.IsSet())
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *dctDataStorage) IsInterfaceNil() bool {
+	return e == nil
+}
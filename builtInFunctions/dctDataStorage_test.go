@@ -0,0 +1,290 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNewDCTDataStorageHandler() *dctDataStorage {
+	return createNewDCTDataStorageHandlerWithArgs(&mock.GlobalSettingsHandlerStub{}, createAccountsWithSystemAccount(), 1)
+}
+
+func createNewDCTDataStorageHandlerWithArgs(
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler,
+	accounts vmcommon.AccountsAdapter,
+	saveToSystemEnableEpoch uint32,
+) *dctDataStorage {
+	dataStore, _ := NewDCTDataStorage(ArgsNewDCTDataStorage{
+		Accounts:                accounts,
+		GlobalSettingsHandler:   globalSettingsHandler,
+		Marshalizer:             &mock.MarshalizerMock{},
+		SaveToSystemEnableEpoch: saveToSystemEnableEpoch,
+		EpochNotifier:           &mock.EpochNotifierStub{},
+	})
+
+	return dataStore
+}
+
+func createAccountsWithSystemAccount() vmcommon.AccountsAdapter {
+	systemAccount := mock.NewUserAccount(vmcommon.SystemAccountAddress)
+	return &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return systemAccount, nil
+		},
+	}
+}
+
+func TestNewDCTDataStorage(t *testing.T) {
+	t.Parallel()
+
+	args := ArgsNewDCTDataStorage{
+		Accounts:              &mock.AccountsStub{},
+		GlobalSettingsHandler: &mock.GlobalSettingsHandlerStub{},
+		Marshalizer:           &mock.MarshalizerMock{},
+		EpochNotifier:         &mock.EpochNotifierStub{},
+	}
+
+	argsCopy := args
+	argsCopy.Accounts = nil
+	dataStore, err := NewDCTDataStorage(argsCopy)
+	assert.True(t, check.IfNil(dataStore))
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+
+	argsCopy = args
+	argsCopy.GlobalSettingsHandler = nil
+	dataStore, err = NewDCTDataStorage(argsCopy)
+	assert.True(t, check.IfNil(dataStore))
+	assert.Equal(t, ErrNilGlobalSettingsHandler, err)
+
+	argsCopy = args
+	argsCopy.Marshalizer = nil
+	dataStore, err = NewDCTDataStorage(argsCopy)
+	assert.True(t, check.IfNil(dataStore))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	argsCopy = args
+	argsCopy.EpochNotifier = nil
+	dataStore, err = NewDCTDataStorage(argsCopy)
+	assert.True(t, check.IfNil(dataStore))
+	assert.Equal(t, ErrNilEpochHandler, err)
+
+	dataStore, err = NewDCTDataStorage(args)
+	assert.False(t, check.IfNil(dataStore))
+	assert.Nil(t, err)
+	assert.True(t, dataStore.flagSaveToSystemAccount.IsSet())
+
+	dataStore.EpochConfirmed(0, 0)
+	assert.True(t, dataStore.flagSaveToSystemAccount.IsSet())
+
+	args.SaveToSystemEnableEpoch = 5
+	dataStore, _ = NewDCTDataStorage(args)
+	assert.False(t, dataStore.flagSaveToSystemAccount.IsSet())
+	dataStore.EpochConfirmed(5, 0)
+	assert.True(t, dataStore.flagSaveToSystemAccount.IsSet())
+}
+
+func createNFTData(nonce uint64, value int64) *dct.DCToken {
+	return &dct.DCToken{
+		Type:  uint32(vmcommon.SemiFungible),
+		Value: big.NewInt(value),
+		TokenMetaData: &dct.MetaData{
+			Nonce:      nonce,
+			Name:       []byte("name"),
+			Hash:       []byte("hash"),
+			Attributes: []byte("attributes"),
+			URIs:       [][]byte{[]byte("uri")},
+		},
+	}
+}
+
+func TestDCTDataStorage_SaveDCTNFTTokenBeforeActivationShouldKeepMetaDataOnAccount(t *testing.T) {
+	t.Parallel()
+
+	accounts := createAccountsWithSystemAccount()
+	dataStore := createNewDCTDataStorageHandlerWithArgs(&mock.GlobalSettingsHandlerStub{}, accounts, 1)
+	dctTokenKey := []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier + "token")
+	nonce := uint64(4)
+	userAcc := mock.NewUserAccount([]byte("user"))
+
+	dctData := createNFTData(nonce, 10)
+	marshaledData, err := dataStore.SaveDCTNFTToken(userAcc, dctTokenKey, nonce, dctData, false)
+	require.Nil(t, err)
+
+	stored, _ := userAcc.AccountDataHandler().RetrieveValue(computeDCTNFTTokenKey(dctTokenKey, nonce))
+	assert.Equal(t, marshaledData, stored)
+
+	systemAcc, _ := accounts.LoadAccount(vmcommon.SystemAccountAddress)
+	stored, _ = systemAcc.(vmcommon.UserAccountHandler).AccountDataHandler().RetrieveValue(computeDCTNFTTokenKey(dctTokenKey, nonce))
+	assert.Empty(t, stored)
+}
+
+func TestDCTDataStorage_SaveDCTNFTTokenAfterActivationShouldSaveMetaDataOnSystemAccount(t *testing.T) {
+	t.Parallel()
+
+	accounts := createAccountsWithSystemAccount()
+	dataStore := createNewDCTDataStorageHandlerWithArgs(&mock.GlobalSettingsHandlerStub{}, accounts, 0)
+	marshalizer := dataStore.marshalizer
+	dctTokenKey := []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier + "token")
+	nonce := uint64(4)
+	dctNFTTokenKey := computeDCTNFTTokenKey(dctTokenKey, nonce)
+	firstAcc := mock.NewUserAccount([]byte("first"))
+	secondAcc := mock.NewUserAccount([]byte("second"))
+
+	_, err := dataStore.SaveDCTNFTToken(firstAcc, dctTokenKey, nonce, createNFTData(nonce, 10), false)
+	require.Nil(t, err)
+	_, err = dataStore.SaveDCTNFTToken(secondAcc, dctTokenKey, nonce, createNFTData(nonce, 3), false)
+	require.Nil(t, err)
+
+	stored, _ := firstAcc.AccountDataHandler().RetrieveValue(dctNFTTokenKey)
+	dctDataOnAccount := &dct.DCToken{}
+	_ = marshalizer.Unmarshal(dctDataOnAccount, stored)
+	assert.Nil(t, dctDataOnAccount.TokenMetaData)
+	assert.Equal(t, big.NewInt(10), dctDataOnAccount.Value)
+
+	systemAcc, _ := accounts.LoadAccount(vmcommon.SystemAccountAddress)
+	stored, _ = systemAcc.(vmcommon.UserAccountHandler).AccountDataHandler().RetrieveValue(dctNFTTokenKey)
+	dctDataOnSystemAcc := &dct.DCToken{}
+	_ = marshalizer.Unmarshal(dctDataOnSystemAcc, stored)
+	assert.Equal(t, createNFTData(nonce, 0).TokenMetaData, dctDataOnSystemAcc.TokenMetaData)
+	assert.Equal(t, big.NewInt(0), dctDataOnSystemAcc.Value)
+
+	dctData, err := dataStore.GetDCTNFTTokenOnSender(secondAcc, dctTokenKey, nonce)
+	require.Nil(t, err)
+	assert.Equal(t, createNFTData(nonce, 3), dctData)
+}
+
+func TestDCTDataStorage_SaveDCTNFTTokenShouldOverwriteChangedMetaData(t *testing.T) {
+	t.Parallel()
+
+	dataStore := createNewDCTDataStorageHandlerWithArgs(&mock.GlobalSettingsHandlerStub{}, createAccountsWithSystemAccount(), 0)
+	dctTokenKey := []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier + "token")
+	nonce := uint64(4)
+	userAcc := mock.NewUserAccount([]byte("user"))
+	otherAcc := mock.NewUserAccount([]byte("other"))
+
+	_, _ = dataStore.SaveDCTNFTToken(userAcc, dctTokenKey, nonce, createNFTData(nonce, 10), false)
+
+	dctData := createNFTData(nonce, 10)
+	dctData.TokenMetaData.Attributes = []byte("new attributes")
+	_, err := dataStore.SaveDCTNFTToken(userAcc, dctTokenKey, nonce, dctData, false)
+	require.Nil(t, err)
+
+	dctData, _ = dataStore.GetDCTNFTTokenOnSender(userAcc, dctTokenKey, nonce)
+	assert.Equal(t, []byte("new attributes"), dctData.TokenMetaData.Attributes)
+
+	_, _ = dataStore.SaveDCTNFTToken(otherAcc, dctTokenKey, nonce, createNFTData(nonce, 1), false)
+	dctData, _ = dataStore.GetDCTNFTTokenOnSender(userAcc, dctTokenKey, nonce)
+	assert.Equal(t, []byte("attributes"), dctData.TokenMetaData.Attributes)
+}
+
+func TestDCTDataStorage_GetDCTNFTTokenMissingMetaDataOnSystemAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	dataStore := createNewDCTDataStorageHandlerWithArgs(&mock.GlobalSettingsHandlerStub{}, createAccountsWithSystemAccount(), 0)
+	dctTokenKey := []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier + "token")
+	nonce := uint64(4)
+	userAcc := mock.NewUserAccount([]byte("user"))
+
+	dctDataOnAccount := &dct.DCToken{Type: uint32(vmcommon.NonFungible), Value: big.NewInt(1)}
+	marshaledData, _ := marshalizer.Marshal(dctDataOnAccount)
+	_ = userAcc.AccountDataHandler().SaveKeyValue(computeDCTNFTTokenKey(dctTokenKey, nonce), marshaledData)
+
+	dctData, isNew, err := dataStore.GetDCTNFTTokenOnDestination(userAcc, dctTokenKey, nonce)
+	assert.Nil(t, dctData)
+	assert.False(t, isNew)
+	assert.Equal(t, ErrMissingMetaDataOnSystemAccount, err)
+}
+
+func TestDCTDataStorage_LegacyTokenShouldMigrateOnSave(t *testing.T) {
+	t.Parallel()
+
+	accounts := createAccountsWithSystemAccount()
+	dataStore := createNewDCTDataStorageHandlerWithArgs(&mock.GlobalSettingsHandlerStub{}, accounts, 1)
+	dctTokenKey := []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier + "token")
+	nonce := uint64(4)
+	dctNFTTokenKey := computeDCTNFTTokenKey(dctTokenKey, nonce)
+	userAcc := mock.NewUserAccount([]byte("user"))
+
+	_, _ = dataStore.SaveDCTNFTToken(userAcc, dctTokenKey, nonce, createNFTData(nonce, 10), false)
+
+	dataStore.EpochConfirmed(1, 0)
+
+	dctData, err := dataStore.GetDCTNFTTokenOnSender(userAcc, dctTokenKey, nonce)
+	require.Nil(t, err)
+	assert.Equal(t, createNFTData(nonce, 10), dctData)
+
+	dctData.Value.SetInt64(7)
+	_, err = dataStore.SaveDCTNFTToken(userAcc, dctTokenKey, nonce, dctData, false)
+	require.Nil(t, err)
+
+	stored, _ := userAcc.AccountDataHandler().RetrieveValue(dctNFTTokenKey)
+	dctDataOnAccount := &dct.DCToken{}
+	_ = dataStore.marshalizer.Unmarshal(dctDataOnAccount, stored)
+	assert.Nil(t, dctDataOnAccount.TokenMetaData)
+
+	dctData, _ = dataStore.GetDCTNFTTokenOnSender(userAcc, dctTokenKey, nonce)
+	assert.Equal(t, createNFTData(nonce, 7), dctData)
+}
+
+func TestDCTDataStorage_CheckDCTNFTTokenOnDestination(t *testing.T) {
+	t.Parallel()
+
+	currentDCTData := createNFTData(4, 10)
+	transferredDCTData := createNFTData(4, 1)
+	transferredDCTData.TokenMetaData.Hash = []byte("recreated hash")
+
+	dataStore := createNewDCTDataStorageHandlerWithArgs(&mock.GlobalSettingsHandlerStub{}, createAccountsWithSystemAccount(), 1)
+	err := dataStore.CheckDCTNFTTokenOnDestination(currentDCTData, transferredDCTData)
+	assert.Equal(t, ErrWrongNFTOnDestination, err)
+	err = dataStore.CheckDCTNFTTokenOnDestination(createNFTData(4, 10), createNFTData(4, 1))
+	assert.Nil(t, err)
+	err = dataStore.CheckDCTNFTTokenOnDestination(&dct.DCToken{Value: big.NewInt(0)}, transferredDCTData)
+	assert.Nil(t, err)
+
+	dataStore.EpochConfirmed(1, 0)
+	err = dataStore.CheckDCTNFTTokenOnDestination(currentDCTData, transferredDCTData)
+	assert.Nil(t, err)
+}
+
+func TestDCTDataStorage_GetDCTNFTTokenOnSenderNotHeldShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dataStore := createNewDCTDataStorageHandlerWithArgs(&mock.GlobalSettingsHandlerStub{}, createAccountsWithSystemAccount(), 0)
+	dctTokenKey := []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier + "token")
+
+	dctData, err := dataStore.GetDCTNFTTokenOnSender(mock.NewUserAccount([]byte("user")), dctTokenKey, 1)
+	assert.Nil(t, dctData)
+	assert.Equal(t, ErrNewNFTDataOnSenderAddress, err)
+
+	dctData, isNew, err := dataStore.GetDCTNFTTokenOnDestination(mock.NewUserAccount([]byte("user")), dctTokenKey, 1)
+	assert.Nil(t, err)
+	assert.True(t, isNew)
+	assert.Equal(t, big.NewInt(0), dctData.Value)
+}
+
+func TestDCTDataStorage_SaveDCTNFTTokenPausedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	globalSettingsHandler := &mock.GlobalSettingsHandlerStub{
+		IsPausedCalled: func(_ []byte) bool {
+			return true
+		},
+	}
+	dataStore := createNewDCTDataStorageHandlerWithArgs(globalSettingsHandler, createAccountsWithSystemAccount(), 0)
+	dctTokenKey := []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier + "token")
+	userAcc := mock.NewUserAccount([]byte("user"))
+
+	_, err := dataStore.SaveDCTNFTToken(userAcc, dctTokenKey, 1, createNFTData(1, 10), false)
+	assert.Equal(t, ErrDCTTokenIsPaused, err)
+
+	_, err = dataStore.SaveDCTNFTToken(userAcc, dctTokenKey, 1, createNFTData(1, 10), true)
+	assert.Nil(t, err)
+}
@@ -10,27 +10,33 @@ import (
 
 type dctFreezeWipe struct {
 	baseAlwaysActive
-	marshalizer vmcommon.Marshalizer
-	keyPrefix   []byte
-	wipe        bool
-	freeze      bool
+	marshalizer   vmcommon.Marshalizer
+	supplyHandler vmcommon.DCTSupplyHandler
+	keyPrefix     []byte
+	wipe          bool
+	freeze        bool
 }
 
 // NewDCTFreezeWipeFunc returns the dct freeze/un-freeze/wipe built-in function component
 func NewDCTFreezeWipeFunc(
 	marshalizer vmcommon.Marshalizer,
+	supplyHandler vmcommon.DCTSupplyHandler,
 	freeze bool,
 	wipe bool,
 ) (*dctFreezeWipe, error) {
 	if check.IfNil(marshalizer) {
 		return nil, ErrNilMarshalizer
 	}
+	if check.IfNil(supplyHandler) {
+		return nil, ErrNilSupplyHandler
+	}
 
 	e := &dctFreezeWipe{
-		marshalizer: marshalizer,
-		keyPrefix:   []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
-		freeze:      freeze,
-		wipe:        wipe,
+		marshalizer:   marshalizer,
+		supplyHandler: supplyHandler,
+		keyPrefix:     []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
+		freeze:        freeze,
+		wipe:          wipe,
 	}
 
 	return e, nil
@@ -64,7 +70,12 @@ func (e *dctFreezeWipe) ProcessBuiltinFunction(
 	dctTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
 
 	if e.wipe {
-		err := e.wipeIfApplicable(acntDst, dctTokenKey)
+		wipedValue, err := e.wipeIfApplicable(acntDst, dctTokenKey)
+		if err != nil {
+			return nil, err
+		}
+
+		err = e.supplyHandler.UpdateSupply(vmInput.Arguments[0], 0, big.NewInt(0).Neg(wipedValue))
 		if err != nil {
 			return nil, err
 		}
@@ -83,18 +94,28 @@ func (e *dctFreezeWipe) ProcessBuiltinFunction(
 	return vmOutput, nil
 }
 
-func (e *dctFreezeWipe) wipeIfApplicable(acntDst vmcommon.UserAccountHandler, tokenKey []byte) error {
+func (e *dctFreezeWipe) wipeIfApplicable(acntDst vmcommon.UserAccountHandler, tokenKey []byte) (*big.Int, error) {
 	tokenData, err := getDCTDataFromKey(acntDst, tokenKey, e.marshalizer)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	dctUserMetadata := DCTUserMetadataFromBytes(tokenData.Properties)
 	if !dctUserMetadata.Frozen {
-		return ErrCannotWipeAccountNotFrozen
+		return nil, ErrCannotWipeAccountNotFrozen
+	}
+
+	err = acntDst.AccountDataHandler().SaveKeyValue(tokenKey, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	wipedValue := big.NewInt(0)
+	if tokenData.Value != nil {
+		wipedValue.Set(tokenData.Value)
 	}
 
-	return acntDst.AccountDataHandler().SaveKeyValue(tokenKey, nil)
+	return wipedValue, nil
 }
 
 func (e *dctFreezeWipe) toggleFreeze(acntDst vmcommon.UserAccountHandler, tokenKey []byte) error {
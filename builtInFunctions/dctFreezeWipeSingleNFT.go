@@ -0,0 +1,154 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+)
+
+type dctFreezeWipeSingleNFT struct {
+	baseAlwaysActive
+	marshalizer   vmcommon.Marshalizer
+	supplyHandler vmcommon.DCTSupplyHandler
+	keyPrefix     []byte
+	wipe          bool
+	freeze        bool
+}
+
+// NewDCTFreezeWipeSingleNFTFunc returns the dct freeze/un-freeze/wipe built-in function component which acts
+// on a single nonce of a NFT/SFT token
+func NewDCTFreezeWipeSingleNFTFunc(
+	marshalizer vmcommon.Marshalizer,
+	supplyHandler vmcommon.DCTSupplyHandler,
+	freeze bool,
+	wipe bool,
+) (*dctFreezeWipeSingleNFT, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(supplyHandler) {
+		return nil, ErrNilSupplyHandler
+	}
+
+	e := &dctFreezeWipeSingleNFT{
+		marshalizer:   marshalizer,
+		supplyHandler: supplyHandler,
+		keyPrefix:     []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
+		freeze:        freeze,
+		wipe:          wipe,
+	}
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *dctFreezeWipeSingleNFT) SetNewGasConfig(_ *vmcommon.GasCost) {
+}
+
+// ProcessBuiltinFunction resolves DCT freeze, un-freeze and wipe single NFT function calls
+// Requires 3 arguments:
+// arg0 - token identifier
+// arg1 - nonce
+// arg2 - address of the account holding the NFT
+func (e *dctFreezeWipeSingleNFT) ProcessBuiltinFunction(
+	_, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if vmInput == nil {
+		return nil, ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) != 3 {
+		return nil, ErrInvalidArguments
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmcommon.DCTSCAddress) {
+		return nil, ErrAddressIsNotDCTSystemSC
+	}
+	if check.IfNil(acntDst) {
+		return nil, ErrNilUserAccount
+	}
+	if !bytes.Equal(vmInput.Arguments[2], acntDst.AddressBytes()) {
+		return nil, ErrInvalidRcvAddr
+	}
+
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	if nonce == 0 {
+		return nil, ErrNFTDoesNotHaveMetadata
+	}
+
+	tokenID := vmInput.Arguments[0]
+	dctTokenKey := append(e.keyPrefix, tokenID...)
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
+	if e.wipe {
+		wipedQuantity, err := e.wipeIfApplicable(acntDst, dctTokenKey, nonce)
+		if err != nil {
+			return nil, err
+		}
+
+		err = e.supplyHandler.UpdateSupply(tokenID, nonce, big.NewInt(0).Neg(wipedQuantity))
+		if err != nil {
+			return nil, err
+		}
+
+		logEntry := newEntryForNFT(vmcommon.BuiltInFunctionDCTWipeSingleNFT, vmInput.CallerAddr, tokenID, nonce)
+		logEntry.Topics = append(logEntry.Topics, wipedQuantity.Bytes(), acntDst.AddressBytes())
+		vmOutput.Logs = []*vmcommon.LogEntry{logEntry}
+
+		return vmOutput, nil
+	}
+
+	err := e.toggleFreeze(acntDst, dctTokenKey, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return vmOutput, nil
+}
+
+func (e *dctFreezeWipeSingleNFT) wipeIfApplicable(acntDst vmcommon.UserAccountHandler, dctTokenKey []byte, nonce uint64) (*big.Int, error) {
+	dctNFTTokenKey := computeDCTNFTTokenKey(dctTokenKey, nonce)
+	tokenData, err := getDCTDataFromKey(acntDst, dctNFTTokenKey, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	dctUserMetadata := DCTUserMetadataFromBytes(tokenData.Properties)
+	if !dctUserMetadata.Frozen {
+		return nil, ErrCannotWipeAccountNotFrozen
+	}
+
+	err = acntDst.AccountDataHandler().SaveKeyValue(dctNFTTokenKey, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	wipedValue := big.NewInt(0)
+	if tokenData.Value != nil {
+		wipedValue.Set(tokenData.Value)
+	}
+
+	return wipedValue, nil
+}
+
+func (e *dctFreezeWipeSingleNFT) toggleFreeze(acntDst vmcommon.UserAccountHandler, dctTokenKey []byte, nonce uint64) error {
+	dctNFTTokenKey := computeDCTNFTTokenKey(dctTokenKey, nonce)
+	tokenData, err := getDCTDataFromKey(acntDst, dctNFTTokenKey, e.marshalizer)
+	if err != nil {
+		return err
+	}
+
+	dctUserMetadata := DCTUserMetadataFromBytes(tokenData.Properties)
+	dctUserMetadata.Frozen = e.freeze
+	tokenData.Properties = dctUserMetadata.ToBytes()
+
+	return saveDCTData(acntDst, tokenData, dctNFTTokenKey, e.marshalizer)
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *dctFreezeWipeSingleNFT) IsInterfaceNil() bool {
+	return e == nil
+}
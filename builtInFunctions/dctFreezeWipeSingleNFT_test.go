@@ -0,0 +1,147 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDCTFreezeWipeSingleNFTFunc(t *testing.T) {
+	t.Parallel()
+
+	freeze, err := NewDCTFreezeWipeSingleNFTFunc(nil, &mock.SupplyHandlerStub{}, true, false)
+	assert.True(t, check.IfNil(freeze))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	freeze, err = NewDCTFreezeWipeSingleNFTFunc(&mock.MarshalizerMock{}, nil, true, false)
+	assert.True(t, check.IfNil(freeze))
+	assert.Equal(t, ErrNilSupplyHandler, err)
+
+	freeze, err = NewDCTFreezeWipeSingleNFTFunc(&mock.MarshalizerMock{}, &mock.SupplyHandlerStub{}, true, false)
+	assert.False(t, check.IfNil(freeze))
+	assert.Nil(t, err)
+}
+
+func TestDCTFreezeWipeSingleNFT_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	freeze, _ := NewDCTFreezeWipeSingleNFTFunc(&mock.MarshalizerMock{}, &mock.SupplyHandlerStub{}, true, false)
+	_, err := freeze.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, ErrNilVmInput, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue: big.NewInt(1),
+		},
+	}
+	_, err = freeze.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrBuiltInFunctionCalledWithValue, err)
+
+	input.CallValue = big.NewInt(0)
+	input.Arguments = [][]byte{[]byte("token"), big.NewInt(1).Bytes()}
+	_, err = freeze.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{[]byte("token"), big.NewInt(1).Bytes(), []byte("dst")}
+	_, err = freeze.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrAddressIsNotDCTSystemSC, err)
+
+	input.CallerAddr = vmcommon.DCTSCAddress
+	_, err = freeze.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrNilUserAccount, err)
+
+	acnt := mock.NewUserAccount([]byte("other"))
+	_, err = freeze.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Equal(t, ErrInvalidRcvAddr, err)
+
+	acnt = mock.NewUserAccount([]byte("dst"))
+	input.Arguments = [][]byte{[]byte("token"), big.NewInt(0).Bytes(), []byte("dst")}
+	_, err = freeze.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Equal(t, ErrNFTDoesNotHaveMetadata, err)
+}
+
+func TestDCTFreezeWipeSingleNFT_FreezeShouldBlockBurnAndWipeShouldLog(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	freeze, _ := NewDCTFreezeWipeSingleNFTFunc(marshalizer, &mock.SupplyHandlerStub{}, true, false)
+	unFreeze, _ := NewDCTFreezeWipeSingleNFTFunc(marshalizer, &mock.SupplyHandlerStub{}, false, false)
+	wipe, _ := NewDCTFreezeWipeSingleNFTFunc(marshalizer, &mock.SupplyHandlerStub{}, false, true)
+	burn, _ := NewDCTNFTBurnFunc(10, vmcommon.BaseOperationCost{}, marshalizer, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler(), 0, &mock.EpochNotifierStub{})
+
+	address := []byte("dst")
+	tokenID := []byte("token")
+	nonce := big.NewInt(5)
+	acnt := mock.NewUserAccount(address)
+	dctData := &dct.DCToken{
+		Type:          uint32(vmcommon.SemiFungible),
+		Value:         big.NewInt(10),
+		TokenMetaData: &dct.MetaData{Nonce: nonce.Uint64(), Name: []byte("name")},
+	}
+	marshaledData, _ := marshalizer.Marshal(dctData)
+	dctNFTTokenKey := computeDCTNFTTokenKey(append(freeze.keyPrefix, tokenID...), nonce.Uint64())
+	_ = acnt.AccountDataHandler().SaveKeyValue(dctNFTTokenKey, marshaledData)
+	dctData.TokenMetaData.Nonce = nonce.Uint64() + 1
+	marshaledData, _ = marshalizer.Marshal(dctData)
+	otherNonceKey := computeDCTNFTTokenKey(append(freeze.keyPrefix, tokenID...), nonce.Uint64()+1)
+	_ = acnt.AccountDataHandler().SaveKeyValue(otherNonceKey, marshaledData)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: vmcommon.DCTSCAddress,
+			Arguments:  [][]byte{tokenID, nonce.Bytes(), address},
+		},
+		RecipientAddr: address,
+	}
+
+	_, err := wipe.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Equal(t, ErrCannotWipeAccountNotFrozen, err)
+
+	_, err = freeze.ProcessBuiltinFunction(nil, acnt, input)
+	require.Nil(t, err)
+
+	burnInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			CallerAddr:  address,
+			Arguments:   [][]byte{tokenID, nonce.Bytes(), big.NewInt(1).Bytes()},
+			GasProvided: 10,
+		},
+		RecipientAddr: address,
+	}
+	_, err = burn.ProcessBuiltinFunction(acnt, nil, burnInput)
+	assert.Equal(t, ErrDCTIsFrozenForAccount, err)
+
+	burnInput.Arguments[1] = big.NewInt(0).Add(nonce, big.NewInt(1)).Bytes()
+	_, err = burn.ProcessBuiltinFunction(acnt, nil, burnInput)
+	assert.Nil(t, err)
+
+	_, err = unFreeze.ProcessBuiltinFunction(nil, acnt, input)
+	require.Nil(t, err)
+	burnInput.Arguments[1] = nonce.Bytes()
+	_, err = burn.ProcessBuiltinFunction(acnt, nil, burnInput)
+	assert.Nil(t, err)
+
+	_, err = freeze.ProcessBuiltinFunction(nil, acnt, input)
+	require.Nil(t, err)
+	vmOutput, err := wipe.ProcessBuiltinFunction(nil, acnt, input)
+	require.Nil(t, err)
+
+	marshaledData, _ = acnt.AccountDataHandler().RetrieveValue(dctNFTTokenKey)
+	assert.Equal(t, 0, len(marshaledData))
+
+	require.Equal(t, 1, len(vmOutput.Logs))
+	expectedLog := &vmcommon.LogEntry{
+		Identifier: []byte(vmcommon.BuiltInFunctionDCTWipeSingleNFT),
+		Address:    vmcommon.DCTSCAddress,
+		Topics:     [][]byte{tokenID, nonce.Bytes(), big.NewInt(9).Bytes(), address},
+	}
+	assert.Equal(t, expectedLog, vmOutput.Logs[0])
+}
@@ -14,7 +14,7 @@ func TestDCTFreezeWipe_ProcessBuiltInFunctionErrors(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	freeze, _ := NewDCTFreezeWipeFunc(marshalizer, true, false)
+	freeze, _ := NewDCTFreezeWipeFunc(marshalizer, &mock.SupplyHandlerStub{}, true, false)
 	_, err := freeze.ProcessBuiltinFunction(nil, nil, nil)
 	assert.Equal(t, err, ErrNilVmInput)
 
@@ -68,7 +68,7 @@ func TestDCTFreezeWipe_ProcessBuiltInFunction(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	freeze, _ := NewDCTFreezeWipeFunc(marshalizer, true, false)
+	freeze, _ := NewDCTFreezeWipeFunc(marshalizer, &mock.SupplyHandlerStub{}, true, false)
 	_, err := freeze.ProcessBuiltinFunction(nil, nil, nil)
 	assert.Equal(t, err, ErrNilVmInput)
 
@@ -98,7 +98,7 @@ func TestDCTFreezeWipe_ProcessBuiltInFunction(t *testing.T) {
 	dctUserData := DCTUserMetadataFromBytes(dctToken.Properties)
 	assert.True(t, dctUserData.Frozen)
 
-	unFreeze, _ := NewDCTFreezeWipeFunc(marshalizer, false, false)
+	unFreeze, _ := NewDCTFreezeWipeFunc(marshalizer, &mock.SupplyHandlerStub{}, false, false)
 	_, err = unFreeze.ProcessBuiltinFunction(nil, acnt, input)
 	assert.Nil(t, err)
 
@@ -109,7 +109,7 @@ func TestDCTFreezeWipe_ProcessBuiltInFunction(t *testing.T) {
 	assert.False(t, dctUserData.Frozen)
 
 	// cannot wipe if account is not frozen
-	wipe, _ := NewDCTFreezeWipeFunc(marshalizer, false, true)
+	wipe, _ := NewDCTFreezeWipeFunc(marshalizer, &mock.SupplyHandlerStub{}, false, true)
 	_, err = wipe.ProcessBuiltinFunction(nil, acnt, input)
 	assert.Equal(t, ErrCannotWipeAccountNotFrozen, err)
 
@@ -125,10 +125,46 @@ func TestDCTFreezeWipe_ProcessBuiltInFunction(t *testing.T) {
 	err = acnt.AccountDataHandler().SaveKeyValue(dctKey, dctTokenBytes)
 	assert.NoError(t, err)
 
-	wipe, _ = NewDCTFreezeWipeFunc(marshalizer, false, true)
+	wipe, _ = NewDCTFreezeWipeFunc(marshalizer, &mock.SupplyHandlerStub{}, false, true)
 	_, err = wipe.ProcessBuiltinFunction(nil, acnt, input)
 	assert.NoError(t, err)
 
 	marshaledData, _ = acnt.AccountDataHandler().RetrieveValue(dctKey)
 	assert.Equal(t, 0, len(marshaledData))
 }
+
+func TestDCTFreezeWipe_WipeShouldUpdateSupply(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	var wipedValue *big.Int
+	supplyHandler := &mock.SupplyHandlerStub{
+		UpdateSupplyCalled: func(tokenID []byte, nonce uint64, value *big.Int) error {
+			wipedValue = value
+			return nil
+		},
+	}
+	wipe, _ := NewDCTFreezeWipeFunc(marshalizer, supplyHandler, false, true)
+
+	key := []byte("key")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: vmcommon.DCTSCAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  [][]byte{key},
+		},
+		RecipientAddr: []byte("dst"),
+	}
+	metaData := DCTUserMetadata{Frozen: true}
+	dctToken := &dct.DCToken{
+		Value:      big.NewInt(25),
+		Properties: metaData.ToBytes(),
+	}
+	dctTokenBytes, _ := marshalizer.Marshal(dctToken)
+	acnt := mock.NewUserAccount(input.RecipientAddr)
+	_ = acnt.AccountDataHandler().SaveKeyValue(append(wipe.keyPrefix, key...), dctTokenBytes)
+
+	_, err := wipe.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(-25), wipedValue)
+}
@@ -0,0 +1,202 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+)
+
+type dctGlobalSettings struct {
+	baseAlwaysActive
+	keyPrefix []byte
+	set       bool
+	function  string
+	accounts  vmcommon.AccountsAdapter
+}
+
+// NewDCTPauseFunc returns the dct pause/un-pause built-in function component
+func NewDCTPauseFunc(
+	accounts vmcommon.AccountsAdapter,
+	pause bool,
+) (*dctGlobalSettings, error) {
+	function := vmcommon.BuiltInFunctionDCTUnPause
+	if pause {
+		function = vmcommon.BuiltInFunctionDCTPause
+	}
+
+	return NewDCTGlobalSettingsFunc(accounts, pause, function)
+}
+
+// NewDCTGlobalSettingsFunc returns the dct global settings built-in function component. The set flag together with
+// the function name decides which setting is changed
+func NewDCTGlobalSettingsFunc(
+	accounts vmcommon.AccountsAdapter,
+	set bool,
+	function string,
+) (*dctGlobalSettings, error) {
+	if check.IfNil(accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if !isGlobalSettingsFunction(function) {
+		return nil, ErrInvalidArguments
+	}
+
+	e := &dctGlobalSettings{
+		keyPrefix: []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
+		set:       set,
+		function:  function,
+		accounts:  accounts,
+	}
+
+	return e, nil
+}
+
+func isGlobalSettingsFunction(function string) bool {
+	switch function {
+	case vmcommon.BuiltInFunctionDCTPause, vmcommon.BuiltInFunctionDCTUnPause,
+		vmcommon.BuiltInFunctionDCTSetLimitedTransfer, vmcommon.BuiltInFunctionDCTUnSetLimitedTransfer:
+		return true
+	default:
+		return false
+	}
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *dctGlobalSettings) SetNewGasConfig(_ *vmcommon.GasCost) {
+}
+
+// ProcessBuiltinFunction resolves DCT pause, un-pause, set and unset limited transfer function calls
+func (e *dctGlobalSettings) ProcessBuiltinFunction(
+	_, _ vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if vmInput == nil {
+		return nil, ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) != 1 {
+		return nil, ErrInvalidArguments
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmcommon.DCTSCAddress) {
+		return nil, ErrAddressIsNotDCTSystemSC
+	}
+	if !vmcommon.IsSystemAccountAddress(vmInput.RecipientAddr) {
+		return nil, ErrOnlySystemAccountAccepted
+	}
+
+	dctTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
+
+	err := e.toggleSetting(dctTokenKey)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
+	return vmOutput, nil
+}
+
+func (e *dctGlobalSettings) toggleSetting(token []byte) error {
+	systemSCAccount, err := e.getSystemAccount()
+	if err != nil {
+		return err
+	}
+
+	val, _ := systemSCAccount.AccountDataHandler().RetrieveValue(token)
+	dctMetaData := DCTGlobalMetadataFromBytes(val)
+	switch e.function {
+	case vmcommon.BuiltInFunctionDCTPause, vmcommon.BuiltInFunctionDCTUnPause:
+		dctMetaData.Paused = e.set
+	case vmcommon.BuiltInFunctionDCTSetLimitedTransfer, vmcommon.BuiltInFunctionDCTUnSetLimitedTransfer:
+		dctMetaData.LimitedTransfer = e.set
+	}
+	err = systemSCAccount.AccountDataHandler().SaveKeyValue(token, dctMetaData.ToBytes())
+	if err != nil {
+		return err
+	}
+
+	return e.accounts.SaveAccount(systemSCAccount)
+}
+
+func (e *dctGlobalSettings) getSystemAccount() (vmcommon.UserAccountHandler, error) {
+	systemSCAccount, err := e.accounts.LoadAccount(vmcommon.SystemAccountAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	userAcc, ok := systemSCAccount.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	return userAcc, nil
+}
+
+// IsPaused returns true if the token is paused
+func (e *dctGlobalSettings) IsPaused(pauseKey []byte) bool {
+	dctMetaData, found := e.getGlobalMetadata(pauseKey)
+	if !found {
+		return false
+	}
+
+	return dctMetaData.Paused
+}
+
+// IsLimitedTransfer returns true if the token transfer is limited to the accounts holding the transfer role
+func (e *dctGlobalSettings) IsLimitedTransfer(dctTokenKey []byte) bool {
+	dctMetaData, found := e.getGlobalMetadata(dctTokenKey)
+	if !found {
+		return false
+	}
+
+	return dctMetaData.LimitedTransfer
+}
+
+// GetTokenType returns the token type saved in the global metadata of the token
+func (e *dctGlobalSettings) GetTokenType(dctTokenKey []byte) uint32 {
+	dctMetaData, found := e.getGlobalMetadata(dctTokenKey)
+	if !found {
+		return uint32(vmcommon.Fungible)
+	}
+
+	return uint32(dctMetaData.TokenType)
+}
+
+// GetNumDecimals returns the number of decimals saved for the token
+func (e *dctGlobalSettings) GetNumDecimals(dctTokenKey []byte) uint32 {
+	if !bytes.HasPrefix(dctTokenKey, e.keyPrefix) {
+		return 0
+	}
+
+	systemSCAccount, err := e.getSystemAccount()
+	if err != nil {
+		return 0
+	}
+
+	tokenID := dctTokenKey[len(e.keyPrefix):]
+	val, _ := systemSCAccount.AccountDataHandler().RetrieveValue(getDecimalsKey(tokenID))
+
+	return uint32(big.NewInt(0).SetBytes(val).Uint64())
+}
+
+func (e *dctGlobalSettings) getGlobalMetadata(dctTokenKey []byte) (DCTGlobalMetadata, bool) {
+	systemSCAccount, err := e.getSystemAccount()
+	if err != nil {
+		return DCTGlobalMetadata{}, false
+	}
+
+	val, _ := systemSCAccount.AccountDataHandler().RetrieveValue(dctTokenKey)
+	if len(val) != lengthOfDCTMetadata {
+		return DCTGlobalMetadata{}, false
+	}
+
+	return DCTGlobalMetadataFromBytes(val), true
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *dctGlobalSettings) IsInterfaceNil() bool {
+	return e == nil
+}
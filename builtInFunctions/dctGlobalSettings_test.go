@@ -0,0 +1,151 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDCTPause_ProcessBuiltInFunction(t *testing.T) {
+	t.Parallel()
+
+	acnt := mock.NewUserAccount(vmcommon.SystemAccountAddress)
+	pauseFunc, _ := NewDCTPauseFunc(&mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return acnt, nil
+		},
+	}, true)
+	_, err := pauseFunc.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, err, ErrNilVmInput)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue: big.NewInt(0),
+		},
+	}
+	_, err = pauseFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, err, ErrInvalidArguments)
+
+	input = &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			GasProvided: 50,
+			CallValue:   big.NewInt(1),
+		},
+	}
+	_, err = pauseFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, err, ErrBuiltInFunctionCalledWithValue)
+
+	input.CallValue = big.NewInt(0)
+	key := []byte("key")
+	value := []byte("value")
+	input.Arguments = [][]byte{key, value}
+	_, err = pauseFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, err, ErrInvalidArguments)
+
+	input.Arguments = [][]byte{key}
+	_, err = pauseFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, err, ErrAddressIsNotDCTSystemSC)
+
+	input.CallerAddr = vmcommon.DCTSCAddress
+	_, err = pauseFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, err, ErrOnlySystemAccountAccepted)
+
+	input.RecipientAddr = vmcommon.SystemAccountAddress
+	_, err = pauseFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, err)
+
+	pauseKey := []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier + string(key))
+	assert.True(t, pauseFunc.IsPaused(pauseKey))
+
+	dctPauseFalse, _ := NewDCTPauseFunc(&mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return acnt, nil
+		},
+	}, false)
+
+	_, err = dctPauseFalse.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, err)
+
+	assert.False(t, pauseFunc.IsPaused(pauseKey))
+}
+
+func TestDCTPause_GetTokenTypeAndDecimals(t *testing.T) {
+	t.Parallel()
+
+	acnt := mock.NewUserAccount(vmcommon.SystemAccountAddress)
+	pauseFunc, _ := NewDCTPauseFunc(&mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return acnt, nil
+		},
+	}, true)
+
+	token := []byte("token")
+	dctTokenKey := []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier + string(token))
+	assert.Equal(t, uint32(vmcommon.Fungible), pauseFunc.GetTokenType(dctTokenKey))
+	assert.Equal(t, uint32(0), pauseFunc.GetNumDecimals(dctTokenKey))
+
+	dctMetaData := DCTGlobalMetadata{TokenType: byte(vmcommon.SemiFungible)}
+	_ = acnt.AccountDataHandler().SaveKeyValue(dctTokenKey, dctMetaData.ToBytes())
+	_ = acnt.AccountDataHandler().SaveKeyValue(getDecimalsKey(token), big.NewInt(4).Bytes())
+	assert.Equal(t, uint32(vmcommon.SemiFungible), pauseFunc.GetTokenType(dctTokenKey))
+	assert.Equal(t, uint32(4), pauseFunc.GetNumDecimals(dctTokenKey))
+	assert.False(t, pauseFunc.IsPaused(dctTokenKey))
+	assert.Equal(t, uint32(0), pauseFunc.GetNumDecimals([]byte("invalid key")))
+}
+
+func TestNewDCTGlobalSettingsFunc(t *testing.T) {
+	t.Parallel()
+
+	globalSettingsFunc, err := NewDCTGlobalSettingsFunc(nil, true, vmcommon.BuiltInFunctionDCTPause)
+	assert.True(t, check.IfNil(globalSettingsFunc))
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+
+	globalSettingsFunc, err = NewDCTGlobalSettingsFunc(&mock.AccountsStub{}, true, vmcommon.BuiltInFunctionDCTTransfer)
+	assert.True(t, check.IfNil(globalSettingsFunc))
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	globalSettingsFunc, err = NewDCTGlobalSettingsFunc(&mock.AccountsStub{}, true, vmcommon.BuiltInFunctionDCTSetLimitedTransfer)
+	assert.False(t, check.IfNil(globalSettingsFunc))
+	assert.Nil(t, err)
+}
+
+func TestDCTGlobalSettings_SetAndUnSetLimitedTransfer(t *testing.T) {
+	t.Parallel()
+
+	acnt := mock.NewUserAccount(vmcommon.SystemAccountAddress)
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return acnt, nil
+		},
+	}
+	setLimitedFunc, _ := NewDCTGlobalSettingsFunc(accounts, true, vmcommon.BuiltInFunctionDCTSetLimitedTransfer)
+	unSetLimitedFunc, _ := NewDCTGlobalSettingsFunc(accounts, false, vmcommon.BuiltInFunctionDCTUnSetLimitedTransfer)
+	pauseFunc, _ := NewDCTPauseFunc(accounts, true)
+
+	key := []byte("key")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: vmcommon.DCTSCAddress,
+			Arguments:  [][]byte{key},
+		},
+		RecipientAddr: vmcommon.SystemAccountAddress,
+	}
+	dctTokenKey := []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier + string(key))
+
+	_, err := pauseFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, err)
+	_, err = setLimitedFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, err)
+	assert.True(t, pauseFunc.IsLimitedTransfer(dctTokenKey))
+	assert.True(t, pauseFunc.IsPaused(dctTokenKey))
+
+	_, err = unSetLimitedFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, err)
+	assert.False(t, pauseFunc.IsLimitedTransfer(dctTokenKey))
+	assert.True(t, pauseFunc.IsPaused(dctTokenKey))
+}
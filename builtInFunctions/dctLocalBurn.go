@@ -11,20 +11,29 @@ import (
 
 type dctLocalBurn struct {
 	baseAlwaysActive
-	keyPrefix    []byte
-	marshalizer  vmcommon.Marshalizer
-	pauseHandler vmcommon.DCTPauseHandler
-	rolesHandler vmcommon.DCTRoleHandler
-	funcGasCost  uint64
-	mutExecution sync.RWMutex
+	baseGasRefund
+	keyPrefix            []byte
+	marshalizer          vmcommon.Marshalizer
+	pauseHandler         vmcommon.DCTPauseHandler
+	rolesHandler         vmcommon.DCTRoleHandler
+	supplyHandler        vmcommon.DCTSupplyHandler
+	funcGasCost          uint64
+	gasConfig            vmcommon.BaseOperationCost
+	lockedBalanceHandler vmcommon.DCTLockedBalanceHandler
+	mutExecution         sync.RWMutex
 }
 
 // NewDCTLocalBurnFunc returns the dct local burn built-in function component
 func NewDCTLocalBurnFunc(
 	funcGasCost uint64,
+	gasConfig vmcommon.BaseOperationCost,
 	marshalizer vmcommon.Marshalizer,
 	pauseHandler vmcommon.DCTPauseHandler,
 	rolesHandler vmcommon.DCTRoleHandler,
+	supplyHandler vmcommon.DCTSupplyHandler,
+	lockedBalanceHandler vmcommon.DCTLockedBalanceHandler,
+	gasRefundEnableEpoch uint32,
+	epochNotifier vmcommon.EpochNotifier,
 ) (*dctLocalBurn, error) {
 	if check.IfNil(marshalizer) {
 		return nil, ErrNilMarshalizer
@@ -35,15 +44,32 @@ func NewDCTLocalBurnFunc(
 	if check.IfNil(rolesHandler) {
 		return nil, ErrNilRolesHandler
 	}
+	if check.IfNil(supplyHandler) {
+		return nil, ErrNilSupplyHandler
+	}
+	if check.IfNil(lockedBalanceHandler) {
+		return nil, ErrNilLockedBalanceHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, ErrNilEpochHandler
+	}
 
 	e := &dctLocalBurn{
-		keyPrefix:    []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
-		marshalizer:  marshalizer,
-		pauseHandler: pauseHandler,
-		rolesHandler: rolesHandler,
-		funcGasCost:  funcGasCost,
-		mutExecution: sync.RWMutex{},
-	}
+		baseGasRefund: baseGasRefund{
+			function:             vmcommon.BuiltInFunctionDCTLocalBurn,
+			gasRefundEnableEpoch: gasRefundEnableEpoch,
+		},
+		keyPrefix:            []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
+		marshalizer:          marshalizer,
+		pauseHandler:         pauseHandler,
+		rolesHandler:         rolesHandler,
+		supplyHandler:        supplyHandler,
+		funcGasCost:          funcGasCost,
+		gasConfig:            gasConfig,
+		mutExecution:         sync.RWMutex{},
+		lockedBalanceHandler: lockedBalanceHandler,
+	}
+	epochNotifier.RegisterNotifyHandler(e)
 
 	return e, nil
 }
@@ -56,6 +82,7 @@ func (e *dctLocalBurn) SetNewGasConfig(gasCost *vmcommon.GasCost) {
 
 	e.mutExecution.Lock()
 	e.funcGasCost = gasCost.BuiltInCost.DCTLocalBurn
+	e.gasConfig = gasCost.BaseOperationCost
 	e.mutExecution.Unlock()
 }
 
@@ -80,12 +107,35 @@ func (e *dctLocalBurn) ProcessBuiltinFunction(
 
 	value := big.NewInt(0).SetBytes(vmInput.Arguments[1])
 	dctTokenKey := append(e.keyPrefix, tokenID...)
-	err = addToDCTBalance(acntSnd, dctTokenKey, big.NewInt(0).Neg(value), e.marshalizer, e.pauseHandler, vmInput.ReturnCallAfterError)
+	oldLength := uint64(0)
+	if e.flagGasRefund.IsSet() {
+		oldLength, err = getStoredLength(acntSnd, dctTokenKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = addToDCTBalance(acntSnd, dctTokenKey, big.NewInt(0).Neg(value), e.marshalizer, e.pauseHandler, e.lockedBalanceHandler, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.supplyHandler.UpdateSupply(tokenID, 0, big.NewInt(0).Neg(value))
 	if err != nil {
 		return nil, err
 	}
 
 	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: vmInput.GasProvided - e.funcGasCost}
+	if e.flagGasRefund.IsSet() {
+		newLength, errLength := getStoredLength(acntSnd, dctTokenKey)
+		if errLength != nil {
+			return nil, errLength
+		}
+
+		releasedBytes := computeReleasedBytes(oldLength, newLength)
+		if releasedBytes > 0 {
+			vmOutput.GasRefund = computeGasRefund(releasedBytes, e.gasConfig.ReleasePerByte, e.funcGasCost)
+		}
+	}
 
 	addDCTEntryInVMOutput(vmOutput, []byte(vmcommon.BuiltInFunctionDCTLocalBurn), vmInput.Arguments[0], value, vmInput.CallerAddr)
 
@@ -132,6 +182,7 @@ func checkInputArgumentsForLocalAction(
 	if value.Cmp(zero) <= 0 {
 		return ErrNegativeValue
 	}
+	traceGas(vmInput, gasComponentFunction, 1, funcGasCost)
 	if vmInput.GasProvided < funcGasCost {
 		return ErrNotEnoughGas
 	}
@@ -16,34 +16,55 @@ func TestNewDCTLocalBurnFunc(t *testing.T) {
 
 	tests := []struct {
 		name     string
-		argsFunc func() (c uint64, m vmcommon.Marshalizer, p vmcommon.DCTPauseHandler, r vmcommon.DCTRoleHandler)
+		argsFunc func() (c uint64, g vmcommon.BaseOperationCost, m vmcommon.Marshalizer, p vmcommon.DCTPauseHandler, r vmcommon.DCTRoleHandler, s vmcommon.DCTSupplyHandler, l vmcommon.DCTLockedBalanceHandler, e uint32, n vmcommon.EpochNotifier)
 		exError  error
 	}{
 		{
 			name: "NilMarshalizer",
-			argsFunc: func() (c uint64, m vmcommon.Marshalizer, p vmcommon.DCTPauseHandler, r vmcommon.DCTRoleHandler) {
-				return 0, nil, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{}
+			argsFunc: func() (c uint64, g vmcommon.BaseOperationCost, m vmcommon.Marshalizer, p vmcommon.DCTPauseHandler, r vmcommon.DCTRoleHandler, s vmcommon.DCTSupplyHandler, l vmcommon.DCTLockedBalanceHandler, e uint32, n vmcommon.EpochNotifier) {
+				return 0, vmcommon.BaseOperationCost{}, nil, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{}
 			},
 			exError: ErrNilMarshalizer,
 		},
 		{
 			name: "NilPauseHandler",
-			argsFunc: func() (c uint64, m vmcommon.Marshalizer, p vmcommon.DCTPauseHandler, r vmcommon.DCTRoleHandler) {
-				return 0, &mock.MarshalizerMock{}, nil, &mock.DCTRoleHandlerStub{}
+			argsFunc: func() (c uint64, g vmcommon.BaseOperationCost, m vmcommon.Marshalizer, p vmcommon.DCTPauseHandler, r vmcommon.DCTRoleHandler, s vmcommon.DCTSupplyHandler, l vmcommon.DCTLockedBalanceHandler, e uint32, n vmcommon.EpochNotifier) {
+				return 0, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, nil, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{}
 			},
 			exError: ErrNilPauseHandler,
 		},
 		{
 			name: "NilRolesHandler",
-			argsFunc: func() (c uint64, m vmcommon.Marshalizer, p vmcommon.DCTPauseHandler, r vmcommon.DCTRoleHandler) {
-				return 0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil
+			argsFunc: func() (c uint64, g vmcommon.BaseOperationCost, m vmcommon.Marshalizer, p vmcommon.DCTPauseHandler, r vmcommon.DCTRoleHandler, s vmcommon.DCTSupplyHandler, l vmcommon.DCTLockedBalanceHandler, e uint32, n vmcommon.EpochNotifier) {
+				return 0, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{}
 			},
 			exError: ErrNilRolesHandler,
 		},
+		{
+			name: "NilSupplyHandler",
+			argsFunc: func() (c uint64, g vmcommon.BaseOperationCost, m vmcommon.Marshalizer, p vmcommon.DCTPauseHandler, r vmcommon.DCTRoleHandler, s vmcommon.DCTSupplyHandler, l vmcommon.DCTLockedBalanceHandler, e uint32, n vmcommon.EpochNotifier) {
+				return 0, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{}, nil, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{}
+			},
+			exError: ErrNilSupplyHandler,
+		},
+		{
+			name: "NilLockedBalanceHandler",
+			argsFunc: func() (c uint64, g vmcommon.BaseOperationCost, m vmcommon.Marshalizer, p vmcommon.DCTPauseHandler, r vmcommon.DCTRoleHandler, s vmcommon.DCTSupplyHandler, l vmcommon.DCTLockedBalanceHandler, e uint32, n vmcommon.EpochNotifier) {
+				return 0, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, nil, 0, &mock.EpochNotifierStub{}
+			},
+			exError: ErrNilLockedBalanceHandler,
+		},
+		{
+			name: "NilEpochNotifier",
+			argsFunc: func() (c uint64, g vmcommon.BaseOperationCost, m vmcommon.Marshalizer, p vmcommon.DCTPauseHandler, r vmcommon.DCTRoleHandler, s vmcommon.DCTSupplyHandler, l vmcommon.DCTLockedBalanceHandler, e uint32, n vmcommon.EpochNotifier) {
+				return 0, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{}, 0, nil
+			},
+			exError: ErrNilEpochHandler,
+		},
 		{
 			name: "Ok",
-			argsFunc: func() (c uint64, m vmcommon.Marshalizer, p vmcommon.DCTPauseHandler, r vmcommon.DCTRoleHandler) {
-				return 0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{}
+			argsFunc: func() (c uint64, g vmcommon.BaseOperationCost, m vmcommon.Marshalizer, p vmcommon.DCTPauseHandler, r vmcommon.DCTRoleHandler, s vmcommon.DCTSupplyHandler, l vmcommon.DCTLockedBalanceHandler, e uint32, n vmcommon.EpochNotifier) {
+				return 0, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{}
 			},
 			exError: nil,
 		},
@@ -60,7 +81,7 @@ func TestNewDCTLocalBurnFunc(t *testing.T) {
 func TestDctLocalBurn_ProcessBuiltinFunction_CalledWithValueShouldErr(t *testing.T) {
 	t.Parallel()
 
-	dctLocalBurnF, _ := NewDCTLocalBurnFunc(0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{})
+	dctLocalBurnF, _ := NewDCTLocalBurnFunc(0, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
 
 	_, err := dctLocalBurnF.ProcessBuiltinFunction(&mock.AccountWrapMock{}, &mock.AccountWrapMock{}, &vmcommon.ContractCallInput{
 		VMInput: vmcommon.VMInput{
@@ -74,11 +95,11 @@ func TestDctLocalBurn_ProcessBuiltinFunction_CheckAllowToExecuteShouldErr(t *tes
 	t.Parallel()
 
 	localErr := errors.New("local err")
-	dctLocalBurnF, _ := NewDCTLocalBurnFunc(0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{
+	dctLocalBurnF, _ := NewDCTLocalBurnFunc(0, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{
 		CheckAllowedToExecuteCalled: func(account vmcommon.UserAccountHandler, tokenID []byte, action []byte) error {
 			return localErr
 		},
-	})
+	}, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
 
 	_, err := dctLocalBurnF.ProcessBuiltinFunction(&mock.AccountWrapMock{}, &mock.AccountWrapMock{}, &vmcommon.ContractCallInput{
 		VMInput: vmcommon.VMInput{
@@ -92,11 +113,11 @@ func TestDctLocalBurn_ProcessBuiltinFunction_CheckAllowToExecuteShouldErr(t *tes
 func TestDctLocalBurn_ProcessBuiltinFunction_CannotAddToDctBalanceShouldErr(t *testing.T) {
 	t.Parallel()
 
-	dctLocalBurnF, _ := NewDCTLocalBurnFunc(0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{
+	dctLocalBurnF, _ := NewDCTLocalBurnFunc(0, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{
 		CheckAllowedToExecuteCalled: func(account vmcommon.UserAccountHandler, tokenID []byte, action []byte) error {
 			return nil
 		},
-	})
+	}, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{}, 1, &mock.EpochNotifierStub{})
 
 	localErr := errors.New("local err")
 	_, err := dctLocalBurnF.ProcessBuiltinFunction(&mock.UserAccountStub{
@@ -116,15 +137,38 @@ func TestDctLocalBurn_ProcessBuiltinFunction_CannotAddToDctBalanceShouldErr(t *t
 	require.Equal(t, ErrInsufficientFunds, err)
 }
 
+func TestDctLocalBurn_ProcessBuiltinFunction_RetrieveValueErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dctLocalBurnF, _ := NewDCTLocalBurnFunc(0, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
+
+	localErr := errors.New("local err")
+	_, err := dctLocalBurnF.ProcessBuiltinFunction(&mock.UserAccountStub{
+		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
+			return &mock.DataTrieTrackerStub{
+				RetrieveValueCalled: func(key []byte) ([]byte, error) {
+					return nil, localErr
+				},
+			}
+		},
+	}, &mock.AccountWrapMock{}, &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue: big.NewInt(0),
+			Arguments: [][]byte{[]byte("arg1"), []byte("arg2")},
+		},
+	})
+	require.Equal(t, localErr, err)
+}
+
 func TestDctLocalBurn_ProcessBuiltinFunction_ShouldWork(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	dctLocalBurnF, _ := NewDCTLocalBurnFunc(50, marshalizer, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{
+	dctLocalBurnF, _ := NewDCTLocalBurnFunc(50, vmcommon.BaseOperationCost{}, marshalizer, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{
 		CheckAllowedToExecuteCalled: func(account vmcommon.UserAccountHandler, tokenID []byte, action []byte) error {
 			return nil
 		},
-	})
+	}, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
 
 	sndAccout := &mock.UserAccountStub{
 		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
@@ -169,7 +213,7 @@ func TestDctLocalBurn_ProcessBuiltinFunction_ShouldWork(t *testing.T) {
 func TestDctLocalBurn_SetNewGasConfig(t *testing.T) {
 	t.Parallel()
 
-	dctLocalBurnF, _ := NewDCTLocalBurnFunc(0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{})
+	dctLocalBurnF, _ := NewDCTLocalBurnFunc(0, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
 
 	dctLocalBurnF.SetNewGasConfig(&vmcommon.GasCost{BuiltInCost: vmcommon.BuiltInCost{
 		DCTLocalBurn: 500},
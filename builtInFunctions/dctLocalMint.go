@@ -11,12 +11,14 @@ import (
 
 type dctLocalMint struct {
 	baseAlwaysActive
-	keyPrefix    []byte
-	marshalizer  vmcommon.Marshalizer
-	pauseHandler vmcommon.DCTPauseHandler
-	rolesHandler vmcommon.DCTRoleHandler
-	funcGasCost  uint64
-	mutExecution sync.RWMutex
+	keyPrefix            []byte
+	marshalizer          vmcommon.Marshalizer
+	pauseHandler         vmcommon.DCTPauseHandler
+	rolesHandler         vmcommon.DCTRoleHandler
+	supplyHandler        vmcommon.DCTSupplyHandler
+	funcGasCost          uint64
+	lockedBalanceHandler vmcommon.DCTLockedBalanceHandler
+	mutExecution         sync.RWMutex
 }
 
 // NewDCTLocalMintFunc returns the dct local mint built-in function component
@@ -25,6 +27,8 @@ func NewDCTLocalMintFunc(
 	marshalizer vmcommon.Marshalizer,
 	pauseHandler vmcommon.DCTPauseHandler,
 	rolesHandler vmcommon.DCTRoleHandler,
+	supplyHandler vmcommon.DCTSupplyHandler,
+	lockedBalanceHandler vmcommon.DCTLockedBalanceHandler,
 ) (*dctLocalMint, error) {
 	if check.IfNil(marshalizer) {
 		return nil, ErrNilMarshalizer
@@ -35,14 +39,22 @@ func NewDCTLocalMintFunc(
 	if check.IfNil(rolesHandler) {
 		return nil, ErrNilRolesHandler
 	}
+	if check.IfNil(supplyHandler) {
+		return nil, ErrNilSupplyHandler
+	}
+	if check.IfNil(lockedBalanceHandler) {
+		return nil, ErrNilLockedBalanceHandler
+	}
 
 	e := &dctLocalMint{
-		keyPrefix:    []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
-		marshalizer:  marshalizer,
-		pauseHandler: pauseHandler,
-		rolesHandler: rolesHandler,
-		funcGasCost:  funcGasCost,
-		mutExecution: sync.RWMutex{},
+		keyPrefix:            []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
+		marshalizer:          marshalizer,
+		pauseHandler:         pauseHandler,
+		rolesHandler:         rolesHandler,
+		supplyHandler:        supplyHandler,
+		funcGasCost:          funcGasCost,
+		mutExecution:         sync.RWMutex{},
+		lockedBalanceHandler: lockedBalanceHandler,
 	}
 
 	return e, nil
@@ -83,8 +95,18 @@ func (e *dctLocalMint) ProcessBuiltinFunction(
 	}
 
 	value := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	err = e.supplyHandler.UseMintAllowance(tokenID, value)
+	if err != nil {
+		return nil, err
+	}
+
 	dctTokenKey := append(e.keyPrefix, tokenID...)
-	err = addToDCTBalance(acntSnd, dctTokenKey, big.NewInt(0).Set(value), e.marshalizer, e.pauseHandler, vmInput.ReturnCallAfterError)
+	err = addToDCTBalance(acntSnd, dctTokenKey, big.NewInt(0).Set(value), e.marshalizer, e.pauseHandler, e.lockedBalanceHandler, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.supplyHandler.UpdateSupply(tokenID, 0, value)
 	if err != nil {
 		return nil, err
 	}
@@ -16,34 +16,48 @@ func TestNewDCTLocalMintFunc(t *testing.T) {
 
 	tests := []struct {
 		name     string
-		argsFunc func() (c uint64, m vmcommon.Marshalizer, p vmcommon.DCTPauseHandler, r vmcommon.DCTRoleHandler)
+		argsFunc func() (c uint64, m vmcommon.Marshalizer, p vmcommon.DCTPauseHandler, r vmcommon.DCTRoleHandler, s vmcommon.DCTSupplyHandler, l vmcommon.DCTLockedBalanceHandler)
 		exError  error
 	}{
 		{
 			name: "NilMarshalizer",
-			argsFunc: func() (c uint64, m vmcommon.Marshalizer, p vmcommon.DCTPauseHandler, r vmcommon.DCTRoleHandler) {
-				return 0, nil, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{}
+			argsFunc: func() (c uint64, m vmcommon.Marshalizer, p vmcommon.DCTPauseHandler, r vmcommon.DCTRoleHandler, s vmcommon.DCTSupplyHandler, l vmcommon.DCTLockedBalanceHandler) {
+				return 0, nil, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{}
 			},
 			exError: ErrNilMarshalizer,
 		},
 		{
 			name: "NilPauseHandler",
-			argsFunc: func() (c uint64, m vmcommon.Marshalizer, p vmcommon.DCTPauseHandler, r vmcommon.DCTRoleHandler) {
-				return 0, &mock.MarshalizerMock{}, nil, &mock.DCTRoleHandlerStub{}
+			argsFunc: func() (c uint64, m vmcommon.Marshalizer, p vmcommon.DCTPauseHandler, r vmcommon.DCTRoleHandler, s vmcommon.DCTSupplyHandler, l vmcommon.DCTLockedBalanceHandler) {
+				return 0, &mock.MarshalizerMock{}, nil, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{}
 			},
 			exError: ErrNilPauseHandler,
 		},
 		{
 			name: "NilRolesHandler",
-			argsFunc: func() (c uint64, m vmcommon.Marshalizer, p vmcommon.DCTPauseHandler, r vmcommon.DCTRoleHandler) {
-				return 0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil
+			argsFunc: func() (c uint64, m vmcommon.Marshalizer, p vmcommon.DCTPauseHandler, r vmcommon.DCTRoleHandler, s vmcommon.DCTSupplyHandler, l vmcommon.DCTLockedBalanceHandler) {
+				return 0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{}
 			},
 			exError: ErrNilRolesHandler,
 		},
+		{
+			name: "NilSupplyHandler",
+			argsFunc: func() (c uint64, m vmcommon.Marshalizer, p vmcommon.DCTPauseHandler, r vmcommon.DCTRoleHandler, s vmcommon.DCTSupplyHandler, l vmcommon.DCTLockedBalanceHandler) {
+				return 0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{}, nil, &mock.LockedBalanceHandlerStub{}
+			},
+			exError: ErrNilSupplyHandler,
+		},
+		{
+			name: "NilLockedBalanceHandler",
+			argsFunc: func() (c uint64, m vmcommon.Marshalizer, p vmcommon.DCTPauseHandler, r vmcommon.DCTRoleHandler, s vmcommon.DCTSupplyHandler, l vmcommon.DCTLockedBalanceHandler) {
+				return 0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, nil
+			},
+			exError: ErrNilLockedBalanceHandler,
+		},
 		{
 			name: "Ok",
-			argsFunc: func() (c uint64, m vmcommon.Marshalizer, p vmcommon.DCTPauseHandler, r vmcommon.DCTRoleHandler) {
-				return 0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{}
+			argsFunc: func() (c uint64, m vmcommon.Marshalizer, p vmcommon.DCTPauseHandler, r vmcommon.DCTRoleHandler, s vmcommon.DCTSupplyHandler, l vmcommon.DCTLockedBalanceHandler) {
+				return 0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{}
 			},
 			exError: nil,
 		},
@@ -60,7 +74,7 @@ func TestNewDCTLocalMintFunc(t *testing.T) {
 func TestDctLocalMint_SetNewGasConfig(t *testing.T) {
 	t.Parallel()
 
-	dctLocalMintF, _ := NewDCTLocalMintFunc(0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{})
+	dctLocalMintF, _ := NewDCTLocalMintFunc(0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{})
 
 	dctLocalMintF.SetNewGasConfig(&vmcommon.GasCost{BuiltInCost: vmcommon.BuiltInCost{
 		DCTLocalMint: 500},
@@ -72,7 +86,7 @@ func TestDctLocalMint_SetNewGasConfig(t *testing.T) {
 func TestDctLocalMint_ProcessBuiltinFunction_CalledWithValueShouldErr(t *testing.T) {
 	t.Parallel()
 
-	dctLocalMintF, _ := NewDCTLocalMintFunc(0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{})
+	dctLocalMintF, _ := NewDCTLocalMintFunc(0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{})
 
 	_, err := dctLocalMintF.ProcessBuiltinFunction(&mock.AccountWrapMock{}, &mock.AccountWrapMock{}, &vmcommon.ContractCallInput{
 		VMInput: vmcommon.VMInput{
@@ -90,7 +104,7 @@ func TestDctLocalMint_ProcessBuiltinFunction_CheckAllowToExecuteShouldErr(t *tes
 		CheckAllowedToExecuteCalled: func(account vmcommon.UserAccountHandler, tokenID []byte, action []byte) error {
 			return localErr
 		},
-	})
+	}, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{})
 
 	_, err := dctLocalMintF.ProcessBuiltinFunction(&mock.AccountWrapMock{}, &mock.AccountWrapMock{}, &vmcommon.ContractCallInput{
 		VMInput: vmcommon.VMInput{
@@ -108,7 +122,7 @@ func TestDctLocalMint_ProcessBuiltinFunction_CannotAddToDctBalanceShouldErr(t *t
 		CheckAllowedToExecuteCalled: func(account vmcommon.UserAccountHandler, tokenID []byte, action []byte) error {
 			return nil
 		},
-	})
+	}, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{})
 
 	localErr := errors.New("local err")
 	_, err := dctLocalMintF.ProcessBuiltinFunction(&mock.UserAccountStub{
@@ -139,7 +153,7 @@ func TestDctLocalMint_ProcessBuiltinFunction_ShouldWork(t *testing.T) {
 		CheckAllowedToExecuteCalled: func(account vmcommon.UserAccountHandler, tokenID []byte, action []byte) error {
 			return nil
 		},
-	})
+	}, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{})
 
 	sndAccout := &mock.UserAccountStub{
 		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
@@ -192,3 +206,37 @@ func TestDctLocalMint_ProcessBuiltinFunction_ShouldWork(t *testing.T) {
 	require.True(t, errors.Is(err, ErrInvalidArguments))
 	require.Nil(t, vmOutput)
 }
+
+func TestDctLocalMint_ProcessBuiltinFunction_OverMaxSupplyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	supplyHandler, systemAccount := createSupplyHandlerWithSystemAccount()
+	_ = systemAccount.AccountDataHandler().SaveKeyValue(getMaxSupplyKey([]byte("token")), big.NewInt(1000).Bytes())
+	_ = systemAccount.AccountDataHandler().SaveKeyValue(getMintAllowanceKey([]byte("token")), big.NewInt(100).Bytes())
+
+	marshalizer := &mock.MarshalizerMock{}
+	dctLocalMintF, _ := NewDCTLocalMintFunc(50, marshalizer, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{}, supplyHandler, &mock.LockedBalanceHandlerStub{})
+	sndAccount := mock.NewUserAccount([]byte("snd"))
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			Arguments:   [][]byte{[]byte("token"), big.NewInt(60).Bytes()},
+			GasProvided: 500,
+		},
+	}
+
+	_, err := dctLocalMintF.ProcessBuiltinFunction(sndAccount, nil, input)
+	require.Nil(t, err)
+
+	input.Arguments[1] = big.NewInt(41).Bytes()
+	vmOutput, err := dctLocalMintF.ProcessBuiltinFunction(sndAccount, nil, input)
+	require.Equal(t, ErrMaxSupplyExceeded, err)
+	require.Nil(t, vmOutput)
+
+	input.Arguments[1] = big.NewInt(40).Bytes()
+	_, err = dctLocalMintF.ProcessBuiltinFunction(sndAccount, nil, input)
+	require.Nil(t, err)
+
+	supply, _ := supplyHandler.GetSupply([]byte("token"), 0)
+	require.Equal(t, big.NewInt(100), supply.Supply)
+}
@@ -0,0 +1,216 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"sync"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+)
+
+// maxNumLockedAmounts is the maximum number of pending locked entries, each with a different unlock epoch, a token
+// balance can hold. It bounds the reserved field anyone can grow through locked transfers
+const maxNumLockedAmounts = 100
+
+type dctLockedBalance struct {
+	marshalizer  vmcommon.Marshalizer
+	keyPrefix    []byte
+	currentEpoch uint32
+	mutEpoch     sync.RWMutex
+}
+
+// NewDCTLockedBalanceHandler returns the component which keeps the locked amounts of the dct tokens. The locked
+// amounts are saved in the reserved field of the token data and are released once their unlock epoch is confirmed
+func NewDCTLockedBalanceHandler(
+	marshalizer vmcommon.Marshalizer,
+	epochNotifier vmcommon.EpochNotifier,
+) (*dctLockedBalance, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, ErrNilEpochHandler
+	}
+
+	e := &dctLockedBalance{
+		marshalizer: marshalizer,
+		keyPrefix:   []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (e *dctLockedBalance) EpochConfirmed(epoch uint32, _ uint64) {
+	e.mutEpoch.Lock()
+	e.currentEpoch = epoch
+	e.mutEpoch.Unlock()
+}
+
+func (e *dctLockedBalance) getCurrentEpoch() uint32 {
+	e.mutEpoch.RLock()
+	defer e.mutEpoch.RUnlock()
+
+	return e.currentEpoch
+}
+
+// AddLockedBalance locks the value until the given epoch. The value must already be part of the token balance.
+// Amounts which are already unlocked are released, so the reserved field holds only the pending ones. Values sharing
+// the unlock epoch are merged into one entry and at most maxNumLockedAmounts entries can be pending
+func (e *dctLockedBalance) AddLockedBalance(dctData *dct.DCToken, value *big.Int, unlockEpoch uint32) error {
+	lockedBalance, err := e.getPendingLockedAmounts(dctData)
+	if err != nil {
+		return err
+	}
+
+	if unlockEpoch > e.getCurrentEpoch() && value.Cmp(zero) > 0 {
+		lockedBalance.LockedAmounts, err = addLockedAmount(lockedBalance.LockedAmounts, value, unlockEpoch)
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.saveLockedAmounts(dctData, lockedBalance)
+}
+
+func addLockedAmount(lockedAmounts []*dct.DCTLockedAmount, value *big.Int, unlockEpoch uint32) ([]*dct.DCTLockedAmount, error) {
+	for _, lockedAmount := range lockedAmounts {
+		if lockedAmount.UnlockEpoch == unlockEpoch {
+			lockedAmount.Value.Add(lockedAmount.Value, value)
+			return lockedAmounts, nil
+		}
+	}
+	if len(lockedAmounts) >= maxNumLockedAmounts {
+		return nil, ErrTooManyLockedAmounts
+	}
+
+	return append(lockedAmounts, &dct.DCTLockedAmount{
+		Value:       big.NewInt(0).Set(value),
+		UnlockEpoch: unlockEpoch,
+	}), nil
+}
+
+// GetLockedBalance returns the amount of the token balance which can not be spent in the current epoch
+func (e *dctLockedBalance) GetLockedBalance(dctData *dct.DCToken) (*big.Int, error) {
+	lockedBalance, err := e.getPendingLockedAmounts(dctData)
+	if err != nil {
+		return nil, err
+	}
+
+	locked := big.NewInt(0)
+	for _, lockedAmount := range lockedBalance.LockedAmounts {
+		locked.Add(locked, lockedAmount.Value)
+	}
+
+	return locked, nil
+}
+
+// ClaimUnlocked removes the amounts which are already unlocked from the reserved field and returns their sum
+func (e *dctLockedBalance) ClaimUnlocked(dctData *dct.DCToken) (*big.Int, error) {
+	lockedBalance, err := e.unmarshalLockedAmounts(dctData)
+	if err != nil {
+		return nil, err
+	}
+
+	currentEpoch := e.getCurrentEpoch()
+	unlocked := big.NewInt(0)
+	pending := make([]*dct.DCTLockedAmount, 0, len(lockedBalance.LockedAmounts))
+	for _, lockedAmount := range lockedBalance.LockedAmounts {
+		if lockedAmount.UnlockEpoch > currentEpoch {
+			pending = append(pending, lockedAmount)
+			continue
+		}
+		unlocked.Add(unlocked, lockedAmount.Value)
+	}
+
+	lockedBalance.LockedAmounts = pending
+	err = e.saveLockedAmounts(dctData, lockedBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	return unlocked, nil
+}
+
+// GetSpendableAndLockedBalance returns the spendable and the locked balance of the token held by the account
+func (e *dctLockedBalance) GetSpendableAndLockedBalance(acnt vmcommon.UserAccountHandler, tokenID []byte) (*big.Int, *big.Int, error) {
+	if check.IfNil(acnt) {
+		return nil, nil, ErrNilUserAccount
+	}
+
+	dctData, err := getDCTDataFromKey(acnt, append(e.keyPrefix, tokenID...), e.marshalizer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	locked, err := e.GetLockedBalance(dctData)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	spendable := big.NewInt(0).Sub(dctData.Value, locked)
+	if spendable.Cmp(zero) < 0 {
+		spendable.SetUint64(0)
+	}
+
+	return spendable, locked, nil
+}
+
+func (e *dctLockedBalance) getPendingLockedAmounts(dctData *dct.DCToken) (*dct.DCTLockedBalance, error) {
+	lockedBalance, err := e.unmarshalLockedAmounts(dctData)
+	if err != nil {
+		return nil, err
+	}
+
+	currentEpoch := e.getCurrentEpoch()
+	pending := make([]*dct.DCTLockedAmount, 0, len(lockedBalance.LockedAmounts))
+	for _, lockedAmount := range lockedBalance.LockedAmounts {
+		if lockedAmount.UnlockEpoch > currentEpoch {
+			pending = append(pending, lockedAmount)
+		}
+	}
+	lockedBalance.LockedAmounts = pending
+
+	return lockedBalance, nil
+}
+
+func (e *dctLockedBalance) unmarshalLockedAmounts(dctData *dct.DCToken) (*dct.DCTLockedBalance, error) {
+	if dctData == nil {
+		return nil, ErrNilDCTData
+	}
+
+	lockedBalance := &dct.DCTLockedBalance{}
+	if len(dctData.Reserved) == 0 {
+		return lockedBalance, nil
+	}
+
+	err := e.marshalizer.Unmarshal(lockedBalance, dctData.Reserved)
+	if err != nil {
+		return nil, err
+	}
+
+	return lockedBalance, nil
+}
+
+func (e *dctLockedBalance) saveLockedAmounts(dctData *dct.DCToken, lockedBalance *dct.DCTLockedBalance) error {
+	if len(lockedBalance.LockedAmounts) == 0 {
+		dctData.Reserved = nil
+		return nil
+	}
+
+	marshaledData, err := e.marshalizer.Marshal(lockedBalance)
+	if err != nil {
+		return err
+	}
+
+	dctData.Reserved = marshaledData
+	return nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *dctLockedBalance) IsInterfaceNil() bool {
+	return e == nil
+}
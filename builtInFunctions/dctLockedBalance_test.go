@@ -0,0 +1,130 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDCTLockedBalanceHandler(t *testing.T) {
+	t.Parallel()
+
+	e, err := NewDCTLockedBalanceHandler(nil, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	e, err = NewDCTLockedBalanceHandler(&mock.MarshalizerMock{}, nil)
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilEpochHandler, err)
+
+	e, err = NewDCTLockedBalanceHandler(&mock.MarshalizerMock{}, &mock.EpochNotifierStub{})
+	assert.False(t, check.IfNil(e))
+	assert.Nil(t, err)
+}
+
+func TestDctLockedBalance_AddAndGetLockedBalance(t *testing.T) {
+	t.Parallel()
+
+	e, _ := NewDCTLockedBalanceHandler(&mock.MarshalizerMock{}, &mock.EpochNotifierStub{})
+	e.EpochConfirmed(5, 0)
+	dctData := &dct.DCToken{Value: big.NewInt(100)}
+
+	locked, err := e.GetLockedBalance(dctData)
+	require.Nil(t, err)
+	assert.Equal(t, 0, locked.Sign())
+
+	_, err = e.GetLockedBalance(nil)
+	assert.Equal(t, ErrNilDCTData, err)
+
+	require.Nil(t, e.AddLockedBalance(dctData, big.NewInt(10), 7))
+	require.Nil(t, e.AddLockedBalance(dctData, big.NewInt(20), 7))
+	require.Nil(t, e.AddLockedBalance(dctData, big.NewInt(30), 9))
+	require.Nil(t, e.AddLockedBalance(dctData, big.NewInt(40), 5))
+
+	lockedBalance := &dct.DCTLockedBalance{}
+	_ = e.marshalizer.Unmarshal(lockedBalance, dctData.Reserved)
+	assert.Equal(t, 2, len(lockedBalance.LockedAmounts))
+
+	locked, _ = e.GetLockedBalance(dctData)
+	assert.Equal(t, big.NewInt(60), locked)
+
+	e.EpochConfirmed(7, 0)
+	locked, _ = e.GetLockedBalance(dctData)
+	assert.Equal(t, big.NewInt(30), locked)
+
+	e.EpochConfirmed(9, 0)
+	locked, _ = e.GetLockedBalance(dctData)
+	assert.Equal(t, 0, locked.Sign())
+}
+
+func TestDctLockedBalance_AddLockedBalanceShouldCapTheNumberOfEntries(t *testing.T) {
+	t.Parallel()
+
+	e, _ := NewDCTLockedBalanceHandler(&mock.MarshalizerMock{}, &mock.EpochNotifierStub{})
+	dctData := &dct.DCToken{Value: big.NewInt(1000)}
+	for unlockEpoch := uint32(1); unlockEpoch <= maxNumLockedAmounts; unlockEpoch++ {
+		require.Nil(t, e.AddLockedBalance(dctData, big.NewInt(1), unlockEpoch))
+	}
+
+	err := e.AddLockedBalance(dctData, big.NewInt(1), maxNumLockedAmounts+1)
+	assert.Equal(t, ErrTooManyLockedAmounts, err)
+
+	// an existing unlock epoch is merged, while released entries free their slots
+	assert.Nil(t, e.AddLockedBalance(dctData, big.NewInt(1), 1))
+	e.EpochConfirmed(1, 0)
+	assert.Nil(t, e.AddLockedBalance(dctData, big.NewInt(1), maxNumLockedAmounts+1))
+}
+
+func TestDctLockedBalance_ClaimUnlocked(t *testing.T) {
+	t.Parallel()
+
+	e, _ := NewDCTLockedBalanceHandler(&mock.MarshalizerMock{}, &mock.EpochNotifierStub{})
+	dctData := &dct.DCToken{Value: big.NewInt(100)}
+	_ = e.AddLockedBalance(dctData, big.NewInt(10), 2)
+	_ = e.AddLockedBalance(dctData, big.NewInt(30), 4)
+
+	unlocked, err := e.ClaimUnlocked(dctData)
+	require.Nil(t, err)
+	assert.Equal(t, 0, unlocked.Sign())
+
+	e.EpochConfirmed(3, 0)
+	unlocked, err = e.ClaimUnlocked(dctData)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(10), unlocked)
+	locked, _ := e.GetLockedBalance(dctData)
+	assert.Equal(t, big.NewInt(30), locked)
+
+	e.EpochConfirmed(4, 0)
+	unlocked, err = e.ClaimUnlocked(dctData)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(30), unlocked)
+	assert.Nil(t, dctData.Reserved)
+}
+
+func TestDctLockedBalance_GetSpendableAndLockedBalance(t *testing.T) {
+	t.Parallel()
+
+	e, _ := NewDCTLockedBalanceHandler(&mock.MarshalizerMock{}, &mock.EpochNotifierStub{})
+	_, _, err := e.GetSpendableAndLockedBalance(nil, []byte("TKN"))
+	assert.Equal(t, ErrNilUserAccount, err)
+
+	acnt := mock.NewUserAccount([]byte("addr"))
+	dctData := &dct.DCToken{Value: big.NewInt(100)}
+	_ = e.AddLockedBalance(dctData, big.NewInt(40), 2)
+	_ = saveDCTData(acnt, dctData, append(keyPrefix, []byte("TKN")...), e.marshalizer)
+
+	spendable, locked, err := e.GetSpendableAndLockedBalance(acnt, []byte("TKN"))
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(60), spendable)
+	assert.Equal(t, big.NewInt(40), locked)
+
+	e.EpochConfirmed(2, 0)
+	spendable, locked, _ = e.GetSpendableAndLockedBalance(acnt, []byte("TKN"))
+	assert.Equal(t, big.NewInt(100), spendable)
+	assert.Equal(t, 0, locked.Sign())
+}
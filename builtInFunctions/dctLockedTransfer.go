@@ -0,0 +1,266 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+	"sync"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/atomic"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+)
+
+type dctLockedTransfer struct {
+	*baseEnabled
+	funcGasCost           uint64
+	marshalizer           vmcommon.Marshalizer
+	keyPrefix             []byte
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler
+	rolesHandler          vmcommon.DCTRoleHandler
+	lockedBalanceHandler  vmcommon.DCTLockedBalanceHandler
+	payableHandler        vmcommon.PayableHandler
+	shardCoordinator      vmcommon.Coordinator
+	gasConfig             vmcommon.BaseOperationCost
+	mutExecution          sync.RWMutex
+}
+
+// NewDCTLockedTransferFunc returns the dct locked transfer built-in function component
+func NewDCTLockedTransferFunc(
+	funcGasCost uint64,
+	marshalizer vmcommon.Marshalizer,
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler,
+	rolesHandler vmcommon.DCTRoleHandler,
+	shardCoordinator vmcommon.Coordinator,
+	gasConfig vmcommon.BaseOperationCost,
+	lockedBalanceHandler vmcommon.DCTLockedBalanceHandler,
+	activationEpoch uint32,
+	epochNotifier vmcommon.EpochNotifier,
+) (*dctLockedTransfer, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(globalSettingsHandler) {
+		return nil, ErrNilGlobalSettingsHandler
+	}
+	if check.IfNil(rolesHandler) {
+		return nil, ErrNilRolesHandler
+	}
+	if check.IfNil(shardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+	if check.IfNil(lockedBalanceHandler) {
+		return nil, ErrNilLockedBalanceHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, ErrNilEpochHandler
+	}
+
+	e := &dctLockedTransfer{
+		funcGasCost:           funcGasCost,
+		marshalizer:           marshalizer,
+		keyPrefix:             []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
+		globalSettingsHandler: globalSettingsHandler,
+		rolesHandler:          rolesHandler,
+		lockedBalanceHandler:  lockedBalanceHandler,
+		payableHandler:        &disabledPayableHandler{},
+		shardCoordinator:      shardCoordinator,
+		gasConfig:             gasConfig,
+		mutExecution:          sync.RWMutex{},
+	}
+
+	e.baseEnabled = &baseEnabled{
+		function:        vmcommon.BuiltInFunctionDCTLockedTransfer,
+		activationEpoch: activationEpoch,
+		flagActivated:   atomic.Flag{},
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetPayableHandler will set the payable handler to the function
+func (e *dctLockedTransfer) SetPayableHandler(payableHandler vmcommon.PayableHandler) error {
+	if check.IfNil(payableHandler) {
+		return ErrNilPayableHandler
+	}
+
+	e.payableHandler = payableHandler
+	return nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *dctLockedTransfer) SetNewGasConfig(gasCost *vmcommon.GasCost) {
+	if gasCost == nil {
+		return
+	}
+
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.DCTLockedTransfer
+	e.gasConfig = gasCost.BaseOperationCost
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves DCT locked transfer function call. The value is debited from the spendable
+// balance of the sender and the receiver can not spend it before the unlock epoch. An unlock epoch which is
+// already reached on the receiver shard credits a spendable value, as does the refund of a failed call. The sender pays, besides the function cost, the
+// storage of the new locked entry which grows the reserved field of the receiver token data
+// Requires 3 arguments:
+// arg0 - token identifier
+// arg1 - value to transfer
+// arg2 - unlock epoch
+func (e *dctLockedTransfer) ProcessBuiltinFunction(
+	acntSnd, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	err := checkBasicDCTArguments(vmInput)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) != 3 {
+		return nil, ErrInvalidArguments
+	}
+	if bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return nil, ErrInvalidRcvAddr
+	}
+	if e.shardCoordinator.ComputeId(vmInput.RecipientAddr) == vmcommon.MetachainShardId {
+		return nil, ErrInvalidRcvAddr
+	}
+
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	if value.Cmp(zero) <= 0 {
+		return nil, ErrNegativeValue
+	}
+	unlockEpochValue := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	if !unlockEpochValue.IsUint64() || unlockEpochValue.Uint64() > uint64(^uint32(0)) {
+		return nil, ErrInvalidUnlockEpoch
+	}
+	unlockEpoch := uint32(unlockEpochValue.Uint64())
+
+	lockedEntryLength, err := e.getLockedEntryLength(value, unlockEpoch)
+	if err != nil {
+		return nil, err
+	}
+	gasToUse := e.funcGasCost + lockedEntryLength*e.gasConfig.StorePerByte
+
+	tokenID := vmInput.Arguments[0]
+	dctTokenKey := append(e.keyPrefix, tokenID...)
+	if !check.IfNil(acntSnd) {
+		// gas is paid only by sender
+		traceGas(vmInput, gasComponentFunction, 1, e.funcGasCost)
+		traceGas(vmInput, gasComponentStorePerByte, lockedEntryLength, e.gasConfig.StorePerByte)
+		if vmInput.GasProvided < gasToUse {
+			return nil, ErrNotEnoughGas
+		}
+
+		err = checkLimitedTransfer(acntSnd, tokenID, dctTokenKey, e.globalSettingsHandler, e.rolesHandler, vmInput.ReturnCallAfterError)
+		if err != nil {
+			return nil, err
+		}
+
+		err = addToDCTBalance(acntSnd, dctTokenKey, big.NewInt(0).Neg(value), e.marshalizer, e.globalSettingsHandler, e.lockedBalanceHandler, vmInput.ReturnCallAfterError)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		GasRemaining: computeGasRemaining(acntSnd, vmInput.GasProvided, gasToUse),
+		ReturnCode:   vmcommon.Ok,
+	}
+	if !check.IfNil(acntDst) {
+		err = e.addLockedToDestination(acntDst, vmInput, dctTokenKey, value, unlockEpoch)
+		if err != nil {
+			return nil, err
+		}
+
+		if vmInput.CallType == vmcommon.AsynchronousCallBack && check.IfNil(acntSnd) {
+			// gas was already consumed on sender shard
+			vmOutput.GasRemaining = vmInput.GasProvided
+		}
+	} else if vmcommon.IsSmartContractAddress(vmInput.CallerAddr) {
+		// cross-shard DCT locked transfer call through a smart contract
+		addOutputTransferToVMOutput(
+			vmInput.CallerAddr,
+			vmcommon.BuiltInFunctionDCTLockedTransfer,
+			vmInput.Arguments,
+			vmInput.RecipientAddr,
+			vmInput.GasLocked,
+			vmInput.CallType,
+			vmOutput)
+	}
+
+	logEntry := newEntryForDCT([]byte(vmcommon.BuiltInFunctionDCTLockedTransfer), tokenID, value, vmInput.CallerAddr, vmInput.RecipientAddr)
+	logEntry.Topics = append(logEntry.Topics, vmInput.Arguments[2])
+	vmOutput.Logs = append(vmOutput.Logs, logEntry)
+
+	return vmOutput, nil
+}
+
+// getLockedEntryLength returns the length of a new locked entry, which is the most the reserved field of the receiver
+// can grow with. It is computed from the arguments only, as the sender shard does not see the receiver token data
+func (e *dctLockedTransfer) getLockedEntryLength(value *big.Int, unlockEpoch uint32) (uint64, error) {
+	lockedEntry := &dct.DCTLockedBalance{
+		LockedAmounts: []*dct.DCTLockedAmount{{Value: value, UnlockEpoch: unlockEpoch}},
+	}
+	marshaledEntry, err := e.marshalizer.Marshal(lockedEntry)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(len(marshaledEntry)), nil
+}
+
+func (e *dctLockedTransfer) addLockedToDestination(
+	acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+	dctTokenKey []byte,
+	value *big.Int,
+	unlockEpoch uint32,
+) error {
+	if mustVerifyPayable(vmInput, 3) {
+		isPayable, errPayable := e.payableHandler.IsPayable(vmInput.RecipientAddr)
+		if errPayable != nil {
+			return errPayable
+		}
+		if !isPayable {
+			return ErrAccountNotPayable
+		}
+	}
+
+	tokenID := vmInput.Arguments[0]
+	err := checkLimitedTransfer(acntDst, tokenID, dctTokenKey, e.globalSettingsHandler, e.rolesHandler, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return err
+	}
+
+	err = addToDCTBalance(acntDst, dctTokenKey, value, e.marshalizer, e.globalSettingsHandler, e.lockedBalanceHandler, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return err
+	}
+	if vmInput.ReturnCallAfterError {
+		// the value is refunded to the sender after a failed call, it was spendable before the transfer
+		return nil
+	}
+
+	dctData, err := getDCTDataFromKey(acntDst, dctTokenKey, e.marshalizer)
+	if err != nil {
+		return err
+	}
+
+	err = e.lockedBalanceHandler.AddLockedBalance(dctData, value, unlockEpoch)
+	if err != nil {
+		return err
+	}
+
+	return saveDCTData(acntDst, dctData, dctTokenKey, e.marshalizer)
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *dctLockedTransfer) IsInterfaceNil() bool {
+	return e == nil
+}
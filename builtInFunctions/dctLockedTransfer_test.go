@@ -0,0 +1,227 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createLockedTransferWithMockArguments() (*dctLockedTransfer, *dctLockedBalance) {
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		return uint32(address[len(address)-1])
+	}
+	lockedBalanceHandler, _ := NewDCTLockedBalanceHandler(&mock.MarshalizerMock{}, &mock.EpochNotifierStub{})
+
+	lockedTransfer, _ := NewDCTLockedTransferFunc(
+		10,
+		&mock.MarshalizerMock{},
+		&mock.GlobalSettingsHandlerStub{},
+		&mock.DCTRoleHandlerStub{},
+		shardCoordinator,
+		vmcommon.BaseOperationCost{},
+		lockedBalanceHandler,
+		0,
+		&mock.EpochNotifierStub{},
+	)
+	_ = lockedTransfer.SetPayableHandler(&mock.PayableHandlerStub{})
+
+	return lockedTransfer, lockedBalanceHandler
+}
+
+func createLockedTransferInput(caller []byte, recipient []byte, value int64, unlockEpoch int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			CallValue:   big.NewInt(0),
+			GasProvided: 100,
+			Arguments:   [][]byte{[]byte("TKN"), big.NewInt(value).Bytes(), big.NewInt(unlockEpoch).Bytes()},
+		},
+		RecipientAddr: recipient,
+	}
+}
+
+func TestNewDCTLockedTransferFunc(t *testing.T) {
+	t.Parallel()
+
+	e, err := NewDCTLockedTransferFunc(10, nil, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.ShardCoordinatorStub{}, vmcommon.BaseOperationCost{}, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	e, err = NewDCTLockedTransferFunc(10, &mock.MarshalizerMock{}, nil, &mock.DCTRoleHandlerStub{}, &mock.ShardCoordinatorStub{}, vmcommon.BaseOperationCost{}, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilGlobalSettingsHandler, err)
+
+	e, err = NewDCTLockedTransferFunc(10, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, nil, &mock.ShardCoordinatorStub{}, vmcommon.BaseOperationCost{}, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilRolesHandler, err)
+
+	e, err = NewDCTLockedTransferFunc(10, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, nil, vmcommon.BaseOperationCost{}, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilShardCoordinator, err)
+
+	e, err = NewDCTLockedTransferFunc(10, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.ShardCoordinatorStub{}, vmcommon.BaseOperationCost{}, nil, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilLockedBalanceHandler, err)
+
+	e, err = NewDCTLockedTransferFunc(10, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.ShardCoordinatorStub{}, vmcommon.BaseOperationCost{}, &mock.LockedBalanceHandlerStub{}, 0, nil)
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilEpochHandler, err)
+
+	e, err = NewDCTLockedTransferFunc(10, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.ShardCoordinatorStub{}, vmcommon.BaseOperationCost{}, &mock.LockedBalanceHandlerStub{}, 1, &mock.EpochNotifierStub{})
+	assert.False(t, check.IfNil(e))
+	assert.Nil(t, err)
+	assert.False(t, e.IsActive())
+}
+
+func TestDCTLockedTransfer_ProcessBuiltinFunctionInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	e, _ := createLockedTransferWithMockArguments()
+	sender := createSettleSaleAddress(1, 0)
+	receiver := createSettleSaleAddress(2, 0)
+
+	output, err := e.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Nil(t, output)
+	assert.Equal(t, ErrNilVmInput, err)
+
+	input := createLockedTransferInput(sender, receiver, 10, 5)
+	input.Arguments = input.Arguments[:2]
+	output, err = e.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, output)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	input = createLockedTransferInput(sender, sender, 10, 5)
+	output, err = e.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, output)
+	assert.Equal(t, ErrInvalidRcvAddr, err)
+
+	input = createLockedTransferInput(sender, receiver, 0, 5)
+	output, err = e.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, output)
+	assert.Equal(t, ErrNegativeValue, err)
+
+	input = createLockedTransferInput(sender, receiver, 10, 1<<32)
+	output, err = e.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, output)
+	assert.Equal(t, ErrInvalidUnlockEpoch, err)
+
+	input = createLockedTransferInput(sender, receiver, 10, 5)
+	input.GasProvided = 1
+	output, err = e.ProcessBuiltinFunction(mock.NewUserAccount(sender), nil, input)
+	assert.Nil(t, output)
+	assert.Equal(t, ErrNotEnoughGas, err)
+}
+
+func TestDCTLockedTransfer_ProcessBuiltinFunctionOnSameShardShouldLockUntilEpoch(t *testing.T) {
+	t.Parallel()
+
+	e, lockedBalanceHandler := createLockedTransferWithMockArguments()
+	sender := mock.NewUserAccount(createSettleSaleAddress(1, 0))
+	receiver := mock.NewUserAccount(createSettleSaleAddress(2, 0))
+	setTransferFromBalance(t, sender, []byte("TKN"), 100)
+
+	input := createLockedTransferInput(sender.AddressBytes(), receiver.AddressBytes(), 40, 5)
+	output, err := e.ProcessBuiltinFunction(sender, receiver, input)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(90), output.GasRemaining)
+	require.Equal(t, 1, len(output.Logs))
+	assert.Equal(t, [][]byte{[]byte("TKN"), big.NewInt(40).Bytes(), receiver.AddressBytes(), big.NewInt(5).Bytes()}, output.Logs[0].Topics)
+
+	assert.Equal(t, big.NewInt(60), getSettleSaleBalance(sender, []byte("TKN")))
+	spendable, locked, _ := lockedBalanceHandler.GetSpendableAndLockedBalance(receiver, []byte("TKN"))
+	assert.Equal(t, 0, spendable.Sign())
+	assert.Equal(t, big.NewInt(40), locked)
+
+	// locked units can not be sent further
+	input = createLockedTransferInput(receiver.AddressBytes(), sender.AddressBytes(), 1, 5)
+	_, err = e.ProcessBuiltinFunction(receiver, sender, input)
+	assert.Equal(t, ErrInsufficientUnlockedFunds, err)
+
+	lockedBalanceHandler.EpochConfirmed(5, 0)
+	spendable, locked, _ = lockedBalanceHandler.GetSpendableAndLockedBalance(receiver, []byte("TKN"))
+	assert.Equal(t, big.NewInt(40), spendable)
+	assert.Equal(t, 0, locked.Sign())
+
+	input = createLockedTransferInput(receiver.AddressBytes(), sender.AddressBytes(), 40, 5)
+	_, err = e.ProcessBuiltinFunction(receiver, sender, input)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(100), getSettleSaleBalance(sender, []byte("TKN")))
+}
+
+func TestDCTLockedTransfer_ProcessBuiltinFunctionShouldChargeTheLockedEntryStorage(t *testing.T) {
+	t.Parallel()
+
+	e, _ := createLockedTransferWithMockArguments()
+	e.gasConfig.StorePerByte = 1
+	sender := mock.NewUserAccount(createSettleSaleAddress(1, 0))
+	receiver := mock.NewUserAccount(createSettleSaleAddress(2, 0))
+	setTransferFromBalance(t, sender, []byte("TKN"), 100)
+
+	input := createLockedTransferInput(sender.AddressBytes(), receiver.AddressBytes(), 40, 5)
+	output, err := e.ProcessBuiltinFunction(sender, receiver, input)
+	require.Nil(t, err)
+
+	dctData, _ := getDCTDataFromKey(receiver, append(keyPrefix, []byte("TKN")...), &mock.MarshalizerMock{})
+	assert.Equal(t, input.GasProvided-e.funcGasCost-uint64(len(dctData.Reserved)), output.GasRemaining)
+
+	input = createLockedTransferInput(sender.AddressBytes(), receiver.AddressBytes(), 40, 5)
+	input.GasProvided = e.funcGasCost + 1
+	_, err = e.ProcessBuiltinFunction(sender, receiver, input)
+	assert.Equal(t, ErrNotEnoughGas, err)
+}
+
+func TestDCTLockedTransfer_ProcessBuiltinFunctionCrossShard(t *testing.T) {
+	t.Parallel()
+
+	e, _ := createLockedTransferWithMockArguments()
+	sender := make([]byte, 32)
+	receiver := createSettleSaleAddress(2, 1)
+	acntSnd := mock.NewUserAccount(sender)
+	setTransferFromBalance(t, acntSnd, []byte("TKN"), 100)
+
+	input := createLockedTransferInput(sender, receiver, 40, 5)
+	output, err := e.ProcessBuiltinFunction(acntSnd, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(60), getSettleSaleBalance(acntSnd, []byte("TKN")))
+
+	require.Equal(t, 1, len(output.OutputAccounts))
+	outAcc := output.OutputAccounts[string(receiver)]
+	require.NotNil(t, outAcc)
+	expectedData := []byte(vmcommon.BuiltInFunctionDCTLockedTransfer + "@544b4e@28@05")
+	assert.Equal(t, expectedData, outAcc.OutputTransfers[0].Data)
+
+	acntDst := mock.NewUserAccount(receiver)
+	input.CallType = vmcommon.AsynchronousCallBack
+	output, err = e.ProcessBuiltinFunction(nil, acntDst, input)
+	require.Nil(t, err)
+	assert.Equal(t, input.GasProvided, output.GasRemaining)
+	assert.Equal(t, big.NewInt(40), getSettleSaleBalance(acntDst, []byte("TKN")))
+	dctData, _ := getDCTDataFromKey(acntDst, append(keyPrefix, []byte("TKN")...), &mock.MarshalizerMock{})
+	assert.NotNil(t, dctData.Reserved)
+}
+
+func TestDCTLockedTransfer_ProcessBuiltinFunctionReturnWithErrorShouldCreditSpendable(t *testing.T) {
+	t.Parallel()
+
+	e, lockedBalanceHandler := createLockedTransferWithMockArguments()
+	sender := createSettleSaleAddress(2, 1)
+	acntDst := mock.NewUserAccount(createSettleSaleAddress(1, 0))
+
+	input := createLockedTransferInput(sender, acntDst.AddressBytes(), 40, 5)
+	input.CallType = vmcommon.AsynchronousCallBack
+	input.ReturnCallAfterError = true
+	_, err := e.ProcessBuiltinFunction(nil, acntDst, input)
+	require.Nil(t, err)
+
+	spendable, locked, _ := lockedBalanceHandler.GetSpendableAndLockedBalance(acntDst, []byte("TKN"))
+	assert.Equal(t, big.NewInt(40), spendable)
+	assert.Equal(t, 0, locked.Sign())
+	dctData, _ := getDCTDataFromKey(acntDst, append(keyPrefix, []byte("TKN")...), &mock.MarshalizerMock{})
+	assert.Nil(t, dctData.Reserved)
+}
@@ -0,0 +1,120 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"sync"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/atomic"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+)
+
+// baseDCTMetaDataModify holds the components and the load, role check and save flow shared by the built in
+// functions which modify the metadata of an existing NFT
+type baseDCTMetaDataModify struct {
+	*baseEnabled
+	keyPrefix      []byte
+	marshalizer    vmcommon.Marshalizer
+	storageHandler vmcommon.DCTNFTStorageHandler
+	rolesHandler   vmcommon.DCTRoleHandler
+	gasConfig      vmcommon.BaseOperationCost
+	funcGasCost    uint64
+	mutExecution   sync.RWMutex
+}
+
+func newBaseDCTMetaDataModify(
+	function string,
+	funcGasCost uint64,
+	gasConfig vmcommon.BaseOperationCost,
+	marshalizer vmcommon.Marshalizer,
+	storageHandler vmcommon.DCTNFTStorageHandler,
+	rolesHandler vmcommon.DCTRoleHandler,
+	activationEpoch uint32,
+	epochNotifier vmcommon.EpochNotifier,
+) (*baseDCTMetaDataModify, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(storageHandler) {
+		return nil, ErrNilDCTNFTStorageHandler
+	}
+	if check.IfNil(rolesHandler) {
+		return nil, ErrNilRolesHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, ErrNilEpochHandler
+	}
+
+	return &baseDCTMetaDataModify{
+		baseEnabled: &baseEnabled{
+			function:        function,
+			activationEpoch: activationEpoch,
+			flagActivated:   atomic.Flag{},
+		},
+		keyPrefix:      []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
+		marshalizer:    marshalizer,
+		funcGasCost:    funcGasCost,
+		mutExecution:   sync.RWMutex{},
+		storageHandler: storageHandler,
+		gasConfig:      gasConfig,
+		rolesHandler:   rolesHandler,
+	}, nil
+}
+
+func (b *baseDCTMetaDataModify) setGasConfig(funcGasCost uint64, gasConfig vmcommon.BaseOperationCost) {
+	b.mutExecution.Lock()
+	b.funcGasCost = funcGasCost
+	b.gasConfig = gasConfig
+	b.mutExecution.Unlock()
+}
+
+// modifyMetaData checks the caller has the given role, charges the storage of the given length, loads the NFT
+// identified by the first two arguments, applies the modification on its metadata and saves it. It returns the output,
+// holding a log entry for the NFT to which the caller adds the function specific topics, and the saved token data.
+// Must be called under the execution lock
+func (b *baseDCTMetaDataModify) modifyMetaData(
+	acntSnd vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+	role string,
+	storedLength uint64,
+	modify func(metaData *dct.MetaData),
+) (*vmcommon.VMOutput, []byte, error) {
+	err := b.rolesHandler.CheckAllowedToExecute(acntSnd, vmInput.Arguments[0], []byte(role))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	traceGas(vmInput, gasComponentStorePerByte, storedLength, b.gasConfig.StorePerByte)
+	gasToUse := b.funcGasCost + storedLength*b.gasConfig.StorePerByte
+	if vmInput.GasProvided < gasToUse {
+		return nil, nil, ErrNotEnoughGas
+	}
+
+	dctTokenKey := append(b.keyPrefix, vmInput.Arguments[0]...)
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	if nonce == 0 {
+		return nil, nil, ErrNFTDoesNotHaveMetadata
+	}
+	dctData, err := b.storageHandler.GetDCTNFTTokenOnSender(acntSnd, dctTokenKey, nonce)
+	if err != nil {
+		return nil, nil, err
+	}
+	if dctData.TokenMetaData == nil {
+		return nil, nil, ErrNFTDoesNotHaveMetadata
+	}
+
+	modify(dctData.TokenMetaData)
+
+	dctDataBytes, err := b.storageHandler.SaveDCTNFTToken(acntSnd, dctTokenKey, nonce, dctData, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - gasToUse,
+		Logs:         []*vmcommon.LogEntry{newEntryForNFT(b.function, vmInput.CallerAddr, vmInput.Arguments[0], nonce)},
+	}
+	return vmOutput, dctDataBytes, nil
+}
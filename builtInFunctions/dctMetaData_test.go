@@ -3,6 +3,7 @@ package builtInFunctions
 import (
 	"testing"
 
+	vmcommon "github.com/Dharitri-org/me-vm-common"
 	"github.com/stretchr/testify/require"
 )
 
@@ -119,3 +120,34 @@ func TestDCTUserMetadataFromBytes_ShouldSetFrozenToFalse(t *testing.T) {
 	result := DCTUserMetadataFromBytes(input)
 	require.False(t, result.Frozen)
 }
+
+func TestDCTGlobalMetadata_TokenTypeRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	dctMetaData := &DCTGlobalMetadata{
+		Paused:    true,
+		TokenType: byte(vmcommon.MetaFungible),
+	}
+
+	bytes := dctMetaData.ToBytes()
+	require.Equal(t, lengthOfDCTMetadata, len(bytes))
+	require.Equal(t, byte(vmcommon.MetaFungible), bytes[1])
+
+	result := DCTGlobalMetadataFromBytes(bytes)
+	require.Equal(t, *dctMetaData, result)
+}
+
+func TestDCTGlobalMetadata_LimitedTransfer(t *testing.T) {
+	t.Parallel()
+
+	dctMetaData := &DCTGlobalMetadata{
+		LimitedTransfer: true,
+	}
+
+	bytes := dctMetaData.ToBytes()
+	require.Equal(t, byte(MetadataLimitedTransfer), bytes[0])
+
+	result := DCTGlobalMetadataFromBytes(bytes)
+	require.True(t, result.LimitedTransfer)
+	require.False(t, result.Paused)
+}
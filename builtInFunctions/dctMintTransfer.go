@@ -0,0 +1,73 @@
+package builtInFunctions
+
+import (
+	"bytes"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+)
+
+// dctMintTransfer is the transfer through which the dct system smart contract credits newly issued or minted tokens.
+// Unlike the plain transfer, the credited value is reported to the supply handler
+type dctMintTransfer struct {
+	*baseEnabled
+	*dctTransfer
+}
+
+// NewDCTMintTransferFunc returns the dct mint transfer built-in function component
+func NewDCTMintTransferFunc(
+	funcGasCost uint64,
+	marshalizer vmcommon.Marshalizer,
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler,
+	rolesHandler vmcommon.DCTRoleHandler,
+	shardCoordinator vmcommon.Coordinator,
+	supplyHandler vmcommon.DCTSupplyHandler,
+	lockedBalanceHandler vmcommon.DCTLockedBalanceHandler,
+	activationEpoch uint32,
+	epochNotifier vmcommon.EpochNotifier,
+) (*dctMintTransfer, error) {
+	if check.IfNil(supplyHandler) {
+		return nil, ErrNilSupplyHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, ErrNilEpochHandler
+	}
+
+	transfer, err := NewDCTTransferFunc(funcGasCost, marshalizer, globalSettingsHandler, rolesHandler, shardCoordinator, lockedBalanceHandler)
+	if err != nil {
+		return nil, err
+	}
+	transfer.function = vmcommon.BuiltInFunctionDCTMintTransfer
+	transfer.supplyHandler = supplyHandler
+
+	e := &dctMintTransfer{
+		dctTransfer: transfer,
+		baseEnabled: &baseEnabled{
+			function:        vmcommon.BuiltInFunctionDCTMintTransfer,
+			activationEpoch: activationEpoch,
+		},
+	}
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// ProcessBuiltinFunction credits the minted tokens, only if called by the dct system smart contract
+func (e *dctMintTransfer) ProcessBuiltinFunction(
+	acntSnd, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if vmInput == nil {
+		return nil, ErrNilVmInput
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmcommon.DCTSCAddress) {
+		return nil, ErrAddressIsNotDCTSystemSC
+	}
+
+	return e.dctTransfer.ProcessBuiltinFunction(acntSnd, acntDst, vmInput)
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *dctMintTransfer) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,92 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDCTMintTransferFunc(t *testing.T) {
+	t.Parallel()
+
+	e, err := NewDCTMintTransferFunc(10, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.ShardCoordinatorStub{}, nil, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilSupplyHandler, err)
+
+	e, err = NewDCTMintTransferFunc(10, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.ShardCoordinatorStub{}, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{}, 0, nil)
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilEpochHandler, err)
+
+	e, err = NewDCTMintTransferFunc(10, nil, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.ShardCoordinatorStub{}, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	e, err = NewDCTMintTransferFunc(10, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.ShardCoordinatorStub{}, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{}, 1, &mock.EpochNotifierStub{})
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(e))
+	assert.False(t, e.IsActive())
+}
+
+func TestDCTMintTransfer_ProcessBuiltinFunction(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	mintedValue := big.NewInt(0)
+	supplyHandler := &mock.SupplyHandlerStub{
+		UpdateSupplyCalled: func(tokenID []byte, nonce uint64, value *big.Int) error {
+			mintedValue.Add(mintedValue, value)
+			return nil
+		},
+	}
+	mintTransfer, _ := NewDCTMintTransferFunc(10, marshalizer, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.ShardCoordinatorStub{}, supplyHandler, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	_ = mintTransfer.SetPayableHandler(&mock.PayableHandlerStub{})
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  []byte("snd"),
+			GasProvided: 50,
+			CallValue:   big.NewInt(0),
+			Arguments:   [][]byte{[]byte("key"), big.NewInt(10).Bytes()},
+		},
+	}
+	accDst := mock.NewUserAccount([]byte("dst"))
+
+	_, err := mintTransfer.ProcessBuiltinFunction(nil, accDst, nil)
+	assert.Equal(t, ErrNilVmInput, err)
+
+	_, err = mintTransfer.ProcessBuiltinFunction(nil, accDst, input)
+	assert.Equal(t, ErrAddressIsNotDCTSystemSC, err)
+	assert.Equal(t, big.NewInt(0), mintedValue)
+
+	input.CallerAddr = vmcommon.DCTSCAddress
+	vmOutput, err := mintTransfer.ProcessBuiltinFunction(nil, accDst, input)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(10), mintedValue)
+	require.Equal(t, 1, len(vmOutput.Logs))
+	assert.Equal(t, []byte(vmcommon.BuiltInFunctionDCTMintTransfer), vmOutput.Logs[0].Identifier)
+}
+
+func TestDCTTransfer_ProcessBuiltInFunctionFromDCTSCShouldNotUpdateSupply(t *testing.T) {
+	t.Parallel()
+
+	transferFunc, _ := NewDCTTransferFunc(10, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.ShardCoordinatorStub{}, &mock.LockedBalanceHandlerStub{})
+	_ = transferFunc.SetPayableHandler(&mock.PayableHandlerStub{})
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  vmcommon.DCTSCAddress,
+			GasProvided: 50,
+			CallValue:   big.NewInt(0),
+			Arguments:   [][]byte{[]byte("key"), big.NewInt(10).Bytes()},
+		},
+	}
+
+	_, err := transferFunc.ProcessBuiltinFunction(nil, mock.NewUserAccount([]byte("dst")), input)
+	assert.Nil(t, err)
+	assert.True(t, check.IfNil(transferFunc.supplyHandler))
+}
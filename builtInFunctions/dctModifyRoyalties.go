@@ -0,0 +1,96 @@
+package builtInFunctions
+
+import (
+	"fmt"
+	"math/big"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+)
+
+type dctModifyRoyalties struct {
+	*baseDCTMetaDataModify
+}
+
+// NewDCTModifyRoyaltiesFunc returns the dct NFT modify royalties built-in function component
+func NewDCTModifyRoyaltiesFunc(
+	funcGasCost uint64,
+	gasConfig vmcommon.BaseOperationCost,
+	marshalizer vmcommon.Marshalizer,
+	storageHandler vmcommon.DCTNFTStorageHandler,
+	rolesHandler vmcommon.DCTRoleHandler,
+	activationEpoch uint32,
+	epochNotifier vmcommon.EpochNotifier,
+) (*dctModifyRoyalties, error) {
+	base, err := newBaseDCTMetaDataModify(
+		vmcommon.BuiltInFunctionDCTModifyRoyalties,
+		funcGasCost,
+		gasConfig,
+		marshalizer,
+		storageHandler,
+		rolesHandler,
+		activationEpoch,
+		epochNotifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &dctModifyRoyalties{
+		baseDCTMetaDataModify: base,
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *dctModifyRoyalties) SetNewGasConfig(gasCost *vmcommon.GasCost) {
+	if gasCost == nil {
+		return
+	}
+
+	e.setGasConfig(gasCost.BuiltInCost.DCTModifyRoyalties, gasCost.BaseOperationCost)
+}
+
+// ProcessBuiltinFunction resolves DCT NFT modify royalties function call
+// Requires 3 arguments:
+// arg0 - token identifier
+// arg1 - nonce
+// arg2 - new royalties - max 10000
+func (e *dctModifyRoyalties) ProcessBuiltinFunction(
+	acntSnd, _ vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	err := checkDCTNFTCreateBurnAddInput(acntSnd, vmInput, e.funcGasCost)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) != 3 {
+		return nil, ErrInvalidArguments
+	}
+
+	royalties := uint32(big.NewInt(0).SetBytes(vmInput.Arguments[2]).Uint64())
+	if royalties > vmcommon.MaxRoyalty {
+		return nil, fmt.Errorf("%w, invalid max royality value", ErrInvalidArguments)
+	}
+
+	vmOutput, _, err := e.modifyMetaData(acntSnd, vmInput, vmcommon.DCTRoleModifyRoyalties, uint64(len(vmInput.Arguments[2])), func(metaData *dct.MetaData) {
+		metaData.Royalties = royalties
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput.Logs[0].Topics = append(vmOutput.Logs[0].Topics, vmInput.Arguments[2])
+	return vmOutput, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *dctModifyRoyalties) IsInterfaceNil() bool {
+	return e == nil
+}
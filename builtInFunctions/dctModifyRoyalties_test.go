@@ -0,0 +1,146 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createNFTWithMetaData(
+	t *testing.T,
+	storageHandler vmcommon.DCTNFTStorageHandler,
+	account vmcommon.UserAccountHandler,
+	tokenID []byte,
+	nonce uint64,
+) {
+	dctData := &dct.DCToken{
+		Type:  uint32(vmcommon.NonFungible),
+		Value: big.NewInt(1),
+		TokenMetaData: &dct.MetaData{
+			Nonce:      nonce,
+			Name:       []byte("name"),
+			Creator:    []byte("creator"),
+			Royalties:  100,
+			Hash:       []byte("hash"),
+			Attributes: []byte("attributes"),
+			URIs:       [][]byte{[]byte("uri")},
+		},
+	}
+	_, err := storageHandler.SaveDCTNFTToken(account, append(keyPrefix, tokenID...), nonce, dctData, false)
+	require.Nil(t, err)
+}
+
+func createMetaDataModifyInput(caller []byte, arguments ...[]byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			Arguments:   arguments,
+			CallerAddr:  caller,
+			GasProvided: 1000,
+		},
+		RecipientAddr: caller,
+	}
+}
+
+func TestNewDCTModifyRoyaltiesFunc(t *testing.T) {
+	t.Parallel()
+
+	e, err := NewDCTModifyRoyaltiesFunc(10, vmcommon.BaseOperationCost{}, nil, createNewDCTDataStorageHandler(), &mock.DCTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	require.True(t, check.IfNil(e))
+	require.Equal(t, ErrNilMarshalizer, err)
+
+	e, err = NewDCTModifyRoyaltiesFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, nil, &mock.DCTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	require.True(t, check.IfNil(e))
+	require.Equal(t, ErrNilDCTNFTStorageHandler, err)
+
+	e, err = NewDCTModifyRoyaltiesFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, createNewDCTDataStorageHandler(), nil, 0, &mock.EpochNotifierStub{})
+	require.True(t, check.IfNil(e))
+	require.Equal(t, ErrNilRolesHandler, err)
+
+	e, err = NewDCTModifyRoyaltiesFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, createNewDCTDataStorageHandler(), &mock.DCTRoleHandlerStub{}, 0, nil)
+	require.True(t, check.IfNil(e))
+	require.Equal(t, ErrNilEpochHandler, err)
+
+	e, err = NewDCTModifyRoyaltiesFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, createNewDCTDataStorageHandler(), &mock.DCTRoleHandlerStub{}, 1, &mock.EpochNotifierStub{})
+	require.False(t, check.IfNil(e))
+	require.Nil(t, err)
+	require.False(t, e.IsActive())
+
+	e.EpochConfirmed(1, 0)
+	require.True(t, e.IsActive())
+}
+
+func TestDCTModifyRoyalties_SetNewGasConfig(t *testing.T) {
+	t.Parallel()
+
+	e, _ := NewDCTModifyRoyaltiesFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, createNewDCTDataStorageHandler(), &mock.DCTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	e.SetNewGasConfig(nil)
+	require.Equal(t, uint64(10), e.funcGasCost)
+
+	gasCost := createMockGasCost()
+	e.SetNewGasConfig(&gasCost)
+	require.Equal(t, gasCost.BuiltInCost.DCTModifyRoyalties, e.funcGasCost)
+	require.Equal(t, gasCost.BaseOperationCost, e.gasConfig)
+}
+
+func TestDCTModifyRoyalties_ProcessBuiltinFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	caller := []byte("caller")
+	e, _ := NewDCTModifyRoyaltiesFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, createNewDCTDataStorageHandler(), &mock.DCTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	userAcc := mock.NewUserAccount(caller)
+
+	output, err := e.ProcessBuiltinFunction(userAcc, nil, createMetaDataModifyInput(caller, []byte("TKN"), big.NewInt(1).Bytes()))
+	require.Nil(t, output)
+	require.Equal(t, ErrInvalidArguments, err)
+
+	_, err = e.ProcessBuiltinFunction(userAcc, nil, createMetaDataModifyInput(caller, []byte("TKN"), big.NewInt(1).Bytes(), big.NewInt(10001).Bytes()))
+	require.True(t, errors.Is(err, ErrInvalidArguments))
+
+	_, err = e.ProcessBuiltinFunction(userAcc, nil, createMetaDataModifyInput(caller, []byte("TKN"), big.NewInt(0).Bytes(), big.NewInt(10).Bytes()))
+	require.Equal(t, ErrNFTDoesNotHaveMetadata, err)
+
+	_, err = e.ProcessBuiltinFunction(userAcc, nil, createMetaDataModifyInput(caller, []byte("TKN"), big.NewInt(1).Bytes(), big.NewInt(10).Bytes()))
+	require.Equal(t, ErrNewNFTDataOnSenderAddress, err)
+
+	localErr := errors.New("local err")
+	e.rolesHandler = &mock.DCTRoleHandlerStub{
+		CheckAllowedToExecuteCalled: func(_ vmcommon.UserAccountHandler, _ []byte, action []byte) error {
+			require.Equal(t, []byte(vmcommon.DCTRoleModifyRoyalties), action)
+			return localErr
+		},
+	}
+	_, err = e.ProcessBuiltinFunction(userAcc, nil, createMetaDataModifyInput(caller, []byte("TKN"), big.NewInt(1).Bytes(), big.NewInt(10).Bytes()))
+	require.Equal(t, localErr, err)
+}
+
+func TestDCTModifyRoyalties_ProcessBuiltinFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	caller := []byte("caller")
+	tokenID := []byte("TKN")
+	storageHandler := createNewDCTDataStorageHandler()
+	e, _ := NewDCTModifyRoyaltiesFunc(10, vmcommon.BaseOperationCost{StorePerByte: 1}, &mock.MarshalizerMock{}, storageHandler, &mock.DCTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	userAcc := mock.NewUserAccount(caller)
+	createNFTWithMetaData(t, storageHandler, userAcc, tokenID, 1)
+
+	newRoyalties := big.NewInt(2500).Bytes()
+	output, err := e.ProcessBuiltinFunction(userAcc, nil, createMetaDataModifyInput(caller, tokenID, big.NewInt(1).Bytes(), newRoyalties))
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.Ok, output.ReturnCode)
+	require.Equal(t, uint64(1000-10-len(newRoyalties)), output.GasRemaining)
+
+	dctData, _ := storageHandler.GetDCTNFTTokenOnSender(userAcc, append(keyPrefix, tokenID...), 1)
+	require.Equal(t, uint32(2500), dctData.TokenMetaData.Royalties)
+	require.Equal(t, []byte("name"), dctData.TokenMetaData.Name)
+
+	require.Equal(t, 1, len(output.Logs))
+	require.Equal(t, []byte(vmcommon.BuiltInFunctionDCTModifyRoyalties), output.Logs[0].Identifier)
+	require.Equal(t, [][]byte{tokenID, big.NewInt(1).Bytes(), newRoyalties}, output.Logs[0].Topics)
+}
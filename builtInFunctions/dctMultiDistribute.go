@@ -0,0 +1,566 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"sync"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/atomic"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+)
+
+const argumentsPerDistribution = uint64(4)
+
+type dctDistribution struct {
+	destination []byte
+	tokenID     []byte
+	nonce       uint64
+	quantity    *big.Int
+	dctData     *dct.DCToken
+}
+
+func (d *dctDistribution) tokenKey() string {
+	return fmt.Sprintf("%d:%s", d.nonce, d.tokenID)
+}
+
+type dctMultiDistribute struct {
+	*baseEnabled
+	keyPrefix             []byte
+	marshalizer           vmcommon.Marshalizer
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler
+	rolesHandler          vmcommon.DCTRoleHandler
+	storageHandler        vmcommon.DCTNFTStorageHandler
+	payableHandler        vmcommon.PayableHandler
+	lockedBalanceHandler  vmcommon.DCTLockedBalanceHandler
+	funcGasCost           uint64
+	accounts              vmcommon.AccountsAdapter
+	shardCoordinator      vmcommon.Coordinator
+	gasConfig             vmcommon.BaseOperationCost
+	mutExecution          sync.RWMutex
+}
+
+// NewDCTMultiDistributeFunc returns the dct multi distribute built-in function component
+func NewDCTMultiDistributeFunc(
+	funcGasCost uint64,
+	marshalizer vmcommon.Marshalizer,
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler,
+	rolesHandler vmcommon.DCTRoleHandler,
+	accounts vmcommon.AccountsAdapter,
+	shardCoordinator vmcommon.Coordinator,
+	gasConfig vmcommon.BaseOperationCost,
+	storageHandler vmcommon.DCTNFTStorageHandler,
+	lockedBalanceHandler vmcommon.DCTLockedBalanceHandler,
+	activationEpoch uint32,
+	epochNotifier vmcommon.EpochNotifier,
+) (*dctMultiDistribute, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(globalSettingsHandler) {
+		return nil, ErrNilGlobalSettingsHandler
+	}
+	if check.IfNil(rolesHandler) {
+		return nil, ErrNilRolesHandler
+	}
+	if check.IfNil(accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(shardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+	if check.IfNil(storageHandler) {
+		return nil, ErrNilDCTNFTStorageHandler
+	}
+	if check.IfNil(lockedBalanceHandler) {
+		return nil, ErrNilLockedBalanceHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, ErrNilEpochHandler
+	}
+
+	e := &dctMultiDistribute{
+		keyPrefix:             []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
+		marshalizer:           marshalizer,
+		globalSettingsHandler: globalSettingsHandler,
+		rolesHandler:          rolesHandler,
+		storageHandler:        storageHandler,
+		payableHandler:        &disabledPayableHandler{},
+		lockedBalanceHandler:  lockedBalanceHandler,
+		funcGasCost:           funcGasCost,
+		accounts:              accounts,
+		shardCoordinator:      shardCoordinator,
+		gasConfig:             gasConfig,
+		mutExecution:          sync.RWMutex{},
+	}
+
+	e.baseEnabled = &baseEnabled{
+		function:        vmcommon.BuiltInFunctionDCTMultiDistribute,
+		activationEpoch: activationEpoch,
+		flagActivated:   atomic.Flag{},
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetPayableHandler will set the payable handler to the function
+func (e *dctMultiDistribute) SetPayableHandler(payableHandler vmcommon.PayableHandler) error {
+	if check.IfNil(payableHandler) {
+		return ErrNilPayableHandler
+	}
+
+	e.payableHandler = payableHandler
+	return nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *dctMultiDistribute) SetNewGasConfig(gasCost *vmcommon.GasCost) {
+	if gasCost == nil {
+		return
+	}
+
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.DCTMultiDistribute
+	e.gasConfig = gasCost.BaseOperationCost
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves DCT multi distribute function call. The sender is debited once for every token,
+// the recipients from the sender shard are credited in place and the ones from other shards receive one
+// transfer per shard
+// Requires the following arguments:
+// arg0 - number of distributions
+// list of (destination - tokenID - nonce - quantity) - in case of DCT nonce == 0
+// on the destination shard the quantity of an NFT is replaced by the marshaled DCT NFT data
+func (e *dctMultiDistribute) ProcessBuiltinFunction(
+	acntSnd, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	err := checkBasicDCTArguments(vmInput)
+	if err != nil {
+		return nil, err
+	}
+
+	// the number of distributions is derived from the arguments, the first argument only has to match it
+	if uint64(len(vmInput.Arguments)-1)%argumentsPerDistribution != 0 {
+		return nil, fmt.Errorf("%w, invalid number of arguments", ErrInvalidArguments)
+	}
+	numOfDistributions := uint64(len(vmInput.Arguments)-1) / argumentsPerDistribution
+	if numOfDistributions == 0 {
+		return nil, fmt.Errorf("%w, 0 distributions", ErrInvalidArguments)
+	}
+	if big.NewInt(0).SetBytes(vmInput.Arguments[0]).Cmp(big.NewInt(0).SetUint64(numOfDistributions)) != 0 {
+		return nil, fmt.Errorf("%w, invalid number of arguments", ErrInvalidArguments)
+	}
+
+	if bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return e.processDistributionOnSenderShard(acntSnd, vmInput, numOfDistributions)
+	}
+
+	// in cross shard distribution the sender account must be nil
+	if !check.IfNil(acntSnd) {
+		return nil, ErrInvalidRcvAddr
+	}
+	if check.IfNil(acntDst) {
+		return nil, ErrInvalidRcvAddr
+	}
+
+	return e.processDistributionOnDestinationShard(acntDst, vmInput, numOfDistributions)
+}
+
+func (e *dctMultiDistribute) processDistributionOnSenderShard(
+	acntSnd vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+	numOfDistributions uint64,
+) (*vmcommon.VMOutput, error) {
+	if check.IfNil(acntSnd) {
+		return nil, ErrNilUserAccount
+	}
+
+	// gas is paid for every recipient
+	traceGas(vmInput, gasComponentFunction, numOfDistributions, e.funcGasCost)
+	overflow, distributionCost := bits.Mul64(numOfDistributions, e.funcGasCost)
+	if overflow != 0 || vmInput.GasProvided < distributionCost {
+		return nil, ErrNotEnoughGas
+	}
+
+	distributions, err := e.parseDistributions(vmInput, numOfDistributions)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.debitSender(acntSnd, distributions, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - distributionCost,
+		Logs:         make([]*vmcommon.LogEntry, 0, numOfDistributions),
+	}
+
+	loadedAccounts := make(map[string]vmcommon.UserAccountHandler)
+	accountsToSave := make([]vmcommon.UserAccountHandler, 0)
+	crossShardArguments := make(map[uint32][][]byte)
+	crossShardRecipients := make([][]byte, 0)
+	verifyPayable := mustVerifyPayable(vmInput, len(vmInput.Arguments))
+	for _, distribution := range distributions {
+		shardID := e.shardCoordinator.ComputeId(distribution.destination)
+		if shardID != e.shardCoordinator.SelfId() {
+			args, ok := crossShardArguments[shardID]
+			if !ok {
+				crossShardRecipients = append(crossShardRecipients, distribution.destination)
+			}
+
+			args, err = e.appendCrossShardArguments(vmInput, args, distribution, vmOutput)
+			if err != nil {
+				return nil, err
+			}
+			crossShardArguments[shardID] = args
+		} else {
+			userAccount, ok := loadedAccounts[string(distribution.destination)]
+			if !ok {
+				userAccount, err = e.loadUserAccount(distribution.destination)
+				if err != nil {
+					return nil, err
+				}
+				loadedAccounts[string(distribution.destination)] = userAccount
+				accountsToSave = append(accountsToSave, userAccount)
+			}
+
+			err = e.addToDestination(userAccount, distribution, verifyPayable, vmInput.ReturnCallAfterError)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		vmOutput.Logs = append(vmOutput.Logs, e.newDistributionLogEntry(vmInput.CallerAddr, distribution))
+	}
+
+	for _, userAccount := range accountsToSave {
+		err = e.accounts.SaveAccount(userAccount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// one transfer for each foreign shard, sent to the first recipient of that shard
+	for _, recipient := range crossShardRecipients {
+		args := crossShardArguments[e.shardCoordinator.ComputeId(recipient)]
+		numOfShardDistributions := uint64(len(args)) / argumentsPerDistribution
+		callArgs := append([][]byte{big.NewInt(0).SetUint64(numOfShardDistributions).Bytes()}, args...)
+		appendOutputTransferToVMOutput(
+			vmInput.CallerAddr,
+			vmcommon.BuiltInFunctionDCTMultiDistribute,
+			callArgs,
+			recipient,
+			vmInput.GasLocked,
+			vmInput.CallType,
+			vmOutput)
+	}
+
+	return vmOutput, nil
+}
+
+func (e *dctMultiDistribute) processDistributionOnDestinationShard(
+	acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+	numOfDistributions uint64,
+) (*vmcommon.VMOutput, error) {
+	// no need to consume gas on destination - sender already paid for it
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided,
+		Logs:         make([]*vmcommon.LogEntry, 0, numOfDistributions),
+	}
+
+	loadedAccounts := map[string]vmcommon.UserAccountHandler{string(acntDst.AddressBytes()): acntDst}
+	accountsToSave := make([]vmcommon.UserAccountHandler, 0)
+	verifyPayable := mustVerifyPayable(vmInput, len(vmInput.Arguments))
+	for i := uint64(0); i < numOfDistributions; i++ {
+		distribution, err := e.parseReceivedDistribution(vmInput.Arguments[1+i*argumentsPerDistribution:])
+		if err != nil {
+			return nil, err
+		}
+
+		userAccount, ok := loadedAccounts[string(distribution.destination)]
+		if !ok {
+			userAccount, err = e.loadUserAccount(distribution.destination)
+			if err != nil {
+				return nil, err
+			}
+			loadedAccounts[string(distribution.destination)] = userAccount
+			accountsToSave = append(accountsToSave, userAccount)
+		}
+
+		err = e.addToDestination(userAccount, distribution, verifyPayable, vmInput.ReturnCallAfterError)
+		if err != nil {
+			return nil, err
+		}
+
+		vmOutput.Logs = append(vmOutput.Logs, e.newDistributionLogEntry(vmInput.CallerAddr, distribution))
+	}
+
+	// the recipient of the call is saved by the caller
+	for _, userAccount := range accountsToSave {
+		err := e.accounts.SaveAccount(userAccount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return vmOutput, nil
+}
+
+func (e *dctMultiDistribute) parseDistributions(
+	vmInput *vmcommon.ContractCallInput,
+	numOfDistributions uint64,
+) ([]*dctDistribution, error) {
+	distributions := make([]*dctDistribution, 0, numOfDistributions)
+	for i := uint64(0); i < numOfDistributions; i++ {
+		startIndex := 1 + i*argumentsPerDistribution
+		destination := vmInput.Arguments[startIndex]
+		if len(destination) != len(vmInput.CallerAddr) {
+			return nil, fmt.Errorf("%w, not a valid destination address", ErrInvalidArguments)
+		}
+		if bytes.Equal(destination, vmInput.CallerAddr) {
+			return nil, fmt.Errorf("%w, can not transfer to self", ErrInvalidArguments)
+		}
+		if e.shardCoordinator.ComputeId(destination) == vmcommon.MetachainShardId {
+			return nil, ErrInvalidRcvAddr
+		}
+
+		quantity := big.NewInt(0).SetBytes(vmInput.Arguments[startIndex+3])
+		if quantity.Cmp(zero) <= 0 {
+			return nil, ErrInvalidNFTQuantity
+		}
+
+		distributions = append(distributions, &dctDistribution{
+			destination: destination,
+			tokenID:     vmInput.Arguments[startIndex+1],
+			nonce:       big.NewInt(0).SetBytes(vmInput.Arguments[startIndex+2]).Uint64(),
+			quantity:    quantity,
+		})
+	}
+
+	return distributions, nil
+}
+
+func (e *dctMultiDistribute) parseReceivedDistribution(arguments [][]byte) (*dctDistribution, error) {
+	distribution := &dctDistribution{
+		destination: arguments[0],
+		tokenID:     arguments[1],
+		nonce:       big.NewInt(0).SetBytes(arguments[2]).Uint64(),
+	}
+	if e.shardCoordinator.ComputeId(distribution.destination) != e.shardCoordinator.SelfId() {
+		return nil, ErrInvalidRcvAddr
+	}
+
+	if distribution.nonce == 0 {
+		distribution.quantity = big.NewInt(0).SetBytes(arguments[3])
+		return distribution, nil
+	}
+
+	dctTransferData := &dct.DCToken{}
+	err := e.marshalizer.Unmarshal(dctTransferData, arguments[3])
+	if err != nil {
+		return nil, err
+	}
+	if dctTransferData.TokenMetaData == nil || dctTransferData.Value == nil {
+		return nil, ErrNilDCTData
+	}
+	distribution.quantity = dctTransferData.Value
+	distribution.dctData = dctTransferData
+
+	return distribution, nil
+}
+
+// debitSender subtracts from the sender, once for every token, the sum of the quantities distributed
+func (e *dctMultiDistribute) debitSender(
+	acntSnd vmcommon.UserAccountHandler,
+	distributions []*dctDistribution,
+	isReturnWithError bool,
+) error {
+	tokenKeys := make([]string, 0)
+	totals := make(map[string]*big.Int)
+	for _, distribution := range distributions {
+		key := distribution.tokenKey()
+		total, ok := totals[key]
+		if !ok {
+			total = big.NewInt(0)
+			totals[key] = total
+			tokenKeys = append(tokenKeys, key)
+		}
+		total.Add(total, distribution.quantity)
+	}
+
+	sentData := make(map[string]*dct.DCToken)
+	for _, distribution := range distributions {
+		key := distribution.tokenKey()
+		if _, ok := sentData[key]; ok {
+			continue
+		}
+
+		dctData, err := e.debitOneToken(acntSnd, distribution.tokenID, distribution.nonce, totals[key], isReturnWithError)
+		if err != nil {
+			return err
+		}
+		sentData[key] = dctData
+	}
+
+	for _, distribution := range distributions {
+		sent := sentData[distribution.tokenKey()]
+		distribution.dctData = &dct.DCToken{
+			Type:          sent.Type,
+			Value:         big.NewInt(0).Set(distribution.quantity),
+			TokenMetaData: sent.TokenMetaData,
+		}
+	}
+
+	return nil
+}
+
+func (e *dctMultiDistribute) debitOneToken(
+	acntSnd vmcommon.UserAccountHandler,
+	tokenID []byte,
+	nonce uint64,
+	total *big.Int,
+	isReturnWithError bool,
+) (*dct.DCToken, error) {
+	dctTokenKey := append(e.keyPrefix, tokenID...)
+	dctData, err := e.storageHandler.GetDCTNFTTokenOnSender(acntSnd, dctTokenKey, nonce)
+	if err != nil {
+		return nil, err
+	}
+	if dctData.Value.Cmp(total) < 0 {
+		return nil, ErrInvalidNFTQuantity
+	}
+
+	err = checkLimitedTransfer(acntSnd, tokenID, dctTokenKey, e.globalSettingsHandler, e.rolesHandler, isReturnWithError)
+	if err != nil {
+		return nil, err
+	}
+	err = checkQuantityForTokenType(e.globalSettingsHandler, dctTokenKey, total)
+	if err != nil {
+		return nil, err
+	}
+
+	dctData.Value.Sub(dctData.Value, total)
+	if nonce == 0 {
+		err = checkLockedBalance(dctData, e.lockedBalanceHandler)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = e.storageHandler.SaveDCTNFTToken(acntSnd, dctTokenKey, nonce, dctData, isReturnWithError)
+	if err != nil {
+		return nil, err
+	}
+
+	return dctData, nil
+}
+
+func (e *dctMultiDistribute) appendCrossShardArguments(
+	vmInput *vmcommon.ContractCallInput,
+	args [][]byte,
+	distribution *dctDistribution,
+	vmOutput *vmcommon.VMOutput,
+) ([][]byte, error) {
+	nonceBytes := big.NewInt(0).SetUint64(distribution.nonce).Bytes()
+	args = append(args, distribution.destination, distribution.tokenID, nonceBytes)
+	if distribution.nonce == 0 {
+		return append(args, distribution.quantity.Bytes()), nil
+	}
+
+	marshaledNFTTransfer, err := e.marshalizer.Marshal(distribution.dctData)
+	if err != nil {
+		return nil, err
+	}
+
+	traceGas(vmInput, gasComponentDataCopyPerByte, uint64(len(marshaledNFTTransfer)), e.gasConfig.DataCopyPerByte)
+	gasForTransfer := uint64(len(marshaledNFTTransfer)) * e.gasConfig.DataCopyPerByte
+	if gasForTransfer > vmOutput.GasRemaining {
+		return nil, ErrNotEnoughGas
+	}
+	vmOutput.GasRemaining -= gasForTransfer
+
+	return append(args, marshaledNFTTransfer), nil
+}
+
+func (e *dctMultiDistribute) addToDestination(
+	userAccount vmcommon.UserAccountHandler,
+	distribution *dctDistribution,
+	verifyPayable bool,
+	isReturnWithError bool,
+) error {
+	dctTokenKey := append(e.keyPrefix, distribution.tokenID...)
+	err := checkLimitedTransfer(userAccount, distribution.tokenID, dctTokenKey, e.globalSettingsHandler, e.rolesHandler, isReturnWithError)
+	if err != nil {
+		return err
+	}
+
+	if verifyPayable {
+		isPayable, errIsPayable := e.payableHandler.IsPayable(distribution.destination)
+		if errIsPayable != nil {
+			return errIsPayable
+		}
+		if !isPayable {
+			return ErrAccountNotPayable
+		}
+	}
+
+	currentDCTData, isNew, err := e.storageHandler.GetDCTNFTTokenOnDestination(userAccount, dctTokenKey, distribution.nonce)
+	if err != nil {
+		return err
+	}
+
+	if distribution.nonce > 0 {
+		err = e.storageHandler.CheckDCTNFTTokenOnDestination(currentDCTData, distribution.dctData)
+		if err != nil {
+			return err
+		}
+		if isNew || currentDCTData.TokenMetaData == nil {
+			currentDCTData.Type = distribution.dctData.Type
+			currentDCTData.TokenMetaData = distribution.dctData.TokenMetaData
+		}
+	}
+	currentDCTData.Value.Add(currentDCTData.Value, distribution.quantity)
+
+	_, err = e.storageHandler.SaveDCTNFTToken(userAccount, dctTokenKey, distribution.nonce, currentDCTData, isReturnWithError)
+	return err
+}
+
+func (e *dctMultiDistribute) loadUserAccount(address []byte) (vmcommon.UserAccountHandler, error) {
+	accountHandler, err := e.accounts.LoadAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	userAccount, ok := accountHandler.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	return userAccount, nil
+}
+
+func (e *dctMultiDistribute) newDistributionLogEntry(caller []byte, distribution *dctDistribution) *vmcommon.LogEntry {
+	logEntry := newEntryForNFT(vmcommon.BuiltInFunctionDCTMultiDistribute, caller, distribution.tokenID, distribution.nonce)
+	logEntry.Topics = append(logEntry.Topics, distribution.destination, distribution.quantity.Bytes())
+
+	return logEntry
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *dctMultiDistribute) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,328 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createDCTMultiDistributeWithMockArguments(selfShard uint32, numShards uint32) *dctMultiDistribute {
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(numShards)
+	shardCoordinator.CurrentShard = selfShard
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		return uint32(address[len(address)-1])
+	}
+	mapAccounts := make(map[string]vmcommon.UserAccountHandler)
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			_, ok := mapAccounts[string(address)]
+			if !ok {
+				mapAccounts[string(address)] = mock.NewUserAccount(address)
+			}
+			return mapAccounts[string(address)], nil
+		},
+	}
+
+	multiDistribute, _ := NewDCTMultiDistributeFunc(
+		10,
+		&mock.MarshalizerMock{},
+		&mock.GlobalSettingsHandlerStub{},
+		&mock.DCTRoleHandlerStub{},
+		accounts,
+		shardCoordinator,
+		vmcommon.BaseOperationCost{},
+		createNewDCTDataStorageHandler(),
+		&mock.LockedBalanceHandlerStub{},
+		0,
+		&mock.EpochNotifierStub{},
+	)
+	_ = multiDistribute.SetPayableHandler(&mock.PayableHandlerStub{})
+
+	return multiDistribute
+}
+
+func createMultiDistributeInput(sender []byte, distributions ...[]byte) *vmcommon.ContractCallInput {
+	arguments := append([][]byte{big.NewInt(int64(len(distributions) / 4)).Bytes()}, distributions...)
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			CallerAddr:  sender,
+			Arguments:   arguments,
+			GasProvided: 1000,
+		},
+		RecipientAddr: sender,
+	}
+}
+
+func TestNewDCTMultiDistributeFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	multiDistribute, err := NewDCTMultiDistributeFunc(0, nil, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.AccountsStub{}, &mock.ShardCoordinatorStub{}, vmcommon.BaseOperationCost{}, createNewDCTDataStorageHandler(), &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(multiDistribute))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	multiDistribute, err = NewDCTMultiDistributeFunc(0, &mock.MarshalizerMock{}, nil, &mock.DCTRoleHandlerStub{}, &mock.AccountsStub{}, &mock.ShardCoordinatorStub{}, vmcommon.BaseOperationCost{}, createNewDCTDataStorageHandler(), &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(multiDistribute))
+	assert.Equal(t, ErrNilGlobalSettingsHandler, err)
+
+	multiDistribute, err = NewDCTMultiDistributeFunc(0, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, nil, &mock.AccountsStub{}, &mock.ShardCoordinatorStub{}, vmcommon.BaseOperationCost{}, createNewDCTDataStorageHandler(), &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(multiDistribute))
+	assert.Equal(t, ErrNilRolesHandler, err)
+
+	multiDistribute, err = NewDCTMultiDistributeFunc(0, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, nil, &mock.ShardCoordinatorStub{}, vmcommon.BaseOperationCost{}, createNewDCTDataStorageHandler(), &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(multiDistribute))
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+
+	multiDistribute, err = NewDCTMultiDistributeFunc(0, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.AccountsStub{}, nil, vmcommon.BaseOperationCost{}, createNewDCTDataStorageHandler(), &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(multiDistribute))
+	assert.Equal(t, ErrNilShardCoordinator, err)
+
+	multiDistribute, err = NewDCTMultiDistributeFunc(0, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.AccountsStub{}, &mock.ShardCoordinatorStub{}, vmcommon.BaseOperationCost{}, nil, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(multiDistribute))
+	assert.Equal(t, ErrNilDCTNFTStorageHandler, err)
+
+	multiDistribute, err = NewDCTMultiDistributeFunc(0, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.AccountsStub{}, &mock.ShardCoordinatorStub{}, vmcommon.BaseOperationCost{}, createNewDCTDataStorageHandler(), nil, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(multiDistribute))
+	assert.Equal(t, ErrNilLockedBalanceHandler, err)
+
+	multiDistribute, err = NewDCTMultiDistributeFunc(0, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.AccountsStub{}, &mock.ShardCoordinatorStub{}, vmcommon.BaseOperationCost{}, createNewDCTDataStorageHandler(), &mock.LockedBalanceHandlerStub{}, 0, nil)
+	assert.True(t, check.IfNil(multiDistribute))
+	assert.Equal(t, ErrNilEpochHandler, err)
+}
+
+func TestDCTMultiDistribute_SetPayableAndGasConfig(t *testing.T) {
+	t.Parallel()
+
+	multiDistribute := createDCTMultiDistributeWithMockArguments(0, 2)
+	assert.False(t, check.IfNil(multiDistribute))
+	assert.True(t, multiDistribute.IsActive())
+	assert.Equal(t, ErrNilPayableHandler, multiDistribute.SetPayableHandler(nil))
+
+	gasCost := createMockGasCost()
+	multiDistribute.SetNewGasConfig(&gasCost)
+	assert.Equal(t, gasCost.BuiltInCost.DCTMultiDistribute, multiDistribute.funcGasCost)
+	assert.Equal(t, gasCost.BaseOperationCost, multiDistribute.gasConfig)
+}
+
+func TestDCTMultiDistribute_ProcessBuiltinFunctionInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	multiDistribute := createDCTMultiDistributeWithMockArguments(0, 2)
+	senderAddress := bytes.Repeat([]byte{0}, 32)
+	sender := mock.NewUserAccount(senderAddress)
+	destination := bytes.Repeat([]byte{1}, 32)
+	token := []byte("token")
+
+	vmOutput, err := multiDistribute.ProcessBuiltinFunction(sender, nil, nil)
+	assert.Nil(t, vmOutput)
+	assert.Equal(t, ErrNilVmInput, err)
+
+	vmInput := createMultiDistributeInput(senderAddress, destination, token, []byte{}, big.NewInt(1).Bytes())
+	vmInput.Arguments = vmInput.Arguments[:len(vmInput.Arguments)-1]
+	_, err = multiDistribute.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+
+	vmInput = createMultiDistributeInput(senderAddress, destination, token, []byte{}, big.NewInt(1).Bytes())
+	vmInput.Arguments[0] = []byte{}
+	_, err = multiDistribute.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+
+	vmInput = createMultiDistributeInput(senderAddress, senderAddress, token, []byte{}, big.NewInt(1).Bytes())
+	_, err = multiDistribute.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+
+	vmInput = createMultiDistributeInput(senderAddress, []byte("short"), token, []byte{}, big.NewInt(1).Bytes())
+	_, err = multiDistribute.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+
+	vmInput = createMultiDistributeInput(senderAddress, destination, token, []byte{}, big.NewInt(0).Bytes())
+	_, err = multiDistribute.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.Equal(t, ErrInvalidNFTQuantity, err)
+
+	vmInput = createMultiDistributeInput(senderAddress, destination, token, []byte{}, big.NewInt(1).Bytes())
+	_, err = multiDistribute.ProcessBuiltinFunction(nil, nil, vmInput)
+	assert.Equal(t, ErrNilUserAccount, err)
+
+	vmInput.RecipientAddr = destination
+	_, err = multiDistribute.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.Equal(t, ErrInvalidRcvAddr, err)
+}
+
+func TestDCTMultiDistribute_ProcessBuiltinFunctionNotEnoughGas(t *testing.T) {
+	t.Parallel()
+
+	multiDistribute := createDCTMultiDistributeWithMockArguments(0, 2)
+	senderAddress := bytes.Repeat([]byte{0}, 32)
+	sender := mock.NewUserAccount(senderAddress)
+	token := []byte("token")
+	createDCTNFTToken(token, vmcommon.Fungible, 0, big.NewInt(10), multiDistribute.marshalizer, sender)
+
+	vmInput := createMultiDistributeInput(senderAddress,
+		bytes.Repeat([]byte{1}, 32), token, []byte{}, big.NewInt(1).Bytes(),
+		bytes.Repeat([]byte{3}, 32), token, []byte{}, big.NewInt(1).Bytes(),
+	)
+	vmInput.GasProvided = 19
+	vmOutput, err := multiDistribute.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.Nil(t, vmOutput)
+	assert.Equal(t, ErrNotEnoughGas, err)
+	testNFTTokenShouldExist(t, multiDistribute.marshalizer, sender, token, 0, big.NewInt(10))
+}
+
+func TestDCTMultiDistribute_ProcessBuiltinFunctionHugeCountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	multiDistribute := createDCTMultiDistributeWithMockArguments(0, 2)
+	senderAddress := bytes.Repeat([]byte{0}, 32)
+	sender := mock.NewUserAccount(senderAddress)
+	destination := bytes.Repeat([]byte{1}, 32)
+	token := []byte("token")
+
+	// the number of distributions times the arguments per distribution wraps around to the real count
+	vmInput := createMultiDistributeInput(senderAddress, destination, token, []byte{}, big.NewInt(1).Bytes())
+	vmInput.Arguments[0] = big.NewInt(0).SetUint64(math.MaxUint64/argumentsPerDistribution + 2).Bytes()
+	vmOutput, err := multiDistribute.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.Nil(t, vmOutput)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+
+	vmInput = createMultiDistributeInput(senderAddress, destination, token, []byte{}, big.NewInt(1).Bytes())
+	vmInput.Arguments[0] = []byte{1, 0, 0, 0, 0, 0, 0, 0, 1}
+	_, err = multiDistribute.ProcessBuiltinFunction(nil, sender, vmInput)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+
+	multiDistribute.funcGasCost = math.MaxUint64
+	vmInput = createMultiDistributeInput(senderAddress,
+		destination, token, []byte{}, big.NewInt(1).Bytes(),
+		destination, token, []byte{}, big.NewInt(1).Bytes(),
+	)
+	vmInput.GasProvided = math.MaxUint64
+	_, err = multiDistribute.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.Equal(t, ErrNotEnoughGas, err)
+}
+
+func TestDCTMultiDistribute_ProcessBuiltinFunctionDebitsSenderOncePerToken(t *testing.T) {
+	t.Parallel()
+
+	multiDistribute := createDCTMultiDistributeWithMockArguments(0, 2)
+	senderAddress := bytes.Repeat([]byte{0}, 32)
+	sender := mock.NewUserAccount(senderAddress)
+	token := []byte("token")
+	createDCTNFTToken(token, vmcommon.Fungible, 0, big.NewInt(10), multiDistribute.marshalizer, sender)
+
+	// every quantity is covered by the balance, but not their sum
+	destination := append(bytes.Repeat([]byte{3}, 31), 0)
+	vmInput := createMultiDistributeInput(senderAddress,
+		destination, token, []byte{}, big.NewInt(6).Bytes(),
+		destination, token, []byte{}, big.NewInt(6).Bytes(),
+	)
+	vmOutput, err := multiDistribute.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.Nil(t, vmOutput)
+	assert.Equal(t, ErrInvalidNFTQuantity, err)
+	testNFTTokenShouldExist(t, multiDistribute.marshalizer, sender, token, 0, big.NewInt(10))
+}
+
+func TestDCTMultiDistribute_ProcessBuiltinFunctionOnSameShardShouldWork(t *testing.T) {
+	t.Parallel()
+
+	multiDistribute := createDCTMultiDistributeWithMockArguments(0, 2)
+	senderAddress := bytes.Repeat([]byte{0}, 32)
+	sender := mock.NewUserAccount(senderAddress)
+	token := []byte("token")
+	nft := []byte("nft")
+	createDCTNFTToken(token, vmcommon.Fungible, 0, big.NewInt(10), multiDistribute.marshalizer, sender)
+	createDCTNFTToken(nft, vmcommon.SemiFungible, 2, big.NewInt(5), multiDistribute.marshalizer, sender)
+
+	destination1 := append(bytes.Repeat([]byte{3}, 31), 0)
+	destination2 := append(bytes.Repeat([]byte{4}, 31), 0)
+	vmInput := createMultiDistributeInput(senderAddress,
+		destination1, token, []byte{}, big.NewInt(3).Bytes(),
+		destination2, token, []byte{}, big.NewInt(4).Bytes(),
+		destination2, nft, big.NewInt(2).Bytes(), big.NewInt(2).Bytes(),
+		destination1, token, []byte{}, big.NewInt(1).Bytes(),
+	)
+	vmOutput, err := multiDistribute.ProcessBuiltinFunction(sender, nil, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	assert.Equal(t, uint64(1000-4*10), vmOutput.GasRemaining)
+	assert.Equal(t, 0, len(vmOutput.OutputAccounts))
+
+	testNFTTokenShouldExist(t, multiDistribute.marshalizer, sender, token, 0, big.NewInt(2))
+	testNFTTokenShouldExist(t, multiDistribute.marshalizer, sender, nft, 2, big.NewInt(3))
+
+	acnt1, _ := multiDistribute.accounts.LoadAccount(destination1)
+	acnt2, _ := multiDistribute.accounts.LoadAccount(destination2)
+	testNFTTokenShouldExist(t, multiDistribute.marshalizer, acnt1, token, 0, big.NewInt(4))
+	testNFTTokenShouldExist(t, multiDistribute.marshalizer, acnt2, token, 0, big.NewInt(4))
+	testNFTTokenShouldExist(t, multiDistribute.marshalizer, acnt2, nft, 2, big.NewInt(2))
+
+	require.Equal(t, 4, len(vmOutput.Logs))
+	assert.Equal(t, []byte(vmcommon.BuiltInFunctionDCTMultiDistribute), vmOutput.Logs[2].Identifier)
+	assert.Equal(t, senderAddress, vmOutput.Logs[2].Address)
+	assert.Equal(t, [][]byte{nft, big.NewInt(2).Bytes(), destination2, big.NewInt(2).Bytes()}, vmOutput.Logs[2].Topics)
+}
+
+func TestDCTMultiDistribute_ProcessBuiltinFunctionCrossShardShouldWork(t *testing.T) {
+	t.Parallel()
+
+	multiDistributeSenderShard := createDCTMultiDistributeWithMockArguments(0, 3)
+	multiDistributeShard1 := createDCTMultiDistributeWithMockArguments(1, 3)
+	senderAddress := bytes.Repeat([]byte{0}, 32)
+	sender := mock.NewUserAccount(senderAddress)
+	token := []byte("token")
+	nft := []byte("nft")
+	createDCTNFTToken(token, vmcommon.Fungible, 0, big.NewInt(10), multiDistributeSenderShard.marshalizer, sender)
+	createDCTNFTToken(nft, vmcommon.NonFungible, 7, big.NewInt(1), multiDistributeSenderShard.marshalizer, sender)
+
+	localDestination := append(bytes.Repeat([]byte{3}, 31), 0)
+	destinationShard1a := append(bytes.Repeat([]byte{4}, 31), 1)
+	destinationShard1b := append(bytes.Repeat([]byte{5}, 31), 1)
+	destinationShard2 := append(bytes.Repeat([]byte{6}, 31), 2)
+	vmInput := createMultiDistributeInput(senderAddress,
+		destinationShard1a, token, []byte{}, big.NewInt(1).Bytes(),
+		localDestination, token, []byte{}, big.NewInt(2).Bytes(),
+		destinationShard2, token, []byte{}, big.NewInt(3).Bytes(),
+		destinationShard1b, nft, big.NewInt(7).Bytes(), big.NewInt(1).Bytes(),
+	)
+	vmOutput, err := multiDistributeSenderShard.ProcessBuiltinFunction(sender, nil, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, 4, len(vmOutput.Logs))
+	testNFTTokenShouldExist(t, multiDistributeSenderShard.marshalizer, sender, token, 0, big.NewInt(4))
+	localAccount, _ := multiDistributeSenderShard.accounts.LoadAccount(localDestination)
+	testNFTTokenShouldExist(t, multiDistributeSenderShard.marshalizer, localAccount, token, 0, big.NewInt(2))
+
+	// one transfer for every foreign shard, sent to the first recipient of the shard
+	require.Equal(t, 2, len(vmOutput.OutputAccounts))
+	require.NotNil(t, vmOutput.OutputAccounts[string(destinationShard2)])
+	outAcc := vmOutput.OutputAccounts[string(destinationShard1a)]
+	require.NotNil(t, outAcc)
+	require.Equal(t, 1, len(outAcc.OutputTransfers))
+
+	funcName, args := extractScResultsFromVmOutput(t, &vmcommon.VMOutput{OutputAccounts: map[string]*vmcommon.OutputAccount{"": outAcc}})
+	assert.Equal(t, vmcommon.BuiltInFunctionDCTMultiDistribute, funcName)
+	require.Equal(t, 9, len(args))
+	assert.Equal(t, big.NewInt(2).Bytes(), args[0])
+
+	destination, _ := multiDistributeShard1.accounts.LoadAccount(destinationShard1a)
+	vmInput = &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: senderAddress,
+			Arguments:  args,
+		},
+		RecipientAddr: destinationShard1a,
+	}
+	vmOutput, err = multiDistributeShard1.ProcessBuiltinFunction(nil, destination.(vmcommon.UserAccountHandler), vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, 2, len(vmOutput.Logs))
+
+	testNFTTokenShouldExist(t, multiDistributeShard1.marshalizer, destination, token, 0, big.NewInt(1))
+	otherDestination, _ := multiDistributeShard1.accounts.LoadAccount(destinationShard1b)
+	testNFTTokenShouldExist(t, multiDistributeShard1.marshalizer, otherDestination, nft, 7, big.NewInt(1))
+}
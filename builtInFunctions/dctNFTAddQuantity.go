@@ -10,38 +10,50 @@ import (
 
 type dctNFTAddQuantity struct {
 	baseAlwaysActive
-	keyPrefix    []byte
-	marshalizer  vmcommon.Marshalizer
-	pauseHandler vmcommon.DCTPauseHandler
-	rolesHandler vmcommon.DCTRoleHandler
-	funcGasCost  uint64
-	mutExecution sync.RWMutex
+	keyPrefix             []byte
+	marshalizer           vmcommon.Marshalizer
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler
+	rolesHandler          vmcommon.DCTRoleHandler
+	storageHandler        vmcommon.DCTNFTStorageHandler
+	supplyHandler         vmcommon.DCTSupplyHandler
+	funcGasCost           uint64
+	mutExecution          sync.RWMutex
 }
 
 // NewDCTNFTAddQuantityFunc returns the dct NFT add quantity built-in function component
 func NewDCTNFTAddQuantityFunc(
 	funcGasCost uint64,
 	marshalizer vmcommon.Marshalizer,
-	pauseHandler vmcommon.DCTPauseHandler,
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler,
 	rolesHandler vmcommon.DCTRoleHandler,
+	supplyHandler vmcommon.DCTSupplyHandler,
+	storageHandler vmcommon.DCTNFTStorageHandler,
 ) (*dctNFTAddQuantity, error) {
 	if check.IfNil(marshalizer) {
 		return nil, ErrNilMarshalizer
 	}
-	if check.IfNil(pauseHandler) {
-		return nil, ErrNilPauseHandler
+	if check.IfNil(globalSettingsHandler) {
+		return nil, ErrNilGlobalSettingsHandler
 	}
 	if check.IfNil(rolesHandler) {
 		return nil, ErrNilRolesHandler
 	}
+	if check.IfNil(storageHandler) {
+		return nil, ErrNilDCTNFTStorageHandler
+	}
+	if check.IfNil(supplyHandler) {
+		return nil, ErrNilSupplyHandler
+	}
 
 	e := &dctNFTAddQuantity{
-		keyPrefix:    []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
-		marshalizer:  marshalizer,
-		pauseHandler: pauseHandler,
-		rolesHandler: rolesHandler,
-		funcGasCost:  funcGasCost,
-		mutExecution: sync.RWMutex{},
+		keyPrefix:             []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
+		marshalizer:           marshalizer,
+		globalSettingsHandler: globalSettingsHandler,
+		rolesHandler:          rolesHandler,
+		storageHandler:        storageHandler,
+		supplyHandler:         supplyHandler,
+		funcGasCost:           funcGasCost,
+		mutExecution:          sync.RWMutex{},
 	}
 
 	return e, nil
@@ -84,18 +96,31 @@ func (e *dctNFTAddQuantity) ProcessBuiltinFunction(
 	}
 
 	dctTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
+	if !isAddQuantityAllowed(e.globalSettingsHandler.GetTokenType(dctTokenKey)) {
+		return nil, ErrAddQuantityNotAllowedForTokenType
+	}
 	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
 	if nonce == 0 {
 		return nil, ErrNFTDoesNotHaveMetadata
 	}
-	dctData, err := getDCTNFTTokenOnSender(acntSnd, dctTokenKey, nonce, e.marshalizer)
+	dctData, err := e.storageHandler.GetDCTNFTTokenOnSender(acntSnd, dctTokenKey, nonce)
 	if err != nil {
 		return nil, err
 	}
 
-	dctData.Value.Add(dctData.Value, big.NewInt(0).SetBytes(vmInput.Arguments[2]))
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	err = e.supplyHandler.UseMintAllowance(vmInput.Arguments[0], value)
+	if err != nil {
+		return nil, err
+	}
+	dctData.Value.Add(dctData.Value, value)
 
-	_, err = saveDCTNFTToken(acntSnd, dctTokenKey, dctData, e.marshalizer, e.pauseHandler, vmInput.ReturnCallAfterError)
+	_, err = e.storageHandler.SaveDCTNFTToken(acntSnd, dctTokenKey, nonce, dctData, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.supplyHandler.UpdateSupply(vmInput.Arguments[0], nonce, value)
 	if err != nil {
 		return nil, err
 	}
@@ -109,6 +134,17 @@ func (e *dctNFTAddQuantity) ProcessBuiltinFunction(
 	return vmOutput, nil
 }
 
+// isAddQuantityAllowed returns true for semi fungible and meta tokens. Tokens with the type not set are legacy
+// tokens, created before the types were stored, and are allowed as well
+func isAddQuantityAllowed(tokenType uint32) bool {
+	switch vmcommon.DCTType(tokenType) {
+	case vmcommon.Fungible, vmcommon.SemiFungible, vmcommon.MetaFungible:
+		return true
+	default:
+		return false
+	}
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *dctNFTAddQuantity) IsInterfaceNil() bool {
 	return e == nil
@@ -16,22 +16,32 @@ func TestNewDCTNFTAddQuantityFunc(t *testing.T) {
 	t.Parallel()
 
 	// nil marshalizer
-	eqf, err := NewDCTNFTAddQuantityFunc(10, nil, nil, nil)
+	eqf, err := NewDCTNFTAddQuantityFunc(10, nil, nil, nil, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler())
 	require.True(t, check.IfNil(eqf))
 	require.Equal(t, ErrNilMarshalizer, err)
 
 	// nil pause handler
-	eqf, err = NewDCTNFTAddQuantityFunc(10, &mock.MarshalizerMock{}, nil, nil)
+	eqf, err = NewDCTNFTAddQuantityFunc(10, &mock.MarshalizerMock{}, nil, nil, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler())
 	require.True(t, check.IfNil(eqf))
-	require.Equal(t, ErrNilPauseHandler, err)
+	require.Equal(t, ErrNilGlobalSettingsHandler, err)
 
 	// nil roles handler
-	eqf, err = NewDCTNFTAddQuantityFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil)
+	eqf, err = NewDCTNFTAddQuantityFunc(10, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, nil, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler())
 	require.True(t, check.IfNil(eqf))
 	require.Equal(t, ErrNilRolesHandler, err)
 
+	// nil supply handler
+	eqf, err = NewDCTNFTAddQuantityFunc(10, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, nil, createNewDCTDataStorageHandler())
+	require.True(t, check.IfNil(eqf))
+	require.Equal(t, ErrNilSupplyHandler, err)
+
+	// nil storage handler
+	eqf, err = NewDCTNFTAddQuantityFunc(10, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, nil)
+	require.True(t, check.IfNil(eqf))
+	require.Equal(t, ErrNilDCTNFTStorageHandler, err)
+
 	// should work
-	eqf, err = NewDCTNFTAddQuantityFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{})
+	eqf, err = NewDCTNFTAddQuantityFunc(10, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler())
 	require.False(t, check.IfNil(eqf))
 	require.NoError(t, err)
 }
@@ -40,7 +50,7 @@ func TestDctNFTAddQuantity_SetNewGasConfig_NilGasCost(t *testing.T) {
 	t.Parallel()
 
 	defaultGasCost := uint64(10)
-	eqf, _ := NewDCTNFTAddQuantityFunc(defaultGasCost, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{})
+	eqf, _ := NewDCTNFTAddQuantityFunc(defaultGasCost, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler())
 
 	eqf.SetNewGasConfig(nil)
 	require.Equal(t, defaultGasCost, eqf.funcGasCost)
@@ -51,7 +61,7 @@ func TestDctNFTAddQuantity_SetNewGasConfig_ShouldWork(t *testing.T) {
 
 	defaultGasCost := uint64(10)
 	newGasCost := uint64(37)
-	eqf, _ := NewDCTNFTAddQuantityFunc(defaultGasCost, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{})
+	eqf, _ := NewDCTNFTAddQuantityFunc(defaultGasCost, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler())
 
 	eqf.SetNewGasConfig(
 		&vmcommon.GasCost{
@@ -67,7 +77,7 @@ func TestDctNFTAddQuantity_SetNewGasConfig_ShouldWork(t *testing.T) {
 func TestDctNFTAddQuantity_ProcessBuiltinFunctionErrorOnCheckDCTNFTCreateBurnAddInput(t *testing.T) {
 	t.Parallel()
 
-	eqf, _ := NewDCTNFTAddQuantityFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{})
+	eqf, _ := NewDCTNFTAddQuantityFunc(10, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler())
 
 	// nil vm input
 	output, err := eqf.ProcessBuiltinFunction(mock.NewAccountWrapMock([]byte("addr")), nil, nil)
@@ -168,7 +178,7 @@ func TestDctNFTAddQuantity_ProcessBuiltinFunctionErrorOnCheckDCTNFTCreateBurnAdd
 func TestDctNFTAddQuantity_ProcessBuiltinFunctionInvalidNumberOfArguments(t *testing.T) {
 	t.Parallel()
 
-	eqf, _ := NewDCTNFTAddQuantityFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{})
+	eqf, _ := NewDCTNFTAddQuantityFunc(10, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler())
 	output, err := eqf.ProcessBuiltinFunction(
 		mock.NewAccountWrapMock([]byte("addr")),
 		nil,
@@ -195,7 +205,7 @@ func TestDctNFTAddQuantity_ProcessBuiltinFunctionCheckAllowedToExecuteError(t *t
 			return localErr
 		},
 	}
-	eqf, _ := NewDCTNFTAddQuantityFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, rolesHandler)
+	eqf, _ := NewDCTNFTAddQuantityFunc(10, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, rolesHandler, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler())
 	output, err := eqf.ProcessBuiltinFunction(
 		mock.NewAccountWrapMock([]byte("addr")),
 		nil,
@@ -217,7 +227,7 @@ func TestDctNFTAddQuantity_ProcessBuiltinFunctionCheckAllowedToExecuteError(t *t
 func TestDctNFTAddQuantity_ProcessBuiltinFunctionNewSenderShouldErr(t *testing.T) {
 	t.Parallel()
 
-	eqf, _ := NewDCTNFTAddQuantityFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{})
+	eqf, _ := NewDCTNFTAddQuantityFunc(10, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler())
 	output, err := eqf.ProcessBuiltinFunction(
 		mock.NewAccountWrapMock([]byte("addr")),
 		nil,
@@ -241,7 +251,7 @@ func TestDctNFTAddQuantity_ProcessBuiltinFunctionMetaDataMissing(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	eqf, _ := NewDCTNFTAddQuantityFunc(10, marshalizer, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{})
+	eqf, _ := NewDCTNFTAddQuantityFunc(10, marshalizer, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler())
 
 	userAcc := mock.NewAccountWrapMock([]byte("addr"))
 	dctData := &dct.DCToken{}
@@ -269,13 +279,13 @@ func TestDctNFTAddQuantity_ProcessBuiltinFunctionShouldErrOnSaveBecauseTokenIsPa
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	pauseHandler := &mock.PauseHandlerStub{
+	globalSettingsHandler := &mock.GlobalSettingsHandlerStub{
 		IsPausedCalled: func(_ []byte) bool {
 			return true
 		},
 	}
 
-	eqf, _ := NewDCTNFTAddQuantityFunc(10, marshalizer, pauseHandler, &mock.DCTRoleHandlerStub{})
+	eqf, _ := NewDCTNFTAddQuantityFunc(10, marshalizer, globalSettingsHandler, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandlerWithArgs(globalSettingsHandler, createAccountsWithSystemAccount(), 1))
 
 	userAcc := mock.NewAccountWrapMock([]byte("addr"))
 	dctData := &dct.DCToken{
@@ -317,7 +327,7 @@ func TestDctNFTAddQuantity_ProcessBuiltinFunctionShouldWork(t *testing.T) {
 	expectedValue := big.NewInt(0).Add(initialValue, valueToAdd)
 
 	marshalizer := &mock.MarshalizerMock{}
-	eqf, _ := NewDCTNFTAddQuantityFunc(10, marshalizer, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{})
+	eqf, _ := NewDCTNFTAddQuantityFunc(10, marshalizer, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler())
 
 	userAcc := mock.NewAccountWrapMock([]byte("addr"))
 	dctData := &dct.DCToken{
@@ -348,7 +358,7 @@ func TestDctNFTAddQuantity_ProcessBuiltinFunctionShouldWork(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, vmcommon.Ok, output.ReturnCode)
 
-	res, err := userAcc.AccountDataHandler().RetrieveValue([]byte(key))
+	res, err := userAcc.AccountDataHandler().RetrieveValue(tokenKey)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -356,3 +366,47 @@ func TestDctNFTAddQuantity_ProcessBuiltinFunctionShouldWork(t *testing.T) {
 	_ = marshalizer.Unmarshal(&finalTokenData, res)
 	require.Equal(t, expectedValue.Bytes(), finalTokenData.Value.Bytes())
 }
+
+func TestDctNFTAddQuantity_ProcessBuiltinFunctionNonFungibleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	eqf, _ := NewDCTNFTAddQuantityFunc(
+		10,
+		&mock.MarshalizerMock{},
+		&mock.GlobalSettingsHandlerStub{
+			GetTokenTypeCalled: func(_ []byte) uint32 {
+				return uint32(vmcommon.NonFungible)
+			},
+		},
+		&mock.DCTRoleHandlerStub{},
+		&mock.SupplyHandlerStub{},
+		createNewDCTDataStorageHandler(),
+	)
+
+	output, err := eqf.ProcessBuiltinFunction(
+		mock.NewAccountWrapMock([]byte("addr")),
+		nil,
+		&vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallValue:   big.NewInt(0),
+				Arguments:   [][]byte{[]byte("testTkn"), big.NewInt(1).Bytes(), big.NewInt(1).Bytes()},
+				CallerAddr:  []byte("address 1"),
+				GasProvided: 12,
+			},
+			RecipientAddr: []byte("address 1"),
+		},
+	)
+
+	require.Nil(t, output)
+	require.Equal(t, ErrAddQuantityNotAllowedForTokenType, err)
+}
+
+func TestIsAddQuantityAllowed(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, isAddQuantityAllowed(uint32(vmcommon.Fungible)))
+	require.False(t, isAddQuantityAllowed(uint32(vmcommon.NonFungible)))
+	require.True(t, isAddQuantityAllowed(uint32(vmcommon.SemiFungible)))
+	require.True(t, isAddQuantityAllowed(uint32(vmcommon.MetaFungible)))
+	require.False(t, isAddQuantityAllowed(10))
+}
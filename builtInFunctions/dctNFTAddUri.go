@@ -11,13 +11,13 @@ import (
 
 type dctNFTAddUri struct {
 	*baseEnabled
-	keyPrefix    []byte
-	marshalizer  vmcommon.Marshalizer
-	pauseHandler vmcommon.DCTPauseHandler
-	rolesHandler vmcommon.DCTRoleHandler
-	gasConfig    vmcommon.BaseOperationCost
-	funcGasCost  uint64
-	mutExecution sync.RWMutex
+	keyPrefix      []byte
+	marshalizer    vmcommon.Marshalizer
+	storageHandler vmcommon.DCTNFTStorageHandler
+	rolesHandler   vmcommon.DCTRoleHandler
+	gasConfig      vmcommon.BaseOperationCost
+	funcGasCost    uint64
+	mutExecution   sync.RWMutex
 }
 
 // NewDCTNFTAddUriFunc returns the dct NFT add URI built-in function component
@@ -25,7 +25,7 @@ func NewDCTNFTAddUriFunc(
 	funcGasCost uint64,
 	gasConfig vmcommon.BaseOperationCost,
 	marshalizer vmcommon.Marshalizer,
-	pauseHandler vmcommon.DCTPauseHandler,
+	storageHandler vmcommon.DCTNFTStorageHandler,
 	rolesHandler vmcommon.DCTRoleHandler,
 	activationEpoch uint32,
 	epochNotifier vmcommon.EpochNotifier,
@@ -33,8 +33,8 @@ func NewDCTNFTAddUriFunc(
 	if check.IfNil(marshalizer) {
 		return nil, ErrNilMarshalizer
 	}
-	if check.IfNil(pauseHandler) {
-		return nil, ErrNilPauseHandler
+	if check.IfNil(storageHandler) {
+		return nil, ErrNilDCTNFTStorageHandler
 	}
 	if check.IfNil(rolesHandler) {
 		return nil, ErrNilRolesHandler
@@ -44,13 +44,13 @@ func NewDCTNFTAddUriFunc(
 	}
 
 	e := &dctNFTAddUri{
-		keyPrefix:    []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
-		marshalizer:  marshalizer,
-		funcGasCost:  funcGasCost,
-		mutExecution: sync.RWMutex{},
-		pauseHandler: pauseHandler,
-		gasConfig:    gasConfig,
-		rolesHandler: rolesHandler,
+		keyPrefix:      []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
+		marshalizer:    marshalizer,
+		funcGasCost:    funcGasCost,
+		mutExecution:   sync.RWMutex{},
+		storageHandler: storageHandler,
+		gasConfig:      gasConfig,
+		rolesHandler:   rolesHandler,
 	}
 
 	e.baseEnabled = &baseEnabled{
@@ -111,14 +111,14 @@ func (e *dctNFTAddUri) ProcessBuiltinFunction(
 	if nonce == 0 {
 		return nil, ErrNFTDoesNotHaveMetadata
 	}
-	dctData, err := getDCTNFTTokenOnSender(acntSnd, dctTokenKey, nonce, e.marshalizer)
+	dctData, err := e.storageHandler.GetDCTNFTTokenOnSender(acntSnd, dctTokenKey, nonce)
 	if err != nil {
 		return nil, err
 	}
 
 	dctData.TokenMetaData.URIs = append(dctData.TokenMetaData.URIs, vmInput.Arguments[2:]...)
 
-	_, err = saveDCTNFTToken(acntSnd, dctTokenKey, dctData, e.marshalizer, e.pauseHandler, vmInput.ReturnCallAfterError)
+	_, err = e.storageHandler.SaveDCTNFTToken(acntSnd, dctTokenKey, nonce, dctData, vmInput.ReturnCallAfterError)
 	if err != nil {
 		return nil, err
 	}
@@ -137,6 +137,7 @@ func (e *dctNFTAddUri) getGasCostForURIStore(vmInput *vmcommon.ContractCallInput
 	for _, uri := range vmInput.Arguments[2:] {
 		lenURIs += len(uri)
 	}
+	traceGas(vmInput, gasComponentStorePerByte, uint64(lenURIs), e.gasConfig.StorePerByte)
 	return uint64(lenURIs) * e.gasConfig.StorePerByte
 }
 
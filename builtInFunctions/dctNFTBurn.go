@@ -10,39 +10,65 @@ import (
 
 type dctNFTBurn struct {
 	baseAlwaysActive
-	keyPrefix    []byte
-	marshalizer  vmcommon.Marshalizer
-	pauseHandler vmcommon.DCTPauseHandler
-	rolesHandler vmcommon.DCTRoleHandler
-	funcGasCost  uint64
-	mutExecution sync.RWMutex
+	baseGasRefund
+	keyPrefix             []byte
+	marshalizer           vmcommon.Marshalizer
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler
+	rolesHandler          vmcommon.DCTRoleHandler
+	storageHandler        vmcommon.DCTNFTStorageHandler
+	supplyHandler         vmcommon.DCTSupplyHandler
+	funcGasCost           uint64
+	gasConfig             vmcommon.BaseOperationCost
+	mutExecution          sync.RWMutex
 }
 
 // NewDCTNFTBurnFunc returns the dct NFT burn built-in function component
 func NewDCTNFTBurnFunc(
 	funcGasCost uint64,
+	gasConfig vmcommon.BaseOperationCost,
 	marshalizer vmcommon.Marshalizer,
-	pauseHandler vmcommon.DCTPauseHandler,
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler,
 	rolesHandler vmcommon.DCTRoleHandler,
+	supplyHandler vmcommon.DCTSupplyHandler,
+	storageHandler vmcommon.DCTNFTStorageHandler,
+	gasRefundEnableEpoch uint32,
+	epochNotifier vmcommon.EpochNotifier,
 ) (*dctNFTBurn, error) {
 	if check.IfNil(marshalizer) {
 		return nil, ErrNilMarshalizer
 	}
-	if check.IfNil(pauseHandler) {
-		return nil, ErrNilPauseHandler
+	if check.IfNil(globalSettingsHandler) {
+		return nil, ErrNilGlobalSettingsHandler
 	}
 	if check.IfNil(rolesHandler) {
 		return nil, ErrNilRolesHandler
 	}
+	if check.IfNil(storageHandler) {
+		return nil, ErrNilDCTNFTStorageHandler
+	}
+	if check.IfNil(supplyHandler) {
+		return nil, ErrNilSupplyHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, ErrNilEpochHandler
+	}
 
 	e := &dctNFTBurn{
-		keyPrefix:    []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
-		marshalizer:  marshalizer,
-		pauseHandler: pauseHandler,
-		rolesHandler: rolesHandler,
-		funcGasCost:  funcGasCost,
-		mutExecution: sync.RWMutex{},
+		baseGasRefund: baseGasRefund{
+			function:             vmcommon.BuiltInFunctionDCTNFTBurn,
+			gasRefundEnableEpoch: gasRefundEnableEpoch,
+		},
+		keyPrefix:             []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
+		marshalizer:           marshalizer,
+		globalSettingsHandler: globalSettingsHandler,
+		rolesHandler:          rolesHandler,
+		storageHandler:        storageHandler,
+		supplyHandler:         supplyHandler,
+		funcGasCost:           funcGasCost,
+		gasConfig:             gasConfig,
+		mutExecution:          sync.RWMutex{},
 	}
+	epochNotifier.RegisterNotifyHandler(e)
 
 	return e, nil
 }
@@ -55,6 +81,7 @@ func (e *dctNFTBurn) SetNewGasConfig(gasCost *vmcommon.GasCost) {
 
 	e.mutExecution.Lock()
 	e.funcGasCost = gasCost.BuiltInCost.DCTNFTBurn
+	e.gasConfig = gasCost.BaseOperationCost
 	e.mutExecution.Unlock()
 }
 
@@ -88,7 +115,7 @@ func (e *dctNFTBurn) ProcessBuiltinFunction(
 	if nonce == 0 {
 		return nil, ErrNFTDoesNotHaveMetadata
 	}
-	dctData, err := getDCTNFTTokenOnSender(acntSnd, dctTokenKey, nonce, e.marshalizer)
+	dctData, err := e.storageHandler.GetDCTNFTTokenOnSender(acntSnd, dctTokenKey, nonce)
 	if err != nil {
 		return nil, err
 	}
@@ -97,10 +124,28 @@ func (e *dctNFTBurn) ProcessBuiltinFunction(
 	if dctData.Value.Cmp(quantityToBurn) < 0 {
 		return nil, ErrInvalidNFTQuantity
 	}
+	err = checkQuantityForTokenType(e.globalSettingsHandler, dctTokenKey, quantityToBurn)
+	if err != nil {
+		return nil, err
+	}
 
 	dctData.Value.Sub(dctData.Value, quantityToBurn)
 
-	_, err = saveDCTNFTToken(acntSnd, dctTokenKey, dctData, e.marshalizer, e.pauseHandler, vmInput.ReturnCallAfterError)
+	dctNFTTokenKey := computeDCTNFTTokenKey(dctTokenKey, nonce)
+	oldLength := uint64(0)
+	if e.flagGasRefund.IsSet() {
+		oldLength, err = getStoredLength(acntSnd, dctNFTTokenKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = e.storageHandler.SaveDCTNFTToken(acntSnd, dctTokenKey, nonce, dctData, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.supplyHandler.UpdateSupply(vmInput.Arguments[0], nonce, big.NewInt(0).Neg(quantityToBurn))
 	if err != nil {
 		return nil, err
 	}
@@ -111,6 +156,17 @@ func (e *dctNFTBurn) ProcessBuiltinFunction(
 		GasRemaining: vmInput.GasProvided - e.funcGasCost,
 		Logs:         []*vmcommon.LogEntry{logEntry},
 	}
+	if e.flagGasRefund.IsSet() {
+		newLength, errLength := getStoredLength(acntSnd, dctNFTTokenKey)
+		if errLength != nil {
+			return nil, errLength
+		}
+
+		releasedBytes := computeReleasedBytes(oldLength, newLength)
+		if releasedBytes > 0 {
+			vmOutput.GasRefund = computeGasRefund(releasedBytes, e.gasConfig.ReleasePerByte, e.funcGasCost)
+		}
+	}
 	return vmOutput, nil
 }
 
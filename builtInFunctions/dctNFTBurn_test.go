@@ -16,22 +16,37 @@ func TestNewDCTNFTBurnFunc(t *testing.T) {
 	t.Parallel()
 
 	// nil marshalizer
-	ebf, err := NewDCTNFTBurnFunc(10, nil, nil, nil)
+	ebf, err := NewDCTNFTBurnFunc(10, vmcommon.BaseOperationCost{}, nil, nil, nil, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler(), 0, &mock.EpochNotifierStub{})
 	require.True(t, check.IfNil(ebf))
 	require.Equal(t, ErrNilMarshalizer, err)
 
 	// nil pause handler
-	ebf, err = NewDCTNFTBurnFunc(10, &mock.MarshalizerMock{}, nil, nil)
+	ebf, err = NewDCTNFTBurnFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, nil, nil, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler(), 0, &mock.EpochNotifierStub{})
 	require.True(t, check.IfNil(ebf))
-	require.Equal(t, ErrNilPauseHandler, err)
+	require.Equal(t, ErrNilGlobalSettingsHandler, err)
 
 	// nil roles handler
-	ebf, err = NewDCTNFTBurnFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil)
+	ebf, err = NewDCTNFTBurnFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, nil, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler(), 0, &mock.EpochNotifierStub{})
 	require.True(t, check.IfNil(ebf))
 	require.Equal(t, ErrNilRolesHandler, err)
 
+	// nil supply handler
+	ebf, err = NewDCTNFTBurnFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, nil, createNewDCTDataStorageHandler(), 0, &mock.EpochNotifierStub{})
+	require.True(t, check.IfNil(ebf))
+	require.Equal(t, ErrNilSupplyHandler, err)
+
+	// nil storage handler
+	ebf, err = NewDCTNFTBurnFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, nil, 0, &mock.EpochNotifierStub{})
+	require.True(t, check.IfNil(ebf))
+	require.Equal(t, ErrNilDCTNFTStorageHandler, err)
+
+	// nil epoch notifier
+	ebf, err = NewDCTNFTBurnFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler(), 0, nil)
+	require.True(t, check.IfNil(ebf))
+	require.Equal(t, ErrNilEpochHandler, err)
+
 	// should work
-	ebf, err = NewDCTNFTBurnFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{})
+	ebf, err = NewDCTNFTBurnFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler(), 0, &mock.EpochNotifierStub{})
 	require.False(t, check.IfNil(ebf))
 	require.NoError(t, err)
 }
@@ -40,7 +55,7 @@ func TestDCTNFTBurn_SetNewGasConfig_NilGasCost(t *testing.T) {
 	t.Parallel()
 
 	defaultGasCost := uint64(10)
-	ebf, _ := NewDCTNFTBurnFunc(defaultGasCost, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{})
+	ebf, _ := NewDCTNFTBurnFunc(defaultGasCost, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler(), 0, &mock.EpochNotifierStub{})
 
 	ebf.SetNewGasConfig(nil)
 	require.Equal(t, defaultGasCost, ebf.funcGasCost)
@@ -51,7 +66,7 @@ func TestDctNFTBurnFunc_SetNewGasConfig_ShouldWork(t *testing.T) {
 
 	defaultGasCost := uint64(10)
 	newGasCost := uint64(37)
-	ebf, _ := NewDCTNFTBurnFunc(defaultGasCost, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{})
+	ebf, _ := NewDCTNFTBurnFunc(defaultGasCost, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler(), 0, &mock.EpochNotifierStub{})
 
 	ebf.SetNewGasConfig(
 		&vmcommon.GasCost{
@@ -67,7 +82,7 @@ func TestDctNFTBurnFunc_SetNewGasConfig_ShouldWork(t *testing.T) {
 func TestDctNFTBurnFunc_ProcessBuiltinFunctionErrorOnCheckDCTNFTCreateBurnAddInput(t *testing.T) {
 	t.Parallel()
 
-	ebf, _ := NewDCTNFTBurnFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{})
+	ebf, _ := NewDCTNFTBurnFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler(), 0, &mock.EpochNotifierStub{})
 
 	// nil vm input
 	output, err := ebf.ProcessBuiltinFunction(mock.NewAccountWrapMock([]byte("addr")), nil, nil)
@@ -168,7 +183,7 @@ func TestDctNFTBurnFunc_ProcessBuiltinFunctionErrorOnCheckDCTNFTCreateBurnAddInp
 func TestDctNFTBurnFunc_ProcessBuiltinFunctionInvalidNumberOfArguments(t *testing.T) {
 	t.Parallel()
 
-	ebf, _ := NewDCTNFTBurnFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{})
+	ebf, _ := NewDCTNFTBurnFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler(), 0, &mock.EpochNotifierStub{})
 	output, err := ebf.ProcessBuiltinFunction(
 		mock.NewAccountWrapMock([]byte("addr")),
 		nil,
@@ -195,7 +210,7 @@ func TestDctNFTBurnFunc_ProcessBuiltinFunctionCheckAllowedToExecuteError(t *test
 			return localErr
 		},
 	}
-	ebf, _ := NewDCTNFTBurnFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, rolesHandler)
+	ebf, _ := NewDCTNFTBurnFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, rolesHandler, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler(), 0, &mock.EpochNotifierStub{})
 	output, err := ebf.ProcessBuiltinFunction(
 		mock.NewAccountWrapMock([]byte("addr")),
 		nil,
@@ -217,7 +232,7 @@ func TestDctNFTBurnFunc_ProcessBuiltinFunctionCheckAllowedToExecuteError(t *test
 func TestDctNFTBurnFunc_ProcessBuiltinFunctionNewSenderShouldErr(t *testing.T) {
 	t.Parallel()
 
-	ebf, _ := NewDCTNFTBurnFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{})
+	ebf, _ := NewDCTNFTBurnFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler(), 0, &mock.EpochNotifierStub{})
 	output, err := ebf.ProcessBuiltinFunction(
 		mock.NewAccountWrapMock([]byte("addr")),
 		nil,
@@ -241,7 +256,7 @@ func TestDctNFTBurnFunc_ProcessBuiltinFunctionMetaDataMissing(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	ebf, _ := NewDCTNFTBurnFunc(10, marshalizer, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{})
+	ebf, _ := NewDCTNFTBurnFunc(10, vmcommon.BaseOperationCost{}, marshalizer, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler(), 0, &mock.EpochNotifierStub{})
 
 	userAcc := mock.NewAccountWrapMock([]byte("addr"))
 	dctData := &dct.DCToken{}
@@ -273,7 +288,7 @@ func TestDctNFTBurnFunc_ProcessBuiltinFunctionInvalidBurnQuantity(t *testing.T)
 
 	marshalizer := &mock.MarshalizerMock{}
 
-	ebf, _ := NewDCTNFTBurnFunc(10, marshalizer, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{})
+	ebf, _ := NewDCTNFTBurnFunc(10, vmcommon.BaseOperationCost{}, marshalizer, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler(), 0, &mock.EpochNotifierStub{})
 
 	userAcc := mock.NewAccountWrapMock([]byte("addr"))
 	dctData := &dct.DCToken{
@@ -306,13 +321,13 @@ func TestDctNFTBurnFunc_ProcessBuiltinFunctionShouldErrOnSaveBecauseTokenIsPause
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	pauseHandler := &mock.PauseHandlerStub{
+	globalSettingsHandler := &mock.GlobalSettingsHandlerStub{
 		IsPausedCalled: func(_ []byte) bool {
 			return true
 		},
 	}
 
-	ebf, _ := NewDCTNFTBurnFunc(10, marshalizer, pauseHandler, &mock.DCTRoleHandlerStub{})
+	ebf, _ := NewDCTNFTBurnFunc(10, vmcommon.BaseOperationCost{}, marshalizer, globalSettingsHandler, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandlerWithArgs(globalSettingsHandler, createAccountsWithSystemAccount(), 1), 0, &mock.EpochNotifierStub{})
 
 	userAcc := mock.NewAccountWrapMock([]byte("addr"))
 	dctData := &dct.DCToken{
@@ -353,7 +368,7 @@ func TestDctNFTBurnFunc_ProcessBuiltinFunctionShouldWork(t *testing.T) {
 	expectedQuantity := big.NewInt(0).Sub(initialQuantity, quantityToBurn)
 
 	marshalizer := &mock.MarshalizerMock{}
-	ebf, _ := NewDCTNFTBurnFunc(10, marshalizer, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{})
+	ebf, _ := NewDCTNFTBurnFunc(10, vmcommon.BaseOperationCost{}, marshalizer, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler(), 0, &mock.EpochNotifierStub{})
 
 	userAcc := mock.NewAccountWrapMock([]byte("addr"))
 	dctData := &dct.DCToken{
@@ -383,7 +398,7 @@ func TestDctNFTBurnFunc_ProcessBuiltinFunctionShouldWork(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, vmcommon.Ok, output.ReturnCode)
 
-	res, err := userAcc.AccountDataHandler().RetrieveValue([]byte(key))
+	res, err := userAcc.AccountDataHandler().RetrieveValue(tokenKey)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -391,3 +406,50 @@ func TestDctNFTBurnFunc_ProcessBuiltinFunctionShouldWork(t *testing.T) {
 	_ = marshalizer.Unmarshal(&finalTokenData, res)
 	require.Equal(t, expectedQuantity.Bytes(), finalTokenData.Value.Bytes())
 }
+
+func TestDctNFTBurnFunc_ProcessBuiltinFunctionNonFungibleInvalidQuantity(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	ebf, _ := NewDCTNFTBurnFunc(
+		10,
+		vmcommon.BaseOperationCost{},
+		marshalizer,
+		&mock.GlobalSettingsHandlerStub{
+			GetTokenTypeCalled: func(_ []byte) uint32 {
+				return uint32(vmcommon.NonFungible)
+			},
+		},
+		&mock.DCTRoleHandlerStub{},
+		&mock.SupplyHandlerStub{},
+		createNewDCTDataStorageHandler(),
+		0,
+		&mock.EpochNotifierStub{},
+	)
+
+	userAcc := mock.NewAccountWrapMock([]byte("addr"))
+	dctData := &dct.DCToken{
+		TokenMetaData: &dct.MetaData{
+			Name: []byte("test"),
+		},
+		Value: big.NewInt(5),
+	}
+	dctDataBytes, _ := marshalizer.Marshal(dctData)
+	_ = userAcc.AccountDataHandler().SaveKeyValue([]byte(vmcommon.DharitriProtectedKeyPrefix+vmcommon.DCTKeyIdentifier+"arg0"+"arg1"), dctDataBytes)
+	output, err := ebf.ProcessBuiltinFunction(
+		userAcc,
+		nil,
+		&vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallValue:   big.NewInt(0),
+				Arguments:   [][]byte{[]byte("arg0"), []byte("arg1"), big.NewInt(2).Bytes()},
+				CallerAddr:  []byte("address 1"),
+				GasProvided: 12,
+			},
+			RecipientAddr: []byte("address 1"),
+		},
+	)
+
+	require.Nil(t, output)
+	require.Equal(t, ErrInvalidNonFungibleQuantity, err)
+}
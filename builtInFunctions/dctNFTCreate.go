@@ -15,13 +15,15 @@ var noncePrefix = []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTNFTLa
 
 type dctNFTCreate struct {
 	baseAlwaysActive
-	keyPrefix    []byte
-	marshalizer  vmcommon.Marshalizer
-	pauseHandler vmcommon.DCTPauseHandler
-	rolesHandler vmcommon.DCTRoleHandler
-	funcGasCost  uint64
-	gasConfig    vmcommon.BaseOperationCost
-	mutExecution sync.RWMutex
+	keyPrefix             []byte
+	marshalizer           vmcommon.Marshalizer
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler
+	rolesHandler          vmcommon.DCTRoleHandler
+	storageHandler        vmcommon.DCTNFTStorageHandler
+	supplyHandler         vmcommon.DCTSupplyHandler
+	funcGasCost           uint64
+	gasConfig             vmcommon.BaseOperationCost
+	mutExecution          sync.RWMutex
 }
 
 // NewDCTNFTCreateFunc returns the dct NFT create built-in function component
@@ -29,27 +31,37 @@ func NewDCTNFTCreateFunc(
 	funcGasCost uint64,
 	gasConfig vmcommon.BaseOperationCost,
 	marshalizer vmcommon.Marshalizer,
-	pauseHandler vmcommon.DCTPauseHandler,
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler,
 	rolesHandler vmcommon.DCTRoleHandler,
+	supplyHandler vmcommon.DCTSupplyHandler,
+	storageHandler vmcommon.DCTNFTStorageHandler,
 ) (*dctNFTCreate, error) {
 	if check.IfNil(marshalizer) {
 		return nil, ErrNilMarshalizer
 	}
-	if check.IfNil(pauseHandler) {
-		return nil, ErrNilPauseHandler
+	if check.IfNil(globalSettingsHandler) {
+		return nil, ErrNilGlobalSettingsHandler
 	}
 	if check.IfNil(rolesHandler) {
 		return nil, ErrNilRolesHandler
 	}
+	if check.IfNil(storageHandler) {
+		return nil, ErrNilDCTNFTStorageHandler
+	}
+	if check.IfNil(supplyHandler) {
+		return nil, ErrNilSupplyHandler
+	}
 
 	e := &dctNFTCreate{
-		keyPrefix:    []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
-		marshalizer:  marshalizer,
-		pauseHandler: pauseHandler,
-		rolesHandler: rolesHandler,
-		funcGasCost:  funcGasCost,
-		gasConfig:    gasConfig,
-		mutExecution: sync.RWMutex{},
+		keyPrefix:             []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
+		marshalizer:           marshalizer,
+		globalSettingsHandler: globalSettingsHandler,
+		rolesHandler:          rolesHandler,
+		storageHandler:        storageHandler,
+		supplyHandler:         supplyHandler,
+		funcGasCost:           funcGasCost,
+		gasConfig:             gasConfig,
+		mutExecution:          sync.RWMutex{},
 	}
 
 	return e, nil
@@ -106,6 +118,7 @@ func (e *dctNFTCreate) ProcessBuiltinFunction(
 	for _, arg := range vmInput.Arguments {
 		totalLength += uint64(len(arg))
 	}
+	traceGas(vmInput, gasComponentStorePerByte, totalLength, e.gasConfig.StorePerByte)
 	gasToUse := totalLength*e.gasConfig.StorePerByte + e.funcGasCost
 	if vmInput.GasProvided < gasToUse {
 		return nil, ErrNotEnoughGas
@@ -127,10 +140,19 @@ func (e *dctNFTCreate) ProcessBuiltinFunction(
 			return nil, err
 		}
 	}
+	err = checkQuantityForTokenType(e.globalSettingsHandler, dctTokenKey, quantity)
+	if err != nil {
+		return nil, err
+	}
+	err = e.supplyHandler.UseMintAllowance(tokenID, quantity)
+	if err != nil {
+		return nil, err
+	}
 
+	tokenType := e.globalSettingsHandler.GetTokenType(dctTokenKey)
 	nextNonce := nonce + 1
 	dctData := &dct.DCToken{
-		Type:  uint32(vmcommon.NonFungible),
+		Type:  getNFTStoredType(tokenType),
 		Value: quantity,
 		TokenMetaData: &dct.MetaData{
 			Nonce:      nextNonce,
@@ -142,9 +164,12 @@ func (e *dctNFTCreate) ProcessBuiltinFunction(
 			URIs:       vmInput.Arguments[6:],
 		},
 	}
+	if tokenType == uint32(vmcommon.MetaFungible) {
+		dctData.TokenMetaData.Decimals = e.globalSettingsHandler.GetNumDecimals(dctTokenKey)
+	}
 
 	var dctDataBytes []byte
-	dctDataBytes, err = saveDCTNFTToken(acntSnd, dctTokenKey, dctData, e.marshalizer, e.pauseHandler, vmInput.ReturnCallAfterError)
+	dctDataBytes, err = e.storageHandler.SaveDCTNFTToken(acntSnd, dctTokenKey, nextNonce, dctData, vmInput.ReturnCallAfterError)
 	if err != nil {
 		return nil, err
 	}
@@ -154,6 +179,11 @@ func (e *dctNFTCreate) ProcessBuiltinFunction(
 		return nil, err
 	}
 
+	err = e.supplyHandler.UpdateSupply(tokenID, nextNonce, quantity)
+	if err != nil {
+		return nil, err
+	}
+
 	logEntry := newEntryForNFT(vmcommon.BuiltInFunctionDCTNFTCreate, vmInput.CallerAddr, tokenID, nextNonce)
 	logEntry.Topics = append(logEntry.Topics, dctDataBytes)
 
@@ -189,77 +219,27 @@ func computeDCTNFTTokenKey(dctTokenKey []byte, nonce uint64) []byte {
 	return append(dctTokenKey, big.NewInt(0).SetUint64(nonce).Bytes()...)
 }
 
-func getDCTNFTTokenOnSender(
-	accnt vmcommon.UserAccountHandler,
-	dctTokenKey []byte,
-	nonce uint64,
-	marshalizer vmcommon.Marshalizer,
-) (*dct.DCToken, error) {
-	dctData, isNew, err := getDCTNFTTokenOnDestination(accnt, dctTokenKey, nonce, marshalizer)
-	if err != nil {
-		return nil, err
-	}
-	if isNew {
-		return nil, ErrNewNFTDataOnSenderAddress
+// getNFTStoredType returns the type saved inside the token data. Tokens which do not have the type set in the
+// global settings are saved as non fungible, as it was done before the types were introduced
+func getNFTStoredType(tokenType uint32) uint32 {
+	if tokenType == uint32(vmcommon.Fungible) {
+		return uint32(vmcommon.NonFungible)
 	}
 
-	return dctData, nil
+	return tokenType
 }
 
-func getDCTNFTTokenOnDestination(
-	accnt vmcommon.UserAccountHandler,
+func checkQuantityForTokenType(
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler,
 	dctTokenKey []byte,
-	nonce uint64,
-	marshalizer vmcommon.Marshalizer,
-) (*dct.DCToken, bool, error) {
-	dctNFTTokenKey := computeDCTNFTTokenKey(dctTokenKey, nonce)
-	dctData := &dct.DCToken{Value: big.NewInt(0), Type: uint32(vmcommon.Fungible)}
-	marshaledData, err := accnt.AccountDataHandler().RetrieveValue(dctNFTTokenKey)
-	if err != nil || len(marshaledData) == 0 {
-		return dctData, true, nil
-	}
-
-	err = marshalizer.Unmarshal(dctData, marshaledData)
-	if err != nil {
-		return nil, false, err
-	}
-
-	return dctData, false, nil
-}
-
-func saveDCTNFTToken(
-	acnt vmcommon.UserAccountHandler,
-	dctTokenKey []byte,
-	dctData *dct.DCToken,
-	marshalizer vmcommon.Marshalizer,
-	pauseHandler vmcommon.DCTPauseHandler,
-	isReturnWithError bool,
-) ([]byte, error) {
-	err := checkFrozeAndPause(acnt.AddressBytes(), dctTokenKey, dctData, pauseHandler, isReturnWithError)
-	if err != nil {
-		return nil, err
-	}
-
-	nonce := uint64(0)
-	if dctData.TokenMetaData != nil {
-		nonce = dctData.TokenMetaData.Nonce
-	}
-	dctNFTTokenKey := computeDCTNFTTokenKey(dctTokenKey, nonce)
-	err = checkFrozeAndPause(acnt.AddressBytes(), dctNFTTokenKey, dctData, pauseHandler, isReturnWithError)
-	if err != nil {
-		return nil, err
-	}
-
-	if dctData.Value.Cmp(zero) <= 0 {
-		return nil, acnt.AccountDataHandler().SaveKeyValue(dctNFTTokenKey, nil)
-	}
-
-	marshaledData, err := marshalizer.Marshal(dctData)
-	if err != nil {
-		return nil, err
+	quantity *big.Int,
+) error {
+	tokenType := globalSettingsHandler.GetTokenType(dctTokenKey)
+	if tokenType == uint32(vmcommon.NonFungible) && quantity.Cmp(big.NewInt(1)) != 0 {
+		return ErrInvalidNonFungibleQuantity
 	}
 
-	return marshaledData, acnt.AccountDataHandler().SaveKeyValue(dctNFTTokenKey, marshaledData)
+	return nil
 }
 
 func checkDCTNFTCreateBurnAddInput(
@@ -277,6 +257,7 @@ func checkDCTNFTCreateBurnAddInput(
 	if check.IfNil(account) {
 		return ErrNilUserAccount
 	}
+	traceGas(vmInput, gasComponentFunction, 1, funcGasCost)
 	if vmInput.GasProvided < funcGasCost {
 		return ErrNotEnoughGas
 	}
@@ -0,0 +1,310 @@
+package builtInFunctions
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/atomic"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+)
+
+const argumentsPerBatchItem = 5
+
+type nftCreateBatchItem struct {
+	quantity   *big.Int
+	name       []byte
+	hash       []byte
+	attributes []byte
+	uris       [][]byte
+	dataLength uint64
+}
+
+type dctNFTCreateBatch struct {
+	*baseEnabled
+	keyPrefix             []byte
+	marshalizer           vmcommon.Marshalizer
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler
+	rolesHandler          vmcommon.DCTRoleHandler
+	storageHandler        vmcommon.DCTNFTStorageHandler
+	supplyHandler         vmcommon.DCTSupplyHandler
+	funcGasCost           uint64
+	gasConfig             vmcommon.BaseOperationCost
+	mutExecution          sync.RWMutex
+}
+
+// NewDCTNFTCreateBatchFunc returns the dct NFT create batch built-in function component
+func NewDCTNFTCreateBatchFunc(
+	funcGasCost uint64,
+	gasConfig vmcommon.BaseOperationCost,
+	marshalizer vmcommon.Marshalizer,
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler,
+	rolesHandler vmcommon.DCTRoleHandler,
+	supplyHandler vmcommon.DCTSupplyHandler,
+	storageHandler vmcommon.DCTNFTStorageHandler,
+	activationEpoch uint32,
+	epochNotifier vmcommon.EpochNotifier,
+) (*dctNFTCreateBatch, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(globalSettingsHandler) {
+		return nil, ErrNilGlobalSettingsHandler
+	}
+	if check.IfNil(rolesHandler) {
+		return nil, ErrNilRolesHandler
+	}
+	if check.IfNil(storageHandler) {
+		return nil, ErrNilDCTNFTStorageHandler
+	}
+	if check.IfNil(supplyHandler) {
+		return nil, ErrNilSupplyHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, ErrNilEpochHandler
+	}
+
+	e := &dctNFTCreateBatch{
+		keyPrefix:             []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
+		marshalizer:           marshalizer,
+		globalSettingsHandler: globalSettingsHandler,
+		rolesHandler:          rolesHandler,
+		storageHandler:        storageHandler,
+		supplyHandler:         supplyHandler,
+		funcGasCost:           funcGasCost,
+		gasConfig:             gasConfig,
+		mutExecution:          sync.RWMutex{},
+	}
+
+	e.baseEnabled = &baseEnabled{
+		function:        vmcommon.BuiltInFunctionDCTNFTCreateBatch,
+		activationEpoch: activationEpoch,
+		flagActivated:   atomic.Flag{},
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *dctNFTCreateBatch) SetNewGasConfig(gasCost *vmcommon.GasCost) {
+	if gasCost == nil {
+		return
+	}
+
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.DCTNFTCreateBatch
+	e.gasConfig = gasCost.BaseOperationCost
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves DCT NFT create batch function call. The created tokens receive consecutive
+// nonces, the first and the last one being returned. Each created token is logged as a DCTNFTCreate event
+// Requires at least 8 arguments:
+// arg0 - token identifier
+// arg1 - Royalties for all the created tokens - max 10000
+// arg2 - number of tokens to create
+// for each token to create:
+//   - initial quantity
+//   - NFT name
+//   - hash
+//   - attributes
+//   - number of URIs (minimum 1), followed by the URIs
+func (e *dctNFTCreateBatch) ProcessBuiltinFunction(
+	acntSnd, _ vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	err := checkDCTNFTCreateBurnAddInput(acntSnd, vmInput, e.funcGasCost)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) < 3+argumentsPerBatchItem+1 {
+		return nil, fmt.Errorf("%w, wrong number of arguments", ErrInvalidArguments)
+	}
+
+	tokenID := vmInput.Arguments[0]
+	royalties := uint32(big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64())
+	if royalties > vmcommon.MaxRoyalty {
+		return nil, fmt.Errorf("%w, invalid max royality value", ErrInvalidArguments)
+	}
+
+	items, err := parseNFTCreateBatchItems(vmInput.Arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	// every created token pays the storage of the data a single create would save
+	commonLength := uint64(len(vmInput.Arguments[0]) + len(vmInput.Arguments[1]))
+	storedLength := uint64(0)
+	for _, item := range items {
+		storedLength += commonLength + item.dataLength
+	}
+	traceGas(vmInput, gasComponentStorePerByte, storedLength, e.gasConfig.StorePerByte)
+	gasToUse := e.funcGasCost + storedLength*e.gasConfig.StorePerByte
+	if vmInput.GasProvided < gasToUse {
+		return nil, ErrNotEnoughGas
+	}
+
+	dctTokenKey := append(e.keyPrefix, tokenID...)
+	totalQuantity, err := e.checkItemsQuantity(dctTokenKey, items)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.checkRoles(acntSnd, tokenID, items)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.supplyHandler.UseMintAllowance(tokenID, totalQuantity)
+	if err != nil {
+		return nil, err
+	}
+
+	latestNonce, err := getLatestNonce(acntSnd, tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenType := e.globalSettingsHandler.GetTokenType(dctTokenKey)
+	logs := make([]*vmcommon.LogEntry, 0, len(items))
+	for i, item := range items {
+		nonce := latestNonce + uint64(i) + 1
+		dctData := &dct.DCToken{
+			Type:  getNFTStoredType(tokenType),
+			Value: item.quantity,
+			TokenMetaData: &dct.MetaData{
+				Nonce:      nonce,
+				Name:       item.name,
+				Creator:    vmInput.CallerAddr,
+				Royalties:  royalties,
+				Hash:       item.hash,
+				Attributes: item.attributes,
+				URIs:       item.uris,
+			},
+		}
+		if tokenType == uint32(vmcommon.MetaFungible) {
+			dctData.TokenMetaData.Decimals = e.globalSettingsHandler.GetNumDecimals(dctTokenKey)
+		}
+
+		dctDataBytes, errSave := e.storageHandler.SaveDCTNFTToken(acntSnd, dctTokenKey, nonce, dctData, vmInput.ReturnCallAfterError)
+		if errSave != nil {
+			return nil, errSave
+		}
+
+		err = e.supplyHandler.UpdateSupply(tokenID, nonce, item.quantity)
+		if err != nil {
+			return nil, err
+		}
+
+		logEntry := newEntryForNFT(vmcommon.BuiltInFunctionDCTNFTCreate, vmInput.CallerAddr, tokenID, nonce)
+		logEntry.Topics = append(logEntry.Topics, dctDataBytes)
+		logs = append(logs, logEntry)
+	}
+
+	lastNonce := latestNonce + uint64(len(items))
+	err = saveLatestNonce(acntSnd, tokenID, lastNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - gasToUse,
+		ReturnData: [][]byte{
+			big.NewInt(0).SetUint64(latestNonce + 1).Bytes(),
+			big.NewInt(0).SetUint64(lastNonce).Bytes(),
+		},
+		Logs: logs,
+	}
+	return vmOutput, nil
+}
+
+func (e *dctNFTCreateBatch) checkItemsQuantity(dctTokenKey []byte, items []*nftCreateBatchItem) (*big.Int, error) {
+	totalQuantity := big.NewInt(0)
+	for _, item := range items {
+		err := checkQuantityForTokenType(e.globalSettingsHandler, dctTokenKey, item.quantity)
+		if err != nil {
+			return nil, err
+		}
+		totalQuantity.Add(totalQuantity, item.quantity)
+	}
+
+	return totalQuantity, nil
+}
+
+func (e *dctNFTCreateBatch) checkRoles(acntSnd vmcommon.UserAccountHandler, tokenID []byte, items []*nftCreateBatchItem) error {
+	err := e.rolesHandler.CheckAllowedToExecute(acntSnd, tokenID, []byte(vmcommon.DCTRoleNFTCreate))
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		if item.quantity.Cmp(big.NewInt(1)) > 0 {
+			return e.rolesHandler.CheckAllowedToExecute(acntSnd, tokenID, []byte(vmcommon.DCTRoleNFTAddQuantity))
+		}
+	}
+
+	return nil
+}
+
+func parseNFTCreateBatchItems(arguments [][]byte) ([]*nftCreateBatchItem, error) {
+	numItemsValue := big.NewInt(0).SetBytes(arguments[2])
+	if numItemsValue.Cmp(zero) == 0 || !numItemsValue.IsUint64() {
+		return nil, fmt.Errorf("%w, invalid number of tokens to create", ErrInvalidArguments)
+	}
+	numItems := numItemsValue.Uint64()
+	if numItems > uint64(len(arguments)-3)/argumentsPerBatchItem {
+		return nil, fmt.Errorf("%w, wrong number of arguments", ErrInvalidArguments)
+	}
+
+	items := make([]*nftCreateBatchItem, 0)
+	index := uint64(3)
+	for i := uint64(0); i < numItems; i++ {
+		if index+argumentsPerBatchItem > uint64(len(arguments)) {
+			return nil, fmt.Errorf("%w, wrong number of arguments", ErrInvalidArguments)
+		}
+
+		item := &nftCreateBatchItem{
+			quantity:   big.NewInt(0).SetBytes(arguments[index]),
+			name:       arguments[index+1],
+			hash:       arguments[index+2],
+			attributes: arguments[index+3],
+		}
+		if item.quantity.Cmp(zero) <= 0 {
+			return nil, fmt.Errorf("%w, invalid quantity", ErrInvalidArguments)
+		}
+
+		startURIs := index + argumentsPerBatchItem
+		numURIsValue := big.NewInt(0).SetBytes(arguments[index+4])
+		if numURIsValue.Cmp(zero) == 0 || numURIsValue.Cmp(big.NewInt(0).SetUint64(uint64(len(arguments))-startURIs)) > 0 {
+			return nil, fmt.Errorf("%w, invalid number of URIs", ErrInvalidArguments)
+		}
+		numURIs := numURIsValue.Uint64()
+		item.uris = arguments[startURIs : startURIs+numURIs]
+
+		for _, arg := range arguments[index : startURIs+numURIs] {
+			item.dataLength += uint64(len(arg))
+		}
+
+		items = append(items, item)
+		index = startURIs + numURIs
+	}
+
+	if index != uint64(len(arguments)) {
+		return nil, fmt.Errorf("%w, wrong number of arguments", ErrInvalidArguments)
+	}
+
+	return items, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *dctNFTCreateBatch) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,371 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNFTCreateBatchWithStubArguments() *dctNFTCreateBatch {
+	nftCreateBatch, _ := NewDCTNFTCreateBatchFunc(
+		10,
+		vmcommon.BaseOperationCost{StorePerByte: 1},
+		&mock.MarshalizerMock{},
+		&mock.GlobalSettingsHandlerStub{},
+		&mock.DCTRoleHandlerStub{},
+		&mock.SupplyHandlerStub{},
+		createNewDCTDataStorageHandler(),
+		0,
+		&mock.EpochNotifierStub{},
+	)
+
+	return nftCreateBatch
+}
+
+func createNFTCreateBatchInput(sender vmcommon.UserAccountHandler, token string, quantities ...*big.Int) *vmcommon.ContractCallInput {
+	arguments := [][]byte{
+		[]byte(token),
+		big.NewInt(100).Bytes(),
+		big.NewInt(int64(len(quantities))).Bytes(),
+	}
+	for _, quantity := range quantities {
+		arguments = append(arguments,
+			quantity.Bytes(),
+			[]byte("name"),
+			[]byte("hash"),
+			[]byte("attributes"),
+			big.NewInt(1).Bytes(),
+			[]byte("uri"),
+		)
+	}
+
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  sender.AddressBytes(),
+			CallValue:   big.NewInt(0),
+			Arguments:   arguments,
+			GasProvided: 1000,
+		},
+		RecipientAddr: sender.AddressBytes(),
+	}
+}
+
+func TestNewDCTNFTCreateBatchFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nftCreateBatch, err := NewDCTNFTCreateBatchFunc(0, vmcommon.BaseOperationCost{}, nil, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler(), 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(nftCreateBatch))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	nftCreateBatch, err = NewDCTNFTCreateBatchFunc(0, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, nil, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler(), 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(nftCreateBatch))
+	assert.Equal(t, ErrNilGlobalSettingsHandler, err)
+
+	nftCreateBatch, err = NewDCTNFTCreateBatchFunc(0, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, nil, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler(), 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(nftCreateBatch))
+	assert.Equal(t, ErrNilRolesHandler, err)
+
+	nftCreateBatch, err = NewDCTNFTCreateBatchFunc(0, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, nil, createNewDCTDataStorageHandler(), 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(nftCreateBatch))
+	assert.Equal(t, ErrNilSupplyHandler, err)
+
+	nftCreateBatch, err = NewDCTNFTCreateBatchFunc(0, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, nil, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(nftCreateBatch))
+	assert.Equal(t, ErrNilDCTNFTStorageHandler, err)
+
+	nftCreateBatch, err = NewDCTNFTCreateBatchFunc(0, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler(), 0, nil)
+	assert.True(t, check.IfNil(nftCreateBatch))
+	assert.Equal(t, ErrNilEpochHandler, err)
+}
+
+func TestNewDCTNFTCreateBatchFunc(t *testing.T) {
+	t.Parallel()
+
+	nftCreateBatch := createNFTCreateBatchWithStubArguments()
+	assert.False(t, check.IfNil(nftCreateBatch))
+	assert.True(t, nftCreateBatch.IsActive())
+
+	nftCreateBatch.SetNewGasConfig(&vmcommon.GasCost{BuiltInCost: vmcommon.BuiltInCost{DCTNFTCreateBatch: 37}, BaseOperationCost: vmcommon.BaseOperationCost{StorePerByte: 5}})
+	assert.Equal(t, uint64(37), nftCreateBatch.funcGasCost)
+	assert.Equal(t, uint64(5), nftCreateBatch.gasConfig.StorePerByte)
+}
+
+func TestDctNFTCreateBatch_ProcessBuiltinFunctionInvalidArguments(t *testing.T) {
+	t.Parallel()
+
+	nftCreateBatch := createNFTCreateBatchWithStubArguments()
+	sender := mock.NewUserAccount([]byte("address"))
+
+	vmOutput, err := nftCreateBatch.ProcessBuiltinFunction(sender, nil, nil)
+	assert.Nil(t, vmOutput)
+	assert.Equal(t, ErrNilVmInput, err)
+
+	vmInput := createNFTCreateBatchInput(sender, "token", big.NewInt(1))
+	vmInput.Arguments = vmInput.Arguments[:len(vmInput.Arguments)-1]
+	vmOutput, err = nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.Nil(t, vmOutput)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+
+	vmInput = createNFTCreateBatchInput(sender, "token", big.NewInt(1), big.NewInt(1))
+	vmInput.Arguments[2] = big.NewInt(1).Bytes()
+	vmOutput, err = nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.Nil(t, vmOutput)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+
+	vmInput = createNFTCreateBatchInput(sender, "token", big.NewInt(1), big.NewInt(1))
+	vmInput.Arguments[2] = big.NewInt(3).Bytes()
+	vmOutput, err = nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.Nil(t, vmOutput)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+
+	vmInput = createNFTCreateBatchInput(sender, "token", big.NewInt(1))
+	vmInput.Arguments[7] = big.NewInt(0).Bytes()
+	vmOutput, err = nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.Nil(t, vmOutput)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+
+	vmInput = createNFTCreateBatchInput(sender, "token", big.NewInt(0))
+	vmOutput, err = nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.Nil(t, vmOutput)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+
+	vmInput = createNFTCreateBatchInput(sender, "token", big.NewInt(1))
+	vmInput.Arguments[1] = big.NewInt(int64(vmcommon.MaxRoyalty + 1)).Bytes()
+	vmOutput, err = nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.Nil(t, vmOutput)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+}
+
+func TestDctNFTCreateBatch_ProcessBuiltinFunctionHugeCountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nftCreateBatch := createNFTCreateBatchWithStubArguments()
+	sender := mock.NewUserAccount([]byte("address"))
+
+	// the number of tokens times the arguments per token overflows uint64
+	vmInput := createNFTCreateBatchInput(sender, "token", big.NewInt(1))
+	vmInput.Arguments[2] = big.NewInt(0).SetUint64(math.MaxUint64/argumentsPerBatchItem + 1).Bytes()
+	vmOutput, err := nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.Nil(t, vmOutput)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+
+	vmInput = createNFTCreateBatchInput(sender, "token", big.NewInt(1))
+	vmInput.Arguments[2] = big.NewInt(0).SetUint64(math.MaxUint64).Bytes()
+	vmOutput, err = nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.Nil(t, vmOutput)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+
+	vmInput = createNFTCreateBatchInput(sender, "token", big.NewInt(1))
+	vmInput.Arguments[7] = big.NewInt(0).SetUint64(math.MaxUint64).Bytes()
+	vmOutput, err = nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.Nil(t, vmOutput)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+}
+
+func TestDctNFTCreateBatch_ProcessBuiltinFunctionNotEnoughGas(t *testing.T) {
+	t.Parallel()
+
+	nftCreateBatch := createNFTCreateBatchWithStubArguments()
+	sender := mock.NewUserAccount([]byte("address"))
+
+	// funcGasCost + 2 * (len(token) + len(royalties) + item data)
+	vmInput := createNFTCreateBatchInput(sender, "token", big.NewInt(1), big.NewInt(1))
+	vmInput.GasProvided = 10 + 2*(5+1+1+4+4+10+1+3) - 1
+	vmOutput, err := nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.Nil(t, vmOutput)
+	assert.Equal(t, ErrNotEnoughGas, err)
+
+	vmInput.GasProvided++
+	vmOutput, err = nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(0), vmOutput.GasRemaining)
+}
+
+func TestDctNFTCreateBatch_ProcessBuiltinFunctionChecksRolesOnce(t *testing.T) {
+	t.Parallel()
+
+	checkedRoles := make([]string, 0)
+	nftCreateBatch, _ := NewDCTNFTCreateBatchFunc(
+		0,
+		vmcommon.BaseOperationCost{},
+		&mock.MarshalizerMock{},
+		&mock.GlobalSettingsHandlerStub{
+			GetTokenTypeCalled: func(_ []byte) uint32 {
+				return uint32(vmcommon.SemiFungible)
+			},
+		},
+		&mock.DCTRoleHandlerStub{
+			CheckAllowedToExecuteCalled: func(_ vmcommon.UserAccountHandler, _ []byte, action []byte) error {
+				checkedRoles = append(checkedRoles, string(action))
+				return nil
+			},
+		},
+		&mock.SupplyHandlerStub{},
+		createNewDCTDataStorageHandler(),
+		0,
+		&mock.EpochNotifierStub{},
+	)
+	sender := mock.NewUserAccount([]byte("address"))
+
+	vmInput := createNFTCreateBatchInput(sender, "token", big.NewInt(1), big.NewInt(5), big.NewInt(7))
+	_, err := nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, []string{vmcommon.DCTRoleNFTCreate, vmcommon.DCTRoleNFTAddQuantity}, checkedRoles)
+}
+
+func TestDctNFTCreateBatch_ProcessBuiltinFunctionNotAllowedToExecute(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	nftCreateBatch, _ := NewDCTNFTCreateBatchFunc(
+		0,
+		vmcommon.BaseOperationCost{},
+		&mock.MarshalizerMock{},
+		&mock.GlobalSettingsHandlerStub{},
+		&mock.DCTRoleHandlerStub{
+			CheckAllowedToExecuteCalled: func(_ vmcommon.UserAccountHandler, _ []byte, _ []byte) error {
+				return expectedErr
+			},
+		},
+		&mock.SupplyHandlerStub{},
+		createNewDCTDataStorageHandler(),
+		0,
+		&mock.EpochNotifierStub{},
+	)
+	sender := mock.NewUserAccount([]byte("address"))
+
+	vmOutput, err := nftCreateBatch.ProcessBuiltinFunction(sender, nil, createNFTCreateBatchInput(sender, "token", big.NewInt(1)))
+	assert.Nil(t, vmOutput)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestDctNFTCreateBatch_ProcessBuiltinFunctionNonFungibleWithQuantityShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nftCreateBatch, _ := NewDCTNFTCreateBatchFunc(
+		0,
+		vmcommon.BaseOperationCost{},
+		&mock.MarshalizerMock{},
+		&mock.GlobalSettingsHandlerStub{
+			GetTokenTypeCalled: func(_ []byte) uint32 {
+				return uint32(vmcommon.NonFungible)
+			},
+		},
+		&mock.DCTRoleHandlerStub{},
+		&mock.SupplyHandlerStub{},
+		createNewDCTDataStorageHandler(),
+		0,
+		&mock.EpochNotifierStub{},
+	)
+	sender := mock.NewUserAccount([]byte("address"))
+
+	vmOutput, err := nftCreateBatch.ProcessBuiltinFunction(sender, nil, createNFTCreateBatchInput(sender, "token", big.NewInt(1), big.NewInt(2)))
+	assert.Nil(t, vmOutput)
+	assert.Equal(t, ErrInvalidNonFungibleQuantity, err)
+
+	latestNonce, _ := getLatestNonce(sender, []byte("token"))
+	assert.Equal(t, uint64(0), latestNonce)
+}
+
+func TestDctNFTCreateBatch_ProcessBuiltinFunctionOverMaxSupplyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nftCreateBatch, _ := NewDCTNFTCreateBatchFunc(
+		0,
+		vmcommon.BaseOperationCost{},
+		&mock.MarshalizerMock{},
+		&mock.GlobalSettingsHandlerStub{},
+		&mock.DCTRoleHandlerStub{},
+		&mock.SupplyHandlerStub{
+			UseMintAllowanceCalled: func(tokenID []byte, value *big.Int) error {
+				assert.Equal(t, []byte("token"), tokenID)
+				assert.Equal(t, big.NewInt(3), value)
+				return ErrMaxSupplyExceeded
+			},
+		},
+		createNewDCTDataStorageHandler(),
+		0,
+		&mock.EpochNotifierStub{},
+	)
+	sender := mock.NewUserAccount([]byte("address"))
+
+	vmInput := createNFTCreateBatchInput(sender, "token", big.NewInt(1), big.NewInt(1), big.NewInt(1))
+	vmOutput, err := nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.Equal(t, ErrMaxSupplyExceeded, err)
+	assert.Nil(t, vmOutput)
+
+	latestNonce, _ := getLatestNonce(sender, []byte("token"))
+	assert.Equal(t, uint64(0), latestNonce)
+}
+
+func TestDctNFTCreateBatch_ProcessBuiltinFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	supplyUpdates := make(map[uint64]*big.Int)
+	nftCreateBatch, _ := NewDCTNFTCreateBatchFunc(
+		0,
+		vmcommon.BaseOperationCost{},
+		&mock.MarshalizerMock{},
+		&mock.GlobalSettingsHandlerStub{
+			GetTokenTypeCalled: func(_ []byte) uint32 {
+				return uint32(vmcommon.SemiFungible)
+			},
+		},
+		&mock.DCTRoleHandlerStub{},
+		&mock.SupplyHandlerStub{
+			UpdateSupplyCalled: func(_ []byte, nonce uint64, value *big.Int) error {
+				supplyUpdates[nonce] = value
+				return nil
+			},
+		},
+		createNewDCTDataStorageHandler(),
+		0,
+		&mock.EpochNotifierStub{},
+	)
+	address := bytes.Repeat([]byte{1}, 32)
+	sender := mock.NewUserAccount(address)
+	_ = saveLatestNonce(sender, []byte("token"), 4)
+
+	vmInput := createNFTCreateBatchInput(sender, "token", big.NewInt(1), big.NewInt(10))
+	vmOutput, err := nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	require.Nil(t, err)
+	require.NotNil(t, vmOutput)
+	assert.Equal(t, [][]byte{big.NewInt(5).Bytes(), big.NewInt(6).Bytes()}, vmOutput.ReturnData)
+	assert.Equal(t, map[uint64]*big.Int{5: big.NewInt(1), 6: big.NewInt(10)}, supplyUpdates)
+
+	require.Equal(t, 2, len(vmOutput.Logs))
+	for i, expectedQuantity := range []*big.Int{big.NewInt(1), big.NewInt(10)} {
+		nonce := uint64(5 + i)
+		createdDct, latestNonce := readNFTData(t, sender, nftCreateBatch.marshalizer, []byte("token"), nonce, address)
+		assert.Equal(t, uint64(6), latestNonce)
+		expectedDct := &dct.DCToken{
+			Type:  uint32(vmcommon.SemiFungible),
+			Value: expectedQuantity,
+			TokenMetaData: &dct.MetaData{
+				Nonce:      nonce,
+				Name:       []byte("name"),
+				Creator:    address,
+				Royalties:  100,
+				Hash:       []byte("hash"),
+				URIs:       [][]byte{[]byte("uri")},
+				Attributes: []byte("attributes"),
+			},
+		}
+		assert.Equal(t, expectedDct, createdDct)
+
+		logEntry := vmOutput.Logs[i]
+		assert.Equal(t, []byte(vmcommon.BuiltInFunctionDCTNFTCreate), logEntry.Identifier)
+		assert.Equal(t, address, logEntry.Address)
+		assert.Equal(t, []byte("token"), logEntry.Topics[0])
+		assert.Equal(t, big.NewInt(int64(nonce)).Bytes(), logEntry.Topics[1])
+	}
+}
@@ -19,8 +19,10 @@ func createNftCreateWithStubArguments() *dctNFTCreate {
 		1,
 		vmcommon.BaseOperationCost{},
 		&mock.MarshalizerMock{},
-		&mock.PauseHandlerStub{},
+		&mock.GlobalSettingsHandlerStub{},
 		&mock.DCTRoleHandlerStub{},
+		&mock.SupplyHandlerStub{},
+		createNewDCTDataStorageHandler(),
 	)
 
 	return nftCreate
@@ -33,8 +35,10 @@ func TestNewDCTNFTCreateFunc_NilArgumentsShouldErr(t *testing.T) {
 		0,
 		vmcommon.BaseOperationCost{},
 		nil,
-		&mock.PauseHandlerStub{},
+		&mock.GlobalSettingsHandlerStub{},
 		&mock.DCTRoleHandlerStub{},
+		&mock.SupplyHandlerStub{},
+		createNewDCTDataStorageHandler(),
 	)
 	assert.True(t, check.IfNil(nftCreate))
 	assert.Equal(t, ErrNilMarshalizer, err)
@@ -45,19 +49,47 @@ func TestNewDCTNFTCreateFunc_NilArgumentsShouldErr(t *testing.T) {
 		&mock.MarshalizerMock{},
 		nil,
 		&mock.DCTRoleHandlerStub{},
+		&mock.SupplyHandlerStub{},
+		createNewDCTDataStorageHandler(),
 	)
 	assert.True(t, check.IfNil(nftCreate))
-	assert.Equal(t, ErrNilPauseHandler, err)
+	assert.Equal(t, ErrNilGlobalSettingsHandler, err)
 
 	nftCreate, err = NewDCTNFTCreateFunc(
 		0,
 		vmcommon.BaseOperationCost{},
 		&mock.MarshalizerMock{},
-		&mock.PauseHandlerStub{},
+		&mock.GlobalSettingsHandlerStub{},
 		nil,
+		&mock.SupplyHandlerStub{},
+		createNewDCTDataStorageHandler(),
 	)
 	assert.True(t, check.IfNil(nftCreate))
 	assert.Equal(t, ErrNilRolesHandler, err)
+
+	nftCreate, err = NewDCTNFTCreateFunc(
+		0,
+		vmcommon.BaseOperationCost{},
+		&mock.MarshalizerMock{},
+		&mock.GlobalSettingsHandlerStub{},
+		&mock.DCTRoleHandlerStub{},
+		nil,
+		createNewDCTDataStorageHandler(),
+	)
+	assert.True(t, check.IfNil(nftCreate))
+	assert.Equal(t, ErrNilSupplyHandler, err)
+
+	nftCreate, err = NewDCTNFTCreateFunc(
+		0,
+		vmcommon.BaseOperationCost{},
+		&mock.MarshalizerMock{},
+		&mock.GlobalSettingsHandlerStub{},
+		&mock.DCTRoleHandlerStub{},
+		&mock.SupplyHandlerStub{},
+		nil,
+	)
+	assert.True(t, check.IfNil(nftCreate))
+	assert.Equal(t, ErrNilDCTNFTStorageHandler, err)
 }
 
 func TestNewDCTNFTCreateFunc(t *testing.T) {
@@ -67,8 +99,10 @@ func TestNewDCTNFTCreateFunc(t *testing.T) {
 		0,
 		vmcommon.BaseOperationCost{},
 		&mock.MarshalizerMock{},
-		&mock.PauseHandlerStub{},
+		&mock.GlobalSettingsHandlerStub{},
 		&mock.DCTRoleHandlerStub{},
+		&mock.SupplyHandlerStub{},
+		createNewDCTDataStorageHandler(),
 	)
 	assert.False(t, check.IfNil(nftCreate))
 	assert.Nil(t, err)
@@ -155,12 +189,14 @@ func TestDctNFTCreate_ProcessBuiltinFunctionNotAllowedToExecute(t *testing.T) {
 		0,
 		vmcommon.BaseOperationCost{},
 		&mock.MarshalizerMock{},
-		&mock.PauseHandlerStub{},
+		&mock.GlobalSettingsHandlerStub{},
 		&mock.DCTRoleHandlerStub{
 			CheckAllowedToExecuteCalled: func(account vmcommon.UserAccountHandler, tokenID []byte, action []byte) error {
 				return expectedErr
 			},
 		},
+		&mock.SupplyHandlerStub{},
+		createNewDCTDataStorageHandler(),
 	)
 	sender := mock.NewAccountWrapMock([]byte("address"))
 	vmInput := &vmcommon.ContractCallInput{
@@ -179,12 +215,20 @@ func TestDctNFTCreate_ProcessBuiltinFunctionNotAllowedToExecute(t *testing.T) {
 func TestDctNFTCreate_ProcessBuiltinFunctionShouldWork(t *testing.T) {
 	t.Parallel()
 
+	supplyUpdates := make(map[uint64]*big.Int)
 	nftCreate, _ := NewDCTNFTCreateFunc(
 		0,
 		vmcommon.BaseOperationCost{},
 		&mock.MarshalizerMock{},
-		&mock.PauseHandlerStub{},
+		&mock.GlobalSettingsHandlerStub{},
 		&mock.DCTRoleHandlerStub{},
+		&mock.SupplyHandlerStub{
+			UpdateSupplyCalled: func(tokenID []byte, nonce uint64, value *big.Int) error {
+				supplyUpdates[nonce] = value
+				return nil
+			},
+		},
+		createNewDCTDataStorageHandler(),
 	)
 	address := bytes.Repeat([]byte{1}, 32)
 	sender := mock.NewUserAccount(address)
@@ -237,6 +281,7 @@ func TestDctNFTCreate_ProcessBuiltinFunctionShouldWork(t *testing.T) {
 		},
 	}
 	assert.Equal(t, expectedDct, createdDct)
+	assert.Equal(t, map[uint64]*big.Int{1: quantity}, supplyUpdates)
 }
 
 func readNFTData(t *testing.T, account vmcommon.UserAccountHandler, marshalizer vmcommon.Marshalizer, tokenID []byte, nonce uint64, _ []byte) (*dct.DCToken, uint64) {
@@ -257,3 +302,109 @@ func readNFTData(t *testing.T, account vmcommon.UserAccountHandler, marshalizer
 
 	return dctData, latestNonce
 }
+
+func createNFTCreateInput(sender vmcommon.UserAccountHandler, token string, quantity *big.Int) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: sender.AddressBytes(),
+			CallValue:  big.NewInt(0),
+			Arguments: [][]byte{
+				[]byte(token),
+				quantity.Bytes(),
+				[]byte("name"),
+				big.NewInt(100).Bytes(),
+				[]byte("hash"),
+				[]byte("attributes"),
+				[]byte("uri"),
+			},
+		},
+		RecipientAddr: sender.AddressBytes(),
+	}
+}
+
+func TestDctNFTCreate_ProcessBuiltinFunctionNonFungibleWithQuantityShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nftCreate, _ := NewDCTNFTCreateFunc(
+		0,
+		vmcommon.BaseOperationCost{},
+		&mock.MarshalizerMock{},
+		&mock.GlobalSettingsHandlerStub{
+			GetTokenTypeCalled: func(_ []byte) uint32 {
+				return uint32(vmcommon.NonFungible)
+			},
+		},
+		&mock.DCTRoleHandlerStub{},
+		&mock.SupplyHandlerStub{},
+		createNewDCTDataStorageHandler(),
+	)
+	sender := mock.NewUserAccount(bytes.Repeat([]byte{1}, 32))
+
+	vmOutput, err := nftCreate.ProcessBuiltinFunction(sender, nil, createNFTCreateInput(sender, "token", big.NewInt(2)))
+	assert.Equal(t, ErrInvalidNonFungibleQuantity, err)
+	assert.Nil(t, vmOutput)
+}
+
+func TestDctNFTCreate_ProcessBuiltinFunctionMetaDCTShouldSetTypeAndDecimals(t *testing.T) {
+	t.Parallel()
+
+	numDecimals := uint32(6)
+	nftCreate, _ := NewDCTNFTCreateFunc(
+		0,
+		vmcommon.BaseOperationCost{},
+		&mock.MarshalizerMock{},
+		&mock.GlobalSettingsHandlerStub{
+			GetTokenTypeCalled: func(_ []byte) uint32 {
+				return uint32(vmcommon.MetaFungible)
+			},
+			GetNumDecimalsCalled: func(_ []byte) uint32 {
+				return numDecimals
+			},
+		},
+		&mock.DCTRoleHandlerStub{},
+		&mock.SupplyHandlerStub{},
+		createNewDCTDataStorageHandler(),
+	)
+	address := bytes.Repeat([]byte{1}, 32)
+	sender := mock.NewUserAccount(address)
+	_ = sender.AccountDataHandler().SaveKeyValue([]byte("key"), []byte("value"))
+
+	token := "token"
+	quantity := big.NewInt(1000)
+	vmOutput, err := nftCreate.ProcessBuiltinFunction(sender, nil, createNFTCreateInput(sender, token, quantity))
+	require.Nil(t, err)
+	require.NotNil(t, vmOutput)
+
+	createdDct, _ := readNFTData(t, sender, nftCreate.marshalizer, []byte(token), 1, address)
+	assert.Equal(t, uint32(vmcommon.MetaFungible), createdDct.Type)
+	assert.Equal(t, quantity, createdDct.Value)
+	assert.Equal(t, numDecimals, createdDct.TokenMetaData.Decimals)
+}
+
+func TestDctNFTCreate_ProcessBuiltinFunctionOverMaxSupplyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nftCreate, _ := NewDCTNFTCreateFunc(
+		0,
+		vmcommon.BaseOperationCost{},
+		&mock.MarshalizerMock{},
+		&mock.GlobalSettingsHandlerStub{},
+		&mock.DCTRoleHandlerStub{},
+		&mock.SupplyHandlerStub{
+			UseMintAllowanceCalled: func(tokenID []byte, value *big.Int) error {
+				assert.Equal(t, []byte("token"), tokenID)
+				assert.Equal(t, big.NewInt(2), value)
+				return ErrMaxSupplyExceeded
+			},
+		},
+		createNewDCTDataStorageHandler(),
+	)
+	sender := mock.NewUserAccount(bytes.Repeat([]byte{1}, 32))
+
+	vmOutput, err := nftCreate.ProcessBuiltinFunction(sender, nil, createNFTCreateInput(sender, "token", big.NewInt(2)))
+	assert.Equal(t, ErrMaxSupplyExceeded, err)
+	assert.Nil(t, vmOutput)
+
+	latestNonce, _ := getLatestNonce(sender, []byte("token"))
+	assert.Equal(t, uint64(0), latestNonce)
+}
@@ -0,0 +1,109 @@
+package builtInFunctions
+
+import (
+	"fmt"
+	"math/big"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+)
+
+type dctNFTRecreate struct {
+	*baseDCTMetaDataModify
+}
+
+// NewDCTNFTRecreateFunc returns the dct NFT recreate built-in function component
+func NewDCTNFTRecreateFunc(
+	funcGasCost uint64,
+	gasConfig vmcommon.BaseOperationCost,
+	marshalizer vmcommon.Marshalizer,
+	storageHandler vmcommon.DCTNFTStorageHandler,
+	rolesHandler vmcommon.DCTRoleHandler,
+	activationEpoch uint32,
+	epochNotifier vmcommon.EpochNotifier,
+) (*dctNFTRecreate, error) {
+	base, err := newBaseDCTMetaDataModify(
+		vmcommon.BuiltInFunctionDCTNFTRecreate,
+		funcGasCost,
+		gasConfig,
+		marshalizer,
+		storageHandler,
+		rolesHandler,
+		activationEpoch,
+		epochNotifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &dctNFTRecreate{
+		baseDCTMetaDataModify: base,
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *dctNFTRecreate) SetNewGasConfig(gasCost *vmcommon.GasCost) {
+	if gasCost == nil {
+		return
+	}
+
+	e.setGasConfig(gasCost.BuiltInCost.DCTNFTRecreate, gasCost.BaseOperationCost)
+}
+
+// ProcessBuiltinFunction resolves DCT NFT recreate function call. The nonce, the creator and the number of decimals
+// are kept, while the rest of the metadata, including the hash, is replaced
+// Requires at least 7 arguments:
+// arg0 - token identifier
+// arg1 - nonce
+// arg2 - new NFT name
+// arg3 - new royalties - max 10000
+// arg4 - new hash
+// arg5 - new attributes
+// arg6+ - multiple entries of new URIs (minimum 1)
+func (e *dctNFTRecreate) ProcessBuiltinFunction(
+	acntSnd, _ vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	err := checkDCTNFTCreateBurnAddInput(acntSnd, vmInput, e.funcGasCost)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) < 7 {
+		return nil, fmt.Errorf("%w, wrong number of arguments", ErrInvalidArguments)
+	}
+
+	royalties := uint32(big.NewInt(0).SetBytes(vmInput.Arguments[3]).Uint64())
+	if royalties > vmcommon.MaxRoyalty {
+		return nil, fmt.Errorf("%w, invalid max royality value", ErrInvalidArguments)
+	}
+
+	totalLength := uint64(0)
+	for _, arg := range vmInput.Arguments[2:] {
+		totalLength += uint64(len(arg))
+	}
+	vmOutput, dctDataBytes, err := e.modifyMetaData(acntSnd, vmInput, vmcommon.DCTRoleNFTRecreate, totalLength, func(metaData *dct.MetaData) {
+		metaData.Name = vmInput.Arguments[2]
+		metaData.Royalties = royalties
+		metaData.Hash = vmInput.Arguments[4]
+		metaData.Attributes = vmInput.Arguments[5]
+		metaData.URIs = vmInput.Arguments[6:]
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput.Logs[0].Topics = append(vmOutput.Logs[0].Topics, dctDataBytes)
+	return vmOutput, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *dctNFTRecreate) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,135 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createNFTRecreateArguments(tokenID []byte, nonce uint64, royalties uint64) [][]byte {
+	return [][]byte{
+		tokenID,
+		big.NewInt(0).SetUint64(nonce).Bytes(),
+		[]byte("new name"),
+		big.NewInt(0).SetUint64(royalties).Bytes(),
+		[]byte("new hash"),
+		[]byte("new attributes"),
+		[]byte("new uri"),
+	}
+}
+
+func TestNewDCTNFTRecreateFunc(t *testing.T) {
+	t.Parallel()
+
+	e, err := NewDCTNFTRecreateFunc(10, vmcommon.BaseOperationCost{}, nil, createNewDCTDataStorageHandler(), &mock.DCTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	require.True(t, check.IfNil(e))
+	require.Equal(t, ErrNilMarshalizer, err)
+
+	e, err = NewDCTNFTRecreateFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, nil, &mock.DCTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	require.True(t, check.IfNil(e))
+	require.Equal(t, ErrNilDCTNFTStorageHandler, err)
+
+	e, err = NewDCTNFTRecreateFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, createNewDCTDataStorageHandler(), nil, 0, &mock.EpochNotifierStub{})
+	require.True(t, check.IfNil(e))
+	require.Equal(t, ErrNilRolesHandler, err)
+
+	e, err = NewDCTNFTRecreateFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, createNewDCTDataStorageHandler(), &mock.DCTRoleHandlerStub{}, 0, nil)
+	require.True(t, check.IfNil(e))
+	require.Equal(t, ErrNilEpochHandler, err)
+
+	e, err = NewDCTNFTRecreateFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, createNewDCTDataStorageHandler(), &mock.DCTRoleHandlerStub{}, 1, &mock.EpochNotifierStub{})
+	require.False(t, check.IfNil(e))
+	require.Nil(t, err)
+	require.False(t, e.IsActive())
+
+	e.EpochConfirmed(1, 0)
+	require.True(t, e.IsActive())
+}
+
+func TestDCTNFTRecreate_SetNewGasConfig(t *testing.T) {
+	t.Parallel()
+
+	e, _ := NewDCTNFTRecreateFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, createNewDCTDataStorageHandler(), &mock.DCTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	e.SetNewGasConfig(nil)
+	require.Equal(t, uint64(10), e.funcGasCost)
+
+	gasCost := createMockGasCost()
+	e.SetNewGasConfig(&gasCost)
+	require.Equal(t, gasCost.BuiltInCost.DCTNFTRecreate, e.funcGasCost)
+	require.Equal(t, gasCost.BaseOperationCost, e.gasConfig)
+}
+
+func TestDCTNFTRecreate_ProcessBuiltinFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	caller := []byte("caller")
+	tokenID := []byte("TKN")
+	e, _ := NewDCTNFTRecreateFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, createNewDCTDataStorageHandler(), &mock.DCTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	userAcc := mock.NewUserAccount(caller)
+
+	output, err := e.ProcessBuiltinFunction(userAcc, nil, createMetaDataModifyInput(caller, createNFTRecreateArguments(tokenID, 1, 10)[:6]...))
+	require.Nil(t, output)
+	require.True(t, errors.Is(err, ErrInvalidArguments))
+
+	_, err = e.ProcessBuiltinFunction(userAcc, nil, createMetaDataModifyInput(caller, createNFTRecreateArguments(tokenID, 1, 10001)...))
+	require.True(t, errors.Is(err, ErrInvalidArguments))
+
+	_, err = e.ProcessBuiltinFunction(userAcc, nil, createMetaDataModifyInput(caller, createNFTRecreateArguments(tokenID, 0, 10)...))
+	require.Equal(t, ErrNFTDoesNotHaveMetadata, err)
+
+	_, err = e.ProcessBuiltinFunction(userAcc, nil, createMetaDataModifyInput(caller, createNFTRecreateArguments(tokenID, 1, 10)...))
+	require.Equal(t, ErrNewNFTDataOnSenderAddress, err)
+
+	localErr := errors.New("local err")
+	e.rolesHandler = &mock.DCTRoleHandlerStub{
+		CheckAllowedToExecuteCalled: func(_ vmcommon.UserAccountHandler, _ []byte, action []byte) error {
+			require.Equal(t, []byte(vmcommon.DCTRoleNFTRecreate), action)
+			return localErr
+		},
+	}
+	_, err = e.ProcessBuiltinFunction(userAcc, nil, createMetaDataModifyInput(caller, createNFTRecreateArguments(tokenID, 1, 10)...))
+	require.Equal(t, localErr, err)
+}
+
+func TestDCTNFTRecreate_ProcessBuiltinFunctionShouldUpdateMetaDataOnSystemAccount(t *testing.T) {
+	t.Parallel()
+
+	caller := []byte("caller")
+	tokenID := []byte("TKN")
+	storageHandler := createNewDCTDataStorageHandlerWithArgs(&mock.GlobalSettingsHandlerStub{}, createAccountsWithSystemAccount(), 0)
+	e, _ := NewDCTNFTRecreateFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, storageHandler, &mock.DCTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	userAcc := mock.NewUserAccount(caller)
+	otherHolder := mock.NewUserAccount([]byte("holder"))
+	createNFTWithMetaData(t, storageHandler, userAcc, tokenID, 1)
+	createNFTWithMetaData(t, storageHandler, otherHolder, tokenID, 1)
+
+	output, err := e.ProcessBuiltinFunction(userAcc, nil, createMetaDataModifyInput(caller, createNFTRecreateArguments(tokenID, 1, 500)...))
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.Ok, output.ReturnCode)
+
+	expectedMetaData := &dct.MetaData{
+		Nonce:      1,
+		Name:       []byte("new name"),
+		Creator:    []byte("creator"),
+		Royalties:  500,
+		Hash:       []byte("new hash"),
+		Attributes: []byte("new attributes"),
+		URIs:       [][]byte{[]byte("new uri")},
+	}
+	dctData, _ := storageHandler.GetDCTNFTTokenOnSender(otherHolder, append(keyPrefix, tokenID...), 1)
+	require.Equal(t, expectedMetaData, dctData.TokenMetaData)
+
+	require.Equal(t, 1, len(output.Logs))
+	require.Equal(t, []byte(vmcommon.BuiltInFunctionDCTNFTRecreate), output.Logs[0].Identifier)
+	require.Equal(t, 3, len(output.Logs[0].Topics))
+	loggedData := &dct.DCToken{}
+	err = e.marshalizer.Unmarshal(loggedData, output.Logs[0].Topics[2])
+	require.Nil(t, err)
+	require.Equal(t, expectedMetaData, loggedData.TokenMetaData)
+}
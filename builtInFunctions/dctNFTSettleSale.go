@@ -0,0 +1,495 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/atomic"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+)
+
+const numArgumentsSettleSale = 7
+
+type dctNFTSettleSale struct {
+	*baseEnabled
+	keyPrefix             []byte
+	marshalizer           vmcommon.Marshalizer
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler
+	rolesHandler          vmcommon.DCTRoleHandler
+	storageHandler        vmcommon.DCTNFTStorageHandler
+	accounts              vmcommon.AccountsAdapter
+	shardCoordinator      vmcommon.Coordinator
+	funcGasCost           uint64
+	gasConfig             vmcommon.BaseOperationCost
+	lockedBalanceHandler  vmcommon.DCTLockedBalanceHandler
+	payableHandler        vmcommon.PayableHandler
+	mutExecution          sync.RWMutex
+}
+
+// NewDCTNFTSettleSaleFunc returns the dct NFT sale settlement built-in function component
+func NewDCTNFTSettleSaleFunc(
+	funcGasCost uint64,
+	gasConfig vmcommon.BaseOperationCost,
+	marshalizer vmcommon.Marshalizer,
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler,
+	rolesHandler vmcommon.DCTRoleHandler,
+	storageHandler vmcommon.DCTNFTStorageHandler,
+	accounts vmcommon.AccountsAdapter,
+	shardCoordinator vmcommon.Coordinator,
+	activationEpoch uint32,
+	epochNotifier vmcommon.EpochNotifier,
+	lockedBalanceHandler vmcommon.DCTLockedBalanceHandler,
+) (*dctNFTSettleSale, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(globalSettingsHandler) {
+		return nil, ErrNilGlobalSettingsHandler
+	}
+	if check.IfNil(rolesHandler) {
+		return nil, ErrNilRolesHandler
+	}
+	if check.IfNil(storageHandler) {
+		return nil, ErrNilDCTNFTStorageHandler
+	}
+	if check.IfNil(accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(shardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, ErrNilEpochHandler
+	}
+	if check.IfNil(lockedBalanceHandler) {
+		return nil, ErrNilLockedBalanceHandler
+	}
+
+	e := &dctNFTSettleSale{
+		keyPrefix:             []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
+		marshalizer:           marshalizer,
+		globalSettingsHandler: globalSettingsHandler,
+		rolesHandler:          rolesHandler,
+		storageHandler:        storageHandler,
+		accounts:              accounts,
+		shardCoordinator:      shardCoordinator,
+		funcGasCost:           funcGasCost,
+		gasConfig:             gasConfig,
+		mutExecution:          sync.RWMutex{},
+		lockedBalanceHandler:  lockedBalanceHandler,
+		payableHandler:        &disabledPayableHandler{},
+	}
+
+	e.baseEnabled = &baseEnabled{
+		function:        vmcommon.BuiltInFunctionDCTNFTSettleSale,
+		activationEpoch: activationEpoch,
+		flagActivated:   atomic.Flag{},
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *dctNFTSettleSale) SetNewGasConfig(gasCost *vmcommon.GasCost) {
+	if gasCost == nil {
+		return
+	}
+
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.DCTNFTSettleSale
+	e.gasConfig = gasCost.BaseOperationCost
+	e.mutExecution.Unlock()
+}
+
+// SetPayableHandler will set the payable handler to the function
+func (e *dctNFTSettleSale) SetPayableHandler(payableHandler vmcommon.PayableHandler) error {
+	if check.IfNil(payableHandler) {
+		return ErrNilPayableHandler
+	}
+
+	e.payableHandler = payableHandler
+	return nil
+}
+
+// ProcessBuiltinFunction resolves DCT NFT settle sale function call. The caller holds both the NFT and the payment
+// and in one step sends the NFT to the buyer, the royalty to the NFT creator and the rest of the price to the seller
+// Requires 7 arguments:
+// arg0 - token identifier
+// arg1 - nonce
+// arg2 - quantity to sell
+// arg3 - buyer address
+// arg4 - seller address
+// arg5 - payment token identifier - must be a fungible DCT
+// arg6 - price
+func (e *dctNFTSettleSale) ProcessBuiltinFunction(
+	acntSnd, _ vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	err := checkDCTNFTCreateBurnAddInput(acntSnd, vmInput, e.funcGasCost)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) != numArgumentsSettleSale {
+		return nil, ErrInvalidArguments
+	}
+
+	tokenID := vmInput.Arguments[0]
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	if nonce == 0 {
+		return nil, ErrNFTDoesNotHaveMetadata
+	}
+	quantity := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	if quantity.Cmp(zero) <= 0 {
+		return nil, ErrInvalidNFTQuantity
+	}
+	buyer := vmInput.Arguments[3]
+	seller := vmInput.Arguments[4]
+	err = e.checkParticipant(buyer, vmInput.CallerAddr)
+	if err != nil {
+		return nil, err
+	}
+	err = e.checkParticipant(seller, vmInput.CallerAddr)
+	if err != nil {
+		return nil, err
+	}
+	paymentTokenID := vmInput.Arguments[5]
+	price := big.NewInt(0).SetBytes(vmInput.Arguments[6])
+
+	dctTokenKey := append(e.keyPrefix, tokenID...)
+	dctData, err := e.storageHandler.GetDCTNFTTokenOnSender(acntSnd, dctTokenKey, nonce)
+	if err != nil {
+		return nil, err
+	}
+	if dctData.Value.Cmp(quantity) < 0 {
+		return nil, ErrInvalidNFTQuantity
+	}
+	err = checkLimitedTransfer(acntSnd, tokenID, dctTokenKey, e.globalSettingsHandler, e.rolesHandler, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return nil, err
+	}
+	err = checkQuantityForTokenType(e.globalSettingsHandler, dctTokenKey, quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	creator, royalties := e.getCreatorAndRoyalties(dctData, vmInput.CallerAddr)
+	royalty, proceeds, err := vmcommon.ComputeRoyalties(price, royalties)
+	if err != nil {
+		return nil, err
+	}
+
+	dctData.Value.Sub(dctData.Value, quantity)
+	_, err = e.storageHandler.SaveDCTNFTToken(acntSnd, dctTokenKey, nonce, dctData, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return nil, err
+	}
+	dctData.Value.Set(quantity)
+
+	paymentTokenKey := append(e.keyPrefix, paymentTokenID...)
+	if price.Cmp(zero) > 0 {
+		err = checkLimitedTransfer(acntSnd, paymentTokenID, paymentTokenKey, e.globalSettingsHandler, e.rolesHandler, vmInput.ReturnCallAfterError)
+		if err != nil {
+			return nil, err
+		}
+		err = addToDCTBalance(acntSnd, paymentTokenKey, big.NewInt(0).Neg(price), e.marshalizer, e.globalSettingsHandler, e.lockedBalanceHandler, vmInput.ReturnCallAfterError)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - e.funcGasCost,
+	}
+	accountsInShard := map[string]vmcommon.UserAccountHandler{string(vmInput.CallerAddr): acntSnd}
+
+	err = e.sendNFT(vmInput, vmOutput, accountsInShard, buyer, dctData, dctTokenKey, nonce)
+	if err != nil {
+		return nil, err
+	}
+	err = e.sendPayment(vmInput, vmOutput, accountsInShard, creator, paymentTokenID, paymentTokenKey, royalty)
+	if err != nil {
+		return nil, err
+	}
+	err = e.sendPayment(vmInput, vmOutput, accountsInShard, seller, paymentTokenID, paymentTokenKey, proceeds)
+	if err != nil {
+		return nil, err
+	}
+
+	for address, userAccount := range accountsInShard {
+		if address == string(vmInput.CallerAddr) {
+			continue
+		}
+
+		err = e.accounts.SaveAccount(userAccount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	logEntry := newEntryForNFT(vmcommon.BuiltInFunctionDCTNFTSettleSale, vmInput.CallerAddr, tokenID, nonce)
+	logEntry.Topics = append(logEntry.Topics,
+		quantity.Bytes(),
+		buyer,
+		seller,
+		creator,
+		paymentTokenID,
+		price.Bytes(),
+		royalty.Bytes(),
+		proceeds.Bytes(),
+	)
+	vmOutput.Logs = []*vmcommon.LogEntry{logEntry}
+
+	return vmOutput, nil
+}
+
+func (e *dctNFTSettleSale) checkParticipant(address []byte, callerAddress []byte) error {
+	if len(address) != len(callerAddress) {
+		return fmt.Errorf("%w, not a valid address", ErrInvalidSaleParticipant)
+	}
+	if e.shardCoordinator.ComputeId(address) == vmcommon.MetachainShardId {
+		return fmt.Errorf("%w, metachain address", ErrInvalidSaleParticipant)
+	}
+
+	return nil
+}
+
+// getCreatorAndRoyalties returns the address which receives the royalty. Tokens without a valid creator pay
+// no royalty, the whole price going to the seller
+func (e *dctNFTSettleSale) getCreatorAndRoyalties(dctData *dct.DCToken, callerAddress []byte) ([]byte, uint32) {
+	if dctData.TokenMetaData == nil {
+		return nil, 0
+	}
+
+	creator := dctData.TokenMetaData.Creator
+	if len(creator) != len(callerAddress) || e.shardCoordinator.ComputeId(creator) == vmcommon.MetachainShardId {
+		return nil, 0
+	}
+
+	return creator, dctData.TokenMetaData.Royalties
+}
+
+func (e *dctNFTSettleSale) sendNFT(
+	vmInput *vmcommon.ContractCallInput,
+	vmOutput *vmcommon.VMOutput,
+	accountsInShard map[string]vmcommon.UserAccountHandler,
+	dstAddress []byte,
+	dctData *dct.DCToken,
+	dctTokenKey []byte,
+	nonce uint64,
+) error {
+	tokenID := vmInput.Arguments[0]
+	acntDst, err := e.loadAccountIfInShard(dstAddress, accountsInShard)
+	if err != nil {
+		return err
+	}
+	if !check.IfNil(acntDst) {
+		err = e.checkPayable(vmInput, dstAddress)
+		if err != nil {
+			return err
+		}
+
+		dctNFTTokenKey := computeDCTNFTTokenKey(dctTokenKey, nonce)
+		// the stored lengths only price the stored data, a failed read counts as an empty value
+		oldLength, _ := getStoredLength(acntDst, dctNFTTokenKey)
+		err = e.addNFTToDestination(acntDst, dctData, tokenID, dctTokenKey, nonce, vmInput.ReturnCallAfterError)
+		if err != nil {
+			return err
+		}
+		newLength, _ := getStoredLength(acntDst, dctNFTTokenKey)
+
+		return e.useGasForStoredData(vmInput, vmOutput, oldLength, newLength)
+	}
+
+	marshaledNFTTransfer, err := e.marshalizer.Marshal(dctData)
+	if err != nil {
+		return err
+	}
+
+	traceGas(vmInput, gasComponentDataCopyPerByte, uint64(len(marshaledNFTTransfer)), e.gasConfig.DataCopyPerByte)
+	gasForTransfer := uint64(len(marshaledNFTTransfer)) * e.gasConfig.DataCopyPerByte
+	if gasForTransfer > vmOutput.GasRemaining {
+		return ErrNotEnoughGas
+	}
+	vmOutput.GasRemaining -= gasForTransfer
+
+	nftTransferCallArgs := [][]byte{tokenID, vmInput.Arguments[1], vmInput.Arguments[2], marshaledNFTTransfer}
+	appendOutputTransferToVMOutput(
+		vmInput.CallerAddr,
+		vmcommon.BuiltInFunctionDCTNFTTransfer,
+		nftTransferCallArgs,
+		dstAddress,
+		vmInput.GasLocked,
+		vmInput.CallType,
+		vmOutput,
+	)
+
+	return nil
+}
+
+func (e *dctNFTSettleSale) sendPayment(
+	vmInput *vmcommon.ContractCallInput,
+	vmOutput *vmcommon.VMOutput,
+	accountsInShard map[string]vmcommon.UserAccountHandler,
+	dstAddress []byte,
+	paymentTokenID []byte,
+	paymentTokenKey []byte,
+	value *big.Int,
+) error {
+	if value.Cmp(zero) <= 0 {
+		return nil
+	}
+
+	acntDst, err := e.loadAccountIfInShard(dstAddress, accountsInShard)
+	if err != nil {
+		return err
+	}
+	if check.IfNil(acntDst) {
+		appendOutputTransferToVMOutput(
+			vmInput.CallerAddr,
+			vmcommon.BuiltInFunctionDCTTransfer,
+			[][]byte{paymentTokenID, value.Bytes()},
+			dstAddress,
+			vmInput.GasLocked,
+			vmInput.CallType,
+			vmOutput,
+		)
+		return nil
+	}
+
+	err = e.checkPayable(vmInput, dstAddress)
+	if err != nil {
+		return err
+	}
+	err = checkLimitedTransfer(acntDst, paymentTokenID, paymentTokenKey, e.globalSettingsHandler, e.rolesHandler, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return err
+	}
+
+	// the stored lengths only price the stored data, a failed read counts as an empty value
+	oldLength, _ := getStoredLength(acntDst, paymentTokenKey)
+	err = addToDCTBalance(acntDst, paymentTokenKey, value, e.marshalizer, e.globalSettingsHandler, e.lockedBalanceHandler, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return err
+	}
+	newLength, _ := getStoredLength(acntDst, paymentTokenKey)
+
+	return e.useGasForStoredData(vmInput, vmOutput, oldLength, newLength)
+}
+
+func (e *dctNFTSettleSale) checkPayable(vmInput *vmcommon.ContractCallInput, dstAddress []byte) error {
+	if !mustVerifyPayable(vmInput, numArgumentsSettleSale) {
+		return nil
+	}
+
+	isPayable, err := e.payableHandler.IsPayable(dstAddress)
+	if err != nil {
+		return err
+	}
+	if !isPayable {
+		return ErrAccountNotPayable
+	}
+
+	return nil
+}
+
+// useGasForStoredData charges the store per byte cost for the growth of the data saved in a destination account
+func (e *dctNFTSettleSale) useGasForStoredData(
+	vmInput *vmcommon.ContractCallInput,
+	vmOutput *vmcommon.VMOutput,
+	oldLength uint64,
+	newLength uint64,
+) error {
+	if newLength <= oldLength {
+		return nil
+	}
+
+	storedLength := newLength - oldLength
+	traceGas(vmInput, gasComponentStorePerByte, storedLength, e.gasConfig.StorePerByte)
+	gasForStore := storedLength * e.gasConfig.StorePerByte
+	if gasForStore > vmOutput.GasRemaining {
+		return ErrNotEnoughGas
+	}
+	vmOutput.GasRemaining -= gasForStore
+
+	return nil
+}
+
+// loadAccountIfInShard returns nil for addresses from other shards. Each account is loaded once, so that all the
+// changes made during the settlement end up in the same account instance
+func (e *dctNFTSettleSale) loadAccountIfInShard(
+	address []byte,
+	accountsInShard map[string]vmcommon.UserAccountHandler,
+) (vmcommon.UserAccountHandler, error) {
+	userAccount, ok := accountsInShard[string(address)]
+	if ok {
+		return userAccount, nil
+	}
+	if e.shardCoordinator.SelfId() != e.shardCoordinator.ComputeId(address) {
+		return nil, nil
+	}
+
+	accountHandler, err := e.accounts.LoadAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	userAccount, ok = accountHandler.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+	accountsInShard[string(address)] = userAccount
+
+	return userAccount, nil
+}
+
+func (e *dctNFTSettleSale) addNFTToDestination(
+	userAccount vmcommon.UserAccountHandler,
+	dctDataToTransfer *dct.DCToken,
+	tokenID []byte,
+	dctTokenKey []byte,
+	nonce uint64,
+	isReturnWithError bool,
+) error {
+	err := checkLimitedTransfer(userAccount, tokenID, dctTokenKey, e.globalSettingsHandler, e.rolesHandler, isReturnWithError)
+	if err != nil {
+		return err
+	}
+
+	currentDCTData, _, err := e.storageHandler.GetDCTNFTTokenOnDestination(userAccount, dctTokenKey, nonce)
+	if err != nil && !errors.Is(err, ErrNFTTokenDoesNotExist) {
+		return err
+	}
+	err = checkFrozeAndPause(userAccount.AddressBytes(), dctTokenKey, currentDCTData, e.globalSettingsHandler, isReturnWithError)
+	if err != nil {
+		return err
+	}
+
+	err = e.storageHandler.CheckDCTNFTTokenOnDestination(currentDCTData, dctDataToTransfer)
+	if err != nil {
+		return err
+	}
+
+	dctDataOnDestination := &dct.DCToken{
+		Type:          dctDataToTransfer.Type,
+		Value:         big.NewInt(0).Add(dctDataToTransfer.Value, currentDCTData.Value),
+		Properties:    currentDCTData.Properties,
+		TokenMetaData: dctDataToTransfer.TokenMetaData,
+	}
+	_, err = e.storageHandler.SaveDCTNFTToken(userAccount, dctTokenKey, nonce, dctDataOnDestination, isReturnWithError)
+
+	return err
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *dctNFTSettleSale) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,384 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSettleSaleWithMockArguments(selfShard uint32) (*dctNFTSettleSale, map[string]vmcommon.UserAccountHandler) {
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.CurrentShard = selfShard
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		return uint32(address[len(address)-1])
+	}
+	mapAccounts := make(map[string]vmcommon.UserAccountHandler)
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			_, ok := mapAccounts[string(address)]
+			if !ok {
+				mapAccounts[string(address)] = mock.NewUserAccount(address)
+			}
+			return mapAccounts[string(address)], nil
+		},
+	}
+
+	settleSale, _ := NewDCTNFTSettleSaleFunc(
+		10,
+		vmcommon.BaseOperationCost{DataCopyPerByte: 1},
+		&mock.MarshalizerMock{},
+		&mock.GlobalSettingsHandlerStub{},
+		&mock.DCTRoleHandlerStub{},
+		createNewDCTDataStorageHandler(),
+		accounts,
+		shardCoordinator,
+		0,
+		&mock.EpochNotifierStub{},
+		&mock.LockedBalanceHandlerStub{},
+	)
+	_ = settleSale.SetPayableHandler(&mock.PayableHandlerStub{})
+
+	return settleSale, mapAccounts
+}
+
+func createSettleSaleAddress(id byte, shard uint32) []byte {
+	address := bytes.Repeat([]byte{id}, 32)
+	address[31] = byte(shard)
+
+	return address
+}
+
+func saveSettleSaleNFT(
+	t *testing.T,
+	e *dctNFTSettleSale,
+	account vmcommon.UserAccountHandler,
+	tokenID []byte,
+	nonce uint64,
+	creator []byte,
+	royalties uint32,
+) {
+	dctData := &dct.DCToken{
+		Type:  uint32(vmcommon.NonFungible),
+		Value: big.NewInt(1),
+		TokenMetaData: &dct.MetaData{
+			Nonce:     nonce,
+			Creator:   creator,
+			Royalties: royalties,
+			Hash:      []byte("hash"),
+		},
+	}
+	_, err := e.storageHandler.SaveDCTNFTToken(account, append(keyPrefix, tokenID...), nonce, dctData, false)
+	require.Nil(t, err)
+}
+
+func getSettleSaleBalance(account vmcommon.UserAccountHandler, tokenID []byte) *big.Int {
+	dctData, _ := getDCTDataFromKey(account, append(keyPrefix, tokenID...), &mock.MarshalizerMock{})
+	return dctData.Value
+}
+
+func createSettleSaleInput(caller, buyer, seller []byte, price int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			CallValue:   big.NewInt(0),
+			GasProvided: 1000,
+			Arguments: [][]byte{
+				[]byte("NFT"),
+				big.NewInt(1).Bytes(),
+				big.NewInt(1).Bytes(),
+				buyer,
+				seller,
+				[]byte("PAY"),
+				big.NewInt(price).Bytes(),
+			},
+		},
+		RecipientAddr: caller,
+	}
+}
+
+func TestNewDCTNFTSettleSaleFunc(t *testing.T) {
+	t.Parallel()
+
+	e, err := NewDCTNFTSettleSaleFunc(10, vmcommon.BaseOperationCost{}, nil, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, createNewDCTDataStorageHandler(), &mock.AccountsStub{}, &mock.ShardCoordinatorStub{}, 0, &mock.EpochNotifierStub{}, &mock.LockedBalanceHandlerStub{})
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	e, err = NewDCTNFTSettleSaleFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, nil, &mock.DCTRoleHandlerStub{}, createNewDCTDataStorageHandler(), &mock.AccountsStub{}, &mock.ShardCoordinatorStub{}, 0, &mock.EpochNotifierStub{}, &mock.LockedBalanceHandlerStub{})
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilGlobalSettingsHandler, err)
+
+	e, err = NewDCTNFTSettleSaleFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, nil, createNewDCTDataStorageHandler(), &mock.AccountsStub{}, &mock.ShardCoordinatorStub{}, 0, &mock.EpochNotifierStub{}, &mock.LockedBalanceHandlerStub{})
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilRolesHandler, err)
+
+	e, err = NewDCTNFTSettleSaleFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, nil, &mock.AccountsStub{}, &mock.ShardCoordinatorStub{}, 0, &mock.EpochNotifierStub{}, &mock.LockedBalanceHandlerStub{})
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilDCTNFTStorageHandler, err)
+
+	e, err = NewDCTNFTSettleSaleFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, createNewDCTDataStorageHandler(), nil, &mock.ShardCoordinatorStub{}, 0, &mock.EpochNotifierStub{}, &mock.LockedBalanceHandlerStub{})
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+
+	e, err = NewDCTNFTSettleSaleFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, createNewDCTDataStorageHandler(), &mock.AccountsStub{}, nil, 0, &mock.EpochNotifierStub{}, &mock.LockedBalanceHandlerStub{})
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilShardCoordinator, err)
+
+	e, err = NewDCTNFTSettleSaleFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, createNewDCTDataStorageHandler(), &mock.AccountsStub{}, &mock.ShardCoordinatorStub{}, 0, nil, &mock.LockedBalanceHandlerStub{})
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilEpochHandler, err)
+
+	e, err = NewDCTNFTSettleSaleFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, createNewDCTDataStorageHandler(), &mock.AccountsStub{}, &mock.ShardCoordinatorStub{}, 1, &mock.EpochNotifierStub{}, &mock.LockedBalanceHandlerStub{})
+	assert.False(t, check.IfNil(e))
+	assert.Nil(t, err)
+	assert.False(t, e.IsActive())
+
+	e.EpochConfirmed(1, 0)
+	assert.True(t, e.IsActive())
+}
+
+func TestDCTNFTSettleSale_SetNewGasConfig(t *testing.T) {
+	t.Parallel()
+
+	e, _ := createSettleSaleWithMockArguments(0)
+	e.SetNewGasConfig(nil)
+	assert.Equal(t, uint64(10), e.funcGasCost)
+
+	gasCost := createMockGasCost()
+	e.SetNewGasConfig(&gasCost)
+	assert.Equal(t, gasCost.BuiltInCost.DCTNFTSettleSale, e.funcGasCost)
+	assert.Equal(t, gasCost.BaseOperationCost, e.gasConfig)
+}
+
+func TestDCTNFTSettleSale_ProcessBuiltinFunctionInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	e, _ := createSettleSaleWithMockArguments(0)
+	caller := createSettleSaleAddress(1, 0)
+	buyer := createSettleSaleAddress(2, 0)
+	seller := createSettleSaleAddress(3, 0)
+	acntSnd := mock.NewUserAccount(caller)
+
+	output, err := e.ProcessBuiltinFunction(acntSnd, nil, nil)
+	assert.Nil(t, output)
+	assert.Equal(t, ErrNilVmInput, err)
+
+	input := createSettleSaleInput(caller, buyer, seller, 1000)
+	input.RecipientAddr = buyer
+	_, err = e.ProcessBuiltinFunction(acntSnd, nil, input)
+	assert.Equal(t, ErrInvalidRcvAddr, err)
+
+	input = createSettleSaleInput(caller, buyer, seller, 1000)
+	input.Arguments = input.Arguments[:6]
+	_, err = e.ProcessBuiltinFunction(acntSnd, nil, input)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	input = createSettleSaleInput(caller, buyer, seller, 1000)
+	input.Arguments[1] = big.NewInt(0).Bytes()
+	_, err = e.ProcessBuiltinFunction(acntSnd, nil, input)
+	assert.Equal(t, ErrNFTDoesNotHaveMetadata, err)
+
+	input = createSettleSaleInput(caller, buyer, seller, 1000)
+	input.Arguments[2] = big.NewInt(0).Bytes()
+	_, err = e.ProcessBuiltinFunction(acntSnd, nil, input)
+	assert.Equal(t, ErrInvalidNFTQuantity, err)
+
+	input = createSettleSaleInput(caller, []byte("short"), seller, 1000)
+	_, err = e.ProcessBuiltinFunction(acntSnd, nil, input)
+	assert.ErrorIs(t, err, ErrInvalidSaleParticipant)
+
+	input = createSettleSaleInput(caller, buyer, seller, 1000)
+	input.GasProvided = 1
+	_, err = e.ProcessBuiltinFunction(acntSnd, nil, input)
+	assert.Equal(t, ErrNotEnoughGas, err)
+
+	input = createSettleSaleInput(caller, buyer, seller, 1000)
+	_, err = e.ProcessBuiltinFunction(acntSnd, nil, input)
+	assert.Equal(t, ErrNewNFTDataOnSenderAddress, err)
+}
+
+func TestDCTNFTSettleSale_ProcessBuiltinFunctionNotEnoughPaymentShouldErr(t *testing.T) {
+	t.Parallel()
+
+	e, _ := createSettleSaleWithMockArguments(0)
+	caller := createSettleSaleAddress(1, 0)
+	creator := createSettleSaleAddress(4, 0)
+	acntSnd := mock.NewUserAccount(caller)
+	saveSettleSaleNFT(t, e, acntSnd, []byte("NFT"), 1, creator, 1000)
+	err := addToDCTBalance(acntSnd, append(keyPrefix, []byte("PAY")...), big.NewInt(999), e.marshalizer, e.globalSettingsHandler, e.lockedBalanceHandler, false)
+	require.Nil(t, err)
+
+	input := createSettleSaleInput(caller, createSettleSaleAddress(2, 0), createSettleSaleAddress(3, 0), 1000)
+	_, err = e.ProcessBuiltinFunction(acntSnd, nil, input)
+	assert.Equal(t, ErrInsufficientFunds, err)
+}
+
+func TestDCTNFTSettleSale_ProcessBuiltinFunctionOnSameShardShouldWork(t *testing.T) {
+	t.Parallel()
+
+	e, mapAccounts := createSettleSaleWithMockArguments(0)
+	caller := createSettleSaleAddress(1, 0)
+	buyer := createSettleSaleAddress(2, 0)
+	seller := createSettleSaleAddress(3, 0)
+	creator := createSettleSaleAddress(4, 0)
+	acntSnd := mock.NewUserAccount(caller)
+	saveSettleSaleNFT(t, e, acntSnd, []byte("NFT"), 1, creator, 250)
+	err := addToDCTBalance(acntSnd, append(keyPrefix, []byte("PAY")...), big.NewInt(1000), e.marshalizer, e.globalSettingsHandler, e.lockedBalanceHandler, false)
+	require.Nil(t, err)
+
+	input := createSettleSaleInput(caller, buyer, seller, 1000)
+	output, err := e.ProcessBuiltinFunction(acntSnd, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, output.ReturnCode)
+	assert.Equal(t, uint64(990), output.GasRemaining)
+	assert.Equal(t, 0, len(output.OutputAccounts))
+
+	testNFTTokenShouldExist(t, e.marshalizer, mapAccounts[string(buyer)], []byte("NFT"), 1, big.NewInt(1))
+	assert.Equal(t, big.NewInt(0), getSettleSaleBalance(acntSnd, []byte("PAY")))
+	assert.Equal(t, big.NewInt(25), getSettleSaleBalance(mapAccounts[string(creator)], []byte("PAY")))
+	assert.Equal(t, big.NewInt(975), getSettleSaleBalance(mapAccounts[string(seller)], []byte("PAY")))
+
+	_, err = e.storageHandler.GetDCTNFTTokenOnSender(acntSnd, append(keyPrefix, []byte("NFT")...), 1)
+	assert.Equal(t, ErrNewNFTDataOnSenderAddress, err)
+
+	require.Equal(t, 1, len(output.Logs))
+	expectedTopics := [][]byte{
+		[]byte("NFT"),
+		big.NewInt(1).Bytes(),
+		big.NewInt(1).Bytes(),
+		buyer,
+		seller,
+		creator,
+		[]byte("PAY"),
+		big.NewInt(1000).Bytes(),
+		big.NewInt(25).Bytes(),
+		big.NewInt(975).Bytes(),
+	}
+	assert.Equal(t, []byte(vmcommon.BuiltInFunctionDCTNFTSettleSale), output.Logs[0].Identifier)
+	assert.Equal(t, caller, output.Logs[0].Address)
+	assert.Equal(t, expectedTopics, output.Logs[0].Topics)
+}
+
+func TestDCTNFTSettleSale_ProcessBuiltinFunctionCrossShardShouldCreateOutputTransfers(t *testing.T) {
+	t.Parallel()
+
+	e, mapAccounts := createSettleSaleWithMockArguments(0)
+	caller := createSettleSaleAddress(1, 0)
+	buyer := createSettleSaleAddress(2, 1)
+	seller := createSettleSaleAddress(3, 0)
+	creator := createSettleSaleAddress(4, 1)
+	acntSnd := mock.NewUserAccount(caller)
+	saveSettleSaleNFT(t, e, acntSnd, []byte("NFT"), 1, creator, 1000)
+	err := addToDCTBalance(acntSnd, append(keyPrefix, []byte("PAY")...), big.NewInt(1000), e.marshalizer, e.globalSettingsHandler, e.lockedBalanceHandler, false)
+	require.Nil(t, err)
+
+	input := createSettleSaleInput(caller, buyer, seller, 1000)
+	output, err := e.ProcessBuiltinFunction(acntSnd, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(900), getSettleSaleBalance(mapAccounts[string(seller)], []byte("PAY")))
+	require.Equal(t, 2, len(output.OutputAccounts))
+
+	buyerTransfers := output.OutputAccounts[string(buyer)].OutputTransfers
+	require.Equal(t, 1, len(buyerTransfers))
+	assert.True(t, strings.HasPrefix(string(buyerTransfers[0].Data), vmcommon.BuiltInFunctionDCTNFTTransfer+"@"))
+	assert.Equal(t, uint64(0), buyerTransfers[0].GasLimit)
+	assert.True(t, output.GasRemaining < 990)
+
+	creatorTransfers := output.OutputAccounts[string(creator)].OutputTransfers
+	require.Equal(t, 1, len(creatorTransfers))
+	assert.Equal(t, vmcommon.BuiltInFunctionDCTTransfer+"@"+"504159"+"@"+"64", string(creatorTransfers[0].Data))
+}
+
+func TestDCTNFTSettleSale_ProcessBuiltinFunctionTokenWithoutMetaDataShouldWork(t *testing.T) {
+	t.Parallel()
+
+	e, mapAccounts := createSettleSaleWithMockArguments(0)
+	caller := createSettleSaleAddress(1, 0)
+	buyer := createSettleSaleAddress(2, 0)
+	seller := createSettleSaleAddress(3, 0)
+	acntSnd := mock.NewUserAccount(caller)
+	dctData := &dct.DCToken{Type: uint32(vmcommon.NonFungible), Value: big.NewInt(1)}
+	marshaledData, _ := e.marshalizer.Marshal(dctData)
+	_ = acntSnd.AccountDataHandler().SaveKeyValue(computeDCTNFTTokenKey(append(keyPrefix, []byte("NFT")...), 1), marshaledData)
+	err := addToDCTBalance(acntSnd, append(keyPrefix, []byte("PAY")...), big.NewInt(1000), e.marshalizer, e.globalSettingsHandler, e.lockedBalanceHandler, false)
+	require.Nil(t, err)
+
+	input := createSettleSaleInput(caller, buyer, seller, 1000)
+	_, err = e.ProcessBuiltinFunction(acntSnd, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(1000), getSettleSaleBalance(mapAccounts[string(seller)], []byte("PAY")))
+
+	dctData, err = e.storageHandler.GetDCTNFTTokenOnSender(mapAccounts[string(buyer)], append(keyPrefix, []byte("NFT")...), 1)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(1), dctData.Value)
+}
+
+func TestDCTNFTSettleSale_ProcessBuiltinFunctionNotPayableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	e, _ := createSettleSaleWithMockArguments(0)
+	caller := createSettleSaleAddress(1, 0)
+	buyer := createSettleSaleAddress(2, 0)
+	seller := createSettleSaleAddress(3, 0)
+	creator := createSettleSaleAddress(4, 0)
+	acntSnd := mock.NewUserAccount(caller)
+	saveSettleSaleNFT(t, e, acntSnd, []byte("NFT"), 1, creator, 250)
+	err := addToDCTBalance(acntSnd, append(keyPrefix, []byte("PAY")...), big.NewInt(1000), e.marshalizer, e.globalSettingsHandler, e.lockedBalanceHandler, false)
+	require.Nil(t, err)
+
+	for _, notPayable := range [][]byte{buyer, seller, creator} {
+		notPayableAddress := notPayable
+		_ = e.SetPayableHandler(&mock.PayableHandlerStub{
+			IsPayableCalled: func(address []byte) (bool, error) {
+				return !bytes.Equal(address, notPayableAddress), nil
+			},
+		})
+
+		input := createSettleSaleInput(caller, buyer, seller, 1000)
+		_, err = e.ProcessBuiltinFunction(acntSnd.Clone(), nil, input)
+		assert.Equal(t, ErrAccountNotPayable, err)
+	}
+
+	err = e.SetPayableHandler(nil)
+	assert.Equal(t, ErrNilPayableHandler, err)
+}
+
+func TestDCTNFTSettleSale_ProcessBuiltinFunctionShouldChargeStoredData(t *testing.T) {
+	t.Parallel()
+
+	e, mapAccounts := createSettleSaleWithMockArguments(0)
+	e.gasConfig.StorePerByte = 1
+	caller := createSettleSaleAddress(1, 0)
+	buyer := createSettleSaleAddress(2, 0)
+	seller := createSettleSaleAddress(3, 0)
+	creator := createSettleSaleAddress(4, 0)
+	acntSnd := mock.NewUserAccount(caller)
+	saveSettleSaleNFT(t, e, acntSnd, []byte("NFT"), 1, creator, 250)
+	err := addToDCTBalance(acntSnd, append(keyPrefix, []byte("PAY")...), big.NewInt(1000), e.marshalizer, e.globalSettingsHandler, e.lockedBalanceHandler, false)
+	require.Nil(t, err)
+
+	input := createSettleSaleInput(caller, buyer, seller, 1000)
+	output, err := e.ProcessBuiltinFunction(acntSnd, nil, input)
+	require.Nil(t, err)
+
+	nftLength, _ := getStoredLength(mapAccounts[string(buyer)], computeDCTNFTTokenKey(append(keyPrefix, []byte("NFT")...), 1))
+	sellerLength, _ := getStoredLength(mapAccounts[string(seller)], append(keyPrefix, []byte("PAY")...))
+	creatorLength, _ := getStoredLength(mapAccounts[string(creator)], append(keyPrefix, []byte("PAY")...))
+	storedLength := nftLength + sellerLength + creatorLength
+	assert.True(t, nftLength > 0)
+	assert.Equal(t, 990-storedLength, output.GasRemaining)
+
+	input = createSettleSaleInput(caller, buyer, seller, 0)
+	input.GasProvided = 10 + nftLength - 1
+	saveSettleSaleNFT(t, e, acntSnd, []byte("NFT"), 1, creator, 250)
+	mapAccounts[string(buyer)] = mock.NewUserAccount(buyer)
+	_, err = e.ProcessBuiltinFunction(acntSnd, nil, input)
+	assert.Equal(t, ErrNotEnoughGas, err)
+}
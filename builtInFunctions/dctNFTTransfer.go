@@ -15,31 +15,41 @@ import (
 
 type dctNFTTransfer struct {
 	baseAlwaysActive
-	keyPrefix        []byte
-	marshalizer      vmcommon.Marshalizer
-	pauseHandler     vmcommon.DCTPauseHandler
-	payableHandler   vmcommon.PayableHandler
-	funcGasCost      uint64
-	accounts         vmcommon.AccountsAdapter
-	shardCoordinator vmcommon.Coordinator
-	gasConfig        vmcommon.BaseOperationCost
-	mutExecution     sync.RWMutex
+	keyPrefix             []byte
+	marshalizer           vmcommon.Marshalizer
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler
+	rolesHandler          vmcommon.DCTRoleHandler
+	storageHandler        vmcommon.DCTNFTStorageHandler
+	payableHandler        vmcommon.PayableHandler
+	funcGasCost           uint64
+	accounts              vmcommon.AccountsAdapter
+	shardCoordinator      vmcommon.Coordinator
+	gasConfig             vmcommon.BaseOperationCost
+	mutExecution          sync.RWMutex
 }
 
 // NewDCTNFTTransferFunc returns the dct NFT transfer built-in function component
 func NewDCTNFTTransferFunc(
 	funcGasCost uint64,
 	marshalizer vmcommon.Marshalizer,
-	pauseHandler vmcommon.DCTPauseHandler,
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler,
+	rolesHandler vmcommon.DCTRoleHandler,
 	accounts vmcommon.AccountsAdapter,
 	shardCoordinator vmcommon.Coordinator,
 	gasConfig vmcommon.BaseOperationCost,
+	storageHandler vmcommon.DCTNFTStorageHandler,
 ) (*dctNFTTransfer, error) {
 	if check.IfNil(marshalizer) {
 		return nil, ErrNilMarshalizer
 	}
-	if check.IfNil(pauseHandler) {
-		return nil, ErrNilPauseHandler
+	if check.IfNil(globalSettingsHandler) {
+		return nil, ErrNilGlobalSettingsHandler
+	}
+	if check.IfNil(rolesHandler) {
+		return nil, ErrNilRolesHandler
+	}
+	if check.IfNil(storageHandler) {
+		return nil, ErrNilDCTNFTStorageHandler
 	}
 	if check.IfNil(accounts) {
 		return nil, ErrNilAccountsAdapter
@@ -49,15 +59,17 @@ func NewDCTNFTTransferFunc(
 	}
 
 	e := &dctNFTTransfer{
-		keyPrefix:        []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
-		marshalizer:      marshalizer,
-		pauseHandler:     pauseHandler,
-		funcGasCost:      funcGasCost,
-		accounts:         accounts,
-		shardCoordinator: shardCoordinator,
-		gasConfig:        gasConfig,
-		mutExecution:     sync.RWMutex{},
-		payableHandler:   &disabledPayableHandler{},
+		keyPrefix:             []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
+		marshalizer:           marshalizer,
+		globalSettingsHandler: globalSettingsHandler,
+		rolesHandler:          rolesHandler,
+		storageHandler:        storageHandler,
+		funcGasCost:           funcGasCost,
+		accounts:              accounts,
+		shardCoordinator:      shardCoordinator,
+		gasConfig:             gasConfig,
+		mutExecution:          sync.RWMutex{},
+		payableHandler:        &disabledPayableHandler{},
 	}
 
 	return e, nil
@@ -127,7 +139,7 @@ func (e *dctNFTTransfer) ProcessBuiltinFunction(
 		return nil, err
 	}
 
-	err = e.addNFTToDestination(vmInput.RecipientAddr, acntDst, dctTransferData, dctTokenKey, mustVerifyPayable(vmInput, vmcommon.MinLenArgumentsDCTNFTTransfer), vmInput.ReturnCallAfterError)
+	err = e.addNFTToDestination(vmInput.RecipientAddr, acntDst, dctTransferData, vmInput.Arguments[0], dctTokenKey, mustVerifyPayable(vmInput, vmcommon.MinLenArgumentsDCTNFTTransfer), vmInput.ReturnCallAfterError)
 	if err != nil {
 		return nil, err
 	}
@@ -172,6 +184,7 @@ func (e *dctNFTTransfer) processNFTTransferOnSenderShard(
 	if e.shardCoordinator.ComputeId(dstAddress) == vmcommon.MetachainShardId {
 		return nil, ErrInvalidRcvAddr
 	}
+	traceGas(vmInput, gasComponentFunction, 1, e.funcGasCost)
 	if vmInput.GasProvided < e.funcGasCost {
 		return nil, ErrNotEnoughGas
 	}
@@ -181,7 +194,7 @@ func (e *dctNFTTransfer) processNFTTransferOnSenderShard(
 	if nonce == 0 {
 		return nil, ErrNFTDoesNotHaveMetadata
 	}
-	dctData, err := getDCTNFTTokenOnSender(acntSnd, dctTokenKey, nonce, e.marshalizer)
+	dctData, err := e.storageHandler.GetDCTNFTTokenOnSender(acntSnd, dctTokenKey, nonce)
 	if err != nil {
 		return nil, err
 	}
@@ -190,9 +203,17 @@ func (e *dctNFTTransfer) processNFTTransferOnSenderShard(
 	if dctData.Value.Cmp(quantityToTransfer) < 0 {
 		return nil, ErrInvalidNFTQuantity
 	}
+	err = checkLimitedTransfer(acntSnd, vmInput.Arguments[0], dctTokenKey, e.globalSettingsHandler, e.rolesHandler, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return nil, err
+	}
+	err = checkQuantityForTokenType(e.globalSettingsHandler, dctTokenKey, quantityToTransfer)
+	if err != nil {
+		return nil, err
+	}
 	dctData.Value.Sub(dctData.Value, quantityToTransfer)
 
-	_, err = saveDCTNFTToken(acntSnd, dctTokenKey, dctData, e.marshalizer, e.pauseHandler, vmInput.ReturnCallAfterError)
+	_, err = e.storageHandler.SaveDCTNFTToken(acntSnd, dctTokenKey, nonce, dctData, vmInput.ReturnCallAfterError)
 	if err != nil {
 		return nil, err
 	}
@@ -209,7 +230,7 @@ func (e *dctNFTTransfer) processNFTTransferOnSenderShard(
 			return nil, ErrWrongTypeAssertion
 		}
 
-		err = e.addNFTToDestination(dstAddress, userAccount, dctData, dctTokenKey, mustVerifyPayable(vmInput, vmcommon.MinLenArgumentsDCTNFTTransfer), vmInput.ReturnCallAfterError)
+		err = e.addNFTToDestination(dstAddress, userAccount, dctData, vmInput.Arguments[0], dctTokenKey, mustVerifyPayable(vmInput, vmcommon.MinLenArgumentsDCTNFTTransfer), vmInput.ReturnCallAfterError)
 		if err != nil {
 			return nil, err
 		}
@@ -248,6 +269,7 @@ func (e *dctNFTTransfer) createNFTOutputTransfers(
 		return err
 	}
 
+	traceGas(vmInput, gasComponentDataCopyPerByte, uint64(len(marshaledNFTTransfer)), e.gasConfig.DataCopyPerByte)
 	gasForTransfer := uint64(len(marshaledNFTTransfer)) * e.gasConfig.DataCopyPerByte
 	if gasForTransfer > vmOutput.GasRemaining {
 		return ErrNotEnoughGas
@@ -306,10 +328,16 @@ func (e *dctNFTTransfer) addNFTToDestination(
 	dstAddress []byte,
 	userAccount vmcommon.UserAccountHandler,
 	dctDataToTransfer *dct.DCToken,
+	tokenID []byte,
 	dctTokenKey []byte,
 	mustVerifyPayable bool,
 	isReturnWithError bool,
 ) error {
+	err := checkLimitedTransfer(userAccount, tokenID, dctTokenKey, e.globalSettingsHandler, e.rolesHandler, isReturnWithError)
+	if err != nil {
+		return err
+	}
+
 	if mustVerifyPayable {
 		isPayable, errIsPayable := e.payableHandler.IsPayable(dstAddress)
 		if errIsPayable != nil {
@@ -325,23 +353,22 @@ func (e *dctNFTTransfer) addNFTToDestination(
 		nonce = dctDataToTransfer.TokenMetaData.Nonce
 	}
 
-	currentDCTData, _, err := getDCTNFTTokenOnDestination(userAccount, dctTokenKey, nonce, e.marshalizer)
+	currentDCTData, _, err := e.storageHandler.GetDCTNFTTokenOnDestination(userAccount, dctTokenKey, nonce)
 	if err != nil && !errors.Is(err, ErrNFTTokenDoesNotExist) {
 		return err
 	}
-	err = checkFrozeAndPause(dstAddress, dctTokenKey, currentDCTData, e.pauseHandler, isReturnWithError)
+	err = checkFrozeAndPause(dstAddress, dctTokenKey, currentDCTData, e.globalSettingsHandler, isReturnWithError)
 	if err != nil {
 		return err
 	}
 
-	if currentDCTData.TokenMetaData != nil {
-		if !bytes.Equal(currentDCTData.TokenMetaData.Hash, dctDataToTransfer.TokenMetaData.Hash) {
-			return ErrWrongNFTOnDestination
-		}
+	err = e.storageHandler.CheckDCTNFTTokenOnDestination(currentDCTData, dctDataToTransfer)
+	if err != nil {
+		return err
 	}
 	dctDataToTransfer.Value.Add(dctDataToTransfer.Value, currentDCTData.Value)
 
-	_, err = saveDCTNFTToken(userAccount, dctTokenKey, dctDataToTransfer, e.marshalizer, e.pauseHandler, isReturnWithError)
+	_, err = e.storageHandler.SaveDCTNFTToken(userAccount, dctTokenKey, nonce, dctDataToTransfer, isReturnWithError)
 	if err != nil {
 		return err
 	}
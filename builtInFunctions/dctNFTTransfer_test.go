@@ -21,16 +21,18 @@ func createNftTransferWithStubArguments() *dctNFTTransfer {
 	nftTransfer, _ := NewDCTNFTTransferFunc(
 		0,
 		&mock.MarshalizerMock{},
-		&mock.PauseHandlerStub{},
+		&mock.GlobalSettingsHandlerStub{},
+		&mock.DCTRoleHandlerStub{},
 		&mock.AccountsStub{},
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
+		createNewDCTDataStorageHandler(),
 	)
 
 	return nftTransfer
 }
 
-func createNftTransferWithMockArguments(selfShard uint32, numShards uint32, pauseHandler vmcommon.DCTPauseHandler) *dctNFTTransfer {
+func createNftTransferWithMockArguments(selfShard uint32, numShards uint32, globalSettingsHandler vmcommon.DCTGlobalSettingsHandler) *dctNFTTransfer {
 	marshalizer := &mock.MarshalizerMock{}
 	shardCoordinator := mock.NewMultiShardsCoordinatorMock(numShards)
 	shardCoordinator.CurrentShard = selfShard
@@ -59,10 +61,12 @@ func createNftTransferWithMockArguments(selfShard uint32, numShards uint32, paus
 	nftTransfer, _ := NewDCTNFTTransferFunc(
 		1,
 		marshalizer,
-		pauseHandler,
+		globalSettingsHandler,
+		&mock.DCTRoleHandlerStub{},
 		accounts,
 		shardCoordinator,
 		vmcommon.BaseOperationCost{},
+		createNewDCTDataStorageHandlerWithArgs(globalSettingsHandler, createAccountsWithSystemAccount(), 1),
 	)
 
 	return nftTransfer
@@ -95,6 +99,16 @@ func createMockGasCost() vmcommon.GasCost {
 			DCTNFTUpdateAttributes:  200,
 			DCTNFTAddURI:            210,
 			DCTNFTMultiTransfer:     220,
+			DCTNFTSettleSale:        230,
+			DCTModifyRoyalties:      240,
+			DCTSetNewURIs:           250,
+			DCTNFTRecreate:          260,
+			DCTApprove:              270,
+			DCTTransferFrom:         280,
+			DCTLockedTransfer:       290,
+			DCTClaimUnlocked:        300,
+			DCTNFTCreateBatch:       310,
+			DCTMultiDistribute:      320,
 		},
 	}
 }
@@ -136,7 +150,14 @@ func testNFTTokenShouldExist(
 	expectedValue *big.Int,
 ) {
 	tokenId := append(keyPrefix, tokenName...)
-	dctData, err := getDCTNFTTokenOnSender(account.(vmcommon.UserAccountHandler), tokenId, nonce, marshalizer)
+	dataStorage, _ := NewDCTDataStorage(ArgsNewDCTDataStorage{
+		Accounts:                createAccountsWithSystemAccount(),
+		GlobalSettingsHandler:   &mock.GlobalSettingsHandlerStub{},
+		Marshalizer:             marshalizer,
+		SaveToSystemEnableEpoch: 1,
+		EpochNotifier:           &mock.EpochNotifierStub{},
+	})
+	dctData, err := dataStorage.GetDCTNFTTokenOnSender(account.(vmcommon.UserAccountHandler), tokenId, nonce)
 	require.Nil(tb, err)
 	assert.Equal(tb, expectedValue, dctData.Value)
 }
@@ -147,10 +168,12 @@ func TestNewDCTNFTTransferFunc_NilArgumentsShouldErr(t *testing.T) {
 	nftTransfer, err := NewDCTNFTTransferFunc(
 		0,
 		nil,
-		&mock.PauseHandlerStub{},
+		&mock.GlobalSettingsHandlerStub{},
+		&mock.DCTRoleHandlerStub{},
 		&mock.AccountsStub{},
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
+		createNewDCTDataStorageHandler(),
 	)
 	assert.True(t, check.IfNil(nftTransfer))
 	assert.Equal(t, ErrNilMarshalizer, err)
@@ -159,20 +182,37 @@ func TestNewDCTNFTTransferFunc_NilArgumentsShouldErr(t *testing.T) {
 		0,
 		&mock.MarshalizerMock{},
 		nil,
+		&mock.DCTRoleHandlerStub{},
 		&mock.AccountsStub{},
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
+		createNewDCTDataStorageHandler(),
 	)
 	assert.True(t, check.IfNil(nftTransfer))
-	assert.Equal(t, ErrNilPauseHandler, err)
+	assert.Equal(t, ErrNilGlobalSettingsHandler, err)
 
 	nftTransfer, err = NewDCTNFTTransferFunc(
 		0,
 		&mock.MarshalizerMock{},
-		&mock.PauseHandlerStub{},
+		&mock.GlobalSettingsHandlerStub{},
 		nil,
+		&mock.AccountsStub{},
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
+		createNewDCTDataStorageHandler(),
+	)
+	assert.True(t, check.IfNil(nftTransfer))
+	assert.Equal(t, ErrNilRolesHandler, err)
+
+	nftTransfer, err = NewDCTNFTTransferFunc(
+		0,
+		&mock.MarshalizerMock{},
+		&mock.GlobalSettingsHandlerStub{},
+		&mock.DCTRoleHandlerStub{},
+		nil,
+		&mock.ShardCoordinatorStub{},
+		vmcommon.BaseOperationCost{},
+		createNewDCTDataStorageHandler(),
 	)
 	assert.True(t, check.IfNil(nftTransfer))
 	assert.Equal(t, ErrNilAccountsAdapter, err)
@@ -180,10 +220,12 @@ func TestNewDCTNFTTransferFunc_NilArgumentsShouldErr(t *testing.T) {
 	nftTransfer, err = NewDCTNFTTransferFunc(
 		0,
 		&mock.MarshalizerMock{},
-		&mock.PauseHandlerStub{},
+		&mock.GlobalSettingsHandlerStub{},
+		&mock.DCTRoleHandlerStub{},
 		&mock.AccountsStub{},
 		nil,
 		vmcommon.BaseOperationCost{},
+		createNewDCTDataStorageHandler(),
 	)
 	assert.True(t, check.IfNil(nftTransfer))
 	assert.Equal(t, ErrNilShardCoordinator, err)
@@ -195,10 +237,12 @@ func TestNewDCTNFTTransferFunc(t *testing.T) {
 	nftTransfer, err := NewDCTNFTTransferFunc(
 		0,
 		&mock.MarshalizerMock{},
-		&mock.PauseHandlerStub{},
+		&mock.GlobalSettingsHandlerStub{},
+		&mock.DCTRoleHandlerStub{},
 		&mock.AccountsStub{},
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
+		createNewDCTDataStorageHandler(),
 	)
 	assert.False(t, check.IfNil(nftTransfer))
 	assert.Nil(t, err)
@@ -272,7 +316,7 @@ func TestDctNFTTransfer_ProcessBuiltinFunctionInvalidArgumentsShouldErr(t *testi
 func TestDctNFTTransfer_ProcessBuiltinFunctionOnSameShardWithScCall(t *testing.T) {
 	t.Parallel()
 
-	nftTransfer := createNftTransferWithMockArguments(0, 1, &mock.PauseHandlerStub{})
+	nftTransfer := createNftTransferWithMockArguments(0, 1, &mock.GlobalSettingsHandlerStub{})
 	_ = nftTransfer.SetPayableHandler(
 		&mock.PayableHandlerStub{
 			IsPayableCalled: func(address []byte) (bool, error) {
@@ -348,10 +392,10 @@ func TestDctNFTTransfer_ProcessBuiltinFunctionOnCrossShardsDestinationDoesNotHol
 		},
 	}
 
-	nftTransferSenderShard := createNftTransferWithMockArguments(1, 2, &mock.PauseHandlerStub{})
+	nftTransferSenderShard := createNftTransferWithMockArguments(1, 2, &mock.GlobalSettingsHandlerStub{})
 	_ = nftTransferSenderShard.SetPayableHandler(payableHandler)
 
-	nftTransferDestinationShard := createNftTransferWithMockArguments(0, 2, &mock.PauseHandlerStub{})
+	nftTransferDestinationShard := createNftTransferWithMockArguments(0, 2, &mock.GlobalSettingsHandlerStub{})
 	_ = nftTransferDestinationShard.SetPayableHandler(payableHandler)
 
 	senderAddress := bytes.Repeat([]byte{1}, 32)
@@ -440,10 +484,10 @@ func TestDctNFTTransfer_ProcessBuiltinFunctionOnCrossShardsDestinationHoldsNFT(t
 		},
 	}
 
-	nftTransferSenderShard := createNftTransferWithMockArguments(0, 2, &mock.PauseHandlerStub{})
+	nftTransferSenderShard := createNftTransferWithMockArguments(0, 2, &mock.GlobalSettingsHandlerStub{})
 	_ = nftTransferSenderShard.SetPayableHandler(payableHandler)
 
-	nftTransferDestinationShard := createNftTransferWithMockArguments(1, 2, &mock.PauseHandlerStub{})
+	nftTransferDestinationShard := createNftTransferWithMockArguments(1, 2, &mock.GlobalSettingsHandlerStub{})
 	_ = nftTransferDestinationShard.SetPayableHandler(payableHandler)
 
 	senderAddress := bytes.Repeat([]byte{2}, 32) // sender is in the same shard
@@ -525,7 +569,7 @@ func TestDctNFTTransfer_ProcessBuiltinFunctionOnCrossShardsDestinationHoldsNFT(t
 func TestDCTNFTTransfer_SndDstFrozen(t *testing.T) {
 	t.Parallel()
 
-	transferFunc := createNftTransferWithMockArguments(0, 1, &mock.PauseHandlerStub{})
+	transferFunc := createNftTransferWithMockArguments(0, 1, &mock.GlobalSettingsHandlerStub{})
 	_ = transferFunc.SetPayableHandler(&mock.PayableHandlerStub{})
 
 	senderAddress := bytes.Repeat([]byte{2}, 32) // sender is in the same shard
@@ -579,7 +623,7 @@ func TestDCTNFTTransfer_SndDstFrozen(t *testing.T) {
 func TestDCTNFTTransfer_NotEnoughGas(t *testing.T) {
 	t.Parallel()
 
-	transferFunc := createNftTransferWithMockArguments(0, 1, &mock.PauseHandlerStub{})
+	transferFunc := createNftTransferWithMockArguments(0, 1, &mock.GlobalSettingsHandlerStub{})
 	_ = transferFunc.SetPayableHandler(&mock.PayableHandlerStub{})
 
 	senderAddress := bytes.Repeat([]byte{2}, 32) // sender is in the same shard
@@ -614,6 +658,100 @@ func TestDCTNFTTransfer_NotEnoughGas(t *testing.T) {
 	assert.Equal(t, err, ErrNotEnoughGas)
 }
 
+func TestDCTNFTTransfer_NonFungibleQuantityShouldErr(t *testing.T) {
+	t.Parallel()
+
+	transferFunc := createNftTransferWithMockArguments(0, 1, &mock.GlobalSettingsHandlerStub{
+		GetTokenTypeCalled: func(_ []byte) uint32 {
+			return uint32(vmcommon.NonFungible)
+		},
+	})
+	_ = transferFunc.SetPayableHandler(&mock.PayableHandlerStub{})
+
+	senderAddress := bytes.Repeat([]byte{2}, 32) // sender is in the same shard
+	destinationAddress := bytes.Repeat([]byte{1}, 32)
+	sender, err := transferFunc.accounts.LoadAccount(senderAddress)
+	require.Nil(t, err)
+
+	tokenName := []byte("token")
+	tokenNonce := uint64(1)
+
+	initialTokens := big.NewInt(3)
+	createDCTNFTToken(tokenName, vmcommon.NonFungible, tokenNonce, initialTokens, transferFunc.marshalizer, sender.(vmcommon.UserAccountHandler))
+	_ = transferFunc.accounts.SaveAccount(sender)
+	_, _ = transferFunc.accounts.Commit()
+	//reload sender account
+	sender, err = transferFunc.accounts.LoadAccount(senderAddress)
+	require.Nil(t, err)
+
+	nonceBytes := big.NewInt(int64(tokenNonce)).Bytes()
+	quantityBytes := big.NewInt(2).Bytes()
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			CallerAddr:  senderAddress,
+			Arguments:   [][]byte{tokenName, nonceBytes, quantityBytes, destinationAddress},
+			GasProvided: 1,
+		},
+		RecipientAddr: senderAddress,
+	}
+
+	_, err = transferFunc.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), nil, vmInput)
+	assert.Equal(t, ErrInvalidNonFungibleQuantity, err)
+	testNFTTokenShouldExist(t, transferFunc.marshalizer, sender, tokenName, tokenNonce, initialTokens)
+}
+
+func TestDCTNFTTransfer_LimitedTransferWithoutRoleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	transferFunc := createNftTransferWithMockArguments(0, 1, &mock.GlobalSettingsHandlerStub{
+		IsLimitedTransferCalled: func(_ []byte) bool {
+			return true
+		},
+	})
+	_ = transferFunc.SetPayableHandler(&mock.PayableHandlerStub{})
+
+	senderAddress := bytes.Repeat([]byte{2}, 32) // sender is in the same shard
+	destinationAddress := bytes.Repeat([]byte{1}, 32)
+	destinationAddress[31] = 0
+	transferFunc.rolesHandler = &mock.DCTRoleHandlerStub{
+		CheckAllowedToExecuteCalled: func(account vmcommon.UserAccountHandler, _ []byte, _ []byte) error {
+			if bytes.Equal(account.AddressBytes(), senderAddress) {
+				return nil
+			}
+			return ErrActionNotAllowed
+		},
+	}
+	sender, err := transferFunc.accounts.LoadAccount(senderAddress)
+	require.Nil(t, err)
+
+	tokenName := []byte("token")
+	tokenNonce := uint64(1)
+
+	initialTokens := big.NewInt(3)
+	createDCTNFTToken(tokenName, vmcommon.SemiFungible, tokenNonce, initialTokens, transferFunc.marshalizer, sender.(vmcommon.UserAccountHandler))
+	_ = transferFunc.accounts.SaveAccount(sender)
+	_, _ = transferFunc.accounts.Commit()
+	//reload sender account
+	sender, err = transferFunc.accounts.LoadAccount(senderAddress)
+	require.Nil(t, err)
+
+	nonceBytes := big.NewInt(int64(tokenNonce)).Bytes()
+	quantityBytes := big.NewInt(1).Bytes()
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			CallerAddr:  senderAddress,
+			Arguments:   [][]byte{tokenName, nonceBytes, quantityBytes, destinationAddress},
+			GasProvided: 1,
+		},
+		RecipientAddr: senderAddress,
+	}
+
+	_, err = transferFunc.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), nil, vmInput)
+	assert.Equal(t, ErrDCTTransferIsLimited, err)
+}
+
 func extractScResultsFromVmOutput(t testing.TB, vmOutput *vmcommon.VMOutput) (string, [][]byte) {
 	require.NotNil(t, vmOutput)
 	require.Equal(t, 1, len(vmOutput.OutputAccounts))
@@ -1,73 +0,0 @@
-package builtInFunctions
-
-import (
-	"math/big"
-	"testing"
-
-	"github.com/Dharitri-org/me-vm-common"
-	"github.com/Dharitri-org/me-vm-common/mock"
-	"github.com/stretchr/testify/assert"
-)
-
-func TestDCTPause_ProcessBuiltInFunction(t *testing.T) {
-	t.Parallel()
-
-	acnt := mock.NewUserAccount(vmcommon.SystemAccountAddress)
-	pauseFunc, _ := NewDCTPauseFunc(&mock.AccountsStub{
-		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
-			return acnt, nil
-		},
-	}, true)
-	_, err := pauseFunc.ProcessBuiltinFunction(nil, nil, nil)
-	assert.Equal(t, err, ErrNilVmInput)
-
-	input := &vmcommon.ContractCallInput{
-		VMInput: vmcommon.VMInput{
-			CallValue: big.NewInt(0),
-		},
-	}
-	_, err = pauseFunc.ProcessBuiltinFunction(nil, nil, input)
-	assert.Equal(t, err, ErrInvalidArguments)
-
-	input = &vmcommon.ContractCallInput{
-		VMInput: vmcommon.VMInput{
-			GasProvided: 50,
-			CallValue:   big.NewInt(1),
-		},
-	}
-	_, err = pauseFunc.ProcessBuiltinFunction(nil, nil, input)
-	assert.Equal(t, err, ErrBuiltInFunctionCalledWithValue)
-
-	input.CallValue = big.NewInt(0)
-	key := []byte("key")
-	value := []byte("value")
-	input.Arguments = [][]byte{key, value}
-	_, err = pauseFunc.ProcessBuiltinFunction(nil, nil, input)
-	assert.Equal(t, err, ErrInvalidArguments)
-
-	input.Arguments = [][]byte{key}
-	_, err = pauseFunc.ProcessBuiltinFunction(nil, nil, input)
-	assert.Equal(t, err, ErrAddressIsNotDCTSystemSC)
-
-	input.CallerAddr = vmcommon.DCTSCAddress
-	_, err = pauseFunc.ProcessBuiltinFunction(nil, nil, input)
-	assert.Equal(t, err, ErrOnlySystemAccountAccepted)
-
-	input.RecipientAddr = vmcommon.SystemAccountAddress
-	_, err = pauseFunc.ProcessBuiltinFunction(nil, nil, input)
-	assert.Nil(t, err)
-
-	pauseKey := []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier + string(key))
-	assert.True(t, pauseFunc.IsPaused(pauseKey))
-
-	dctPauseFalse, _ := NewDCTPauseFunc(&mock.AccountsStub{
-		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
-			return acnt, nil
-		},
-	}, false)
-
-	_, err = dctPauseFalse.ProcessBuiltinFunction(nil, nil, input)
-	assert.Nil(t, err)
-
-	assert.False(t, pauseFunc.IsPaused(pauseKey))
-}
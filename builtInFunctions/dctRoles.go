@@ -2,8 +2,11 @@ package builtInFunctions
 
 import (
 	"bytes"
+	"fmt"
+	"strings"
 
 	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/atomic"
 	"github.com/Dharitri-org/me-vm-common/check"
 	"github.com/Dharitri-org/me-vm-common/data/dct"
 )
@@ -12,27 +15,45 @@ var roleKeyPrefix = []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTRol
 
 type dctRoles struct {
 	baseAlwaysActive
-	set         bool
-	marshalizer vmcommon.Marshalizer
+	set                   bool
+	marshalizer           vmcommon.Marshalizer
+	rolesCheckEnableEpoch uint32
+	flagRolesCheck        atomic.Flag
 }
 
-// NewDCTRolesFunc returns the dct change roles built-in function component
+// NewDCTRolesFunc returns the dct change roles built-in function component. Starting with the roles check enable
+// epoch the set roles are validated and the already held ones are not added again
 func NewDCTRolesFunc(
 	marshalizer vmcommon.Marshalizer,
 	set bool,
+	rolesCheckEnableEpoch uint32,
+	epochNotifier vmcommon.EpochNotifier,
 ) (*dctRoles, error) {
 	if check.IfNil(marshalizer) {
 		return nil, ErrNilMarshalizer
 	}
+	if check.IfNil(epochNotifier) {
+		return nil, ErrNilEpochHandler
+	}
 
 	e := &dctRoles{
-		set:         set,
-		marshalizer: marshalizer,
+		set:                   set,
+		marshalizer:           marshalizer,
+		rolesCheckEnableEpoch: rolesCheckEnableEpoch,
+		flagRolesCheck:        atomic.Flag{},
 	}
 
+	epochNotifier.RegisterNotifyHandler(e)
+
 	return e, nil
 }
 
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (e *dctRoles) EpochConfirmed(epoch uint32, _ uint64) {
+	e.flagRolesCheck.Toggle(epoch >= e.rolesCheckEnableEpoch)
+	log.Debug("dct roles check", "enabled", e.flagRolesCheck.IsSet())
+}
+
 // SetNewGasConfig is called whenever gas cost is changed
 func (e *dctRoles) SetNewGasConfig(_ *vmcommon.GasCost) {
 }
@@ -60,8 +81,13 @@ func (e *dctRoles) ProcessBuiltinFunction(
 		return nil, err
 	}
 
-	if e.set {
+	if e.set && !e.flagRolesCheck.IsSet() {
 		roles.Roles = append(roles.Roles, vmInput.Arguments[1:]...)
+	} else if e.set {
+		err = addRoles(roles, vmInput.Arguments[1:])
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		deleteRoles(roles, vmInput.Arguments[1:])
 	}
@@ -71,10 +97,42 @@ func (e *dctRoles) ProcessBuiltinFunction(
 		return nil, err
 	}
 
-	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
+	logEntry := &vmcommon.LogEntry{
+		Identifier: []byte(e.function()),
+		Address:    acntDst.AddressBytes(),
+		Topics:     append([][]byte{vmInput.Arguments[0]}, vmInput.Arguments[1:]...),
+	}
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, Logs: []*vmcommon.LogEntry{logEntry}}
 	return vmOutput, nil
 }
 
+func (e *dctRoles) function() string {
+	if e.set {
+		return vmcommon.BuiltInFunctionSetDCTRole
+	}
+	return vmcommon.BuiltInFunctionUnSetDCTRole
+}
+
+// addRoles adds the roles which are not already held, so that setting a role twice does not change the stored roles
+func addRoles(roles *dct.DCTRoles, newRoles [][]byte) error {
+	for _, newRole := range newRoles {
+		if !vmcommon.IsValidDCTRole(string(newRole)) {
+			return fmt.Errorf("%w: %s", ErrInvalidRole, newRole)
+		}
+	}
+
+	for _, newRole := range newRoles {
+		_, exist := doesRoleExist(roles, newRole)
+		if exist {
+			continue
+		}
+
+		roles.Roles = append(roles.Roles, newRole)
+	}
+
+	return nil
+}
+
 func deleteRoles(roles *dct.DCTRoles, deleteRoles [][]byte) {
 	for _, deleteRole := range deleteRoles {
 		index, exist := doesRoleExist(roles, deleteRole)
@@ -143,6 +201,45 @@ func (e *dctRoles) CheckAllowedToExecute(account vmcommon.UserAccountHandler, to
 	return ErrActionNotAllowed
 }
 
+// GetRoles returns the roles the account holds for the given token
+func (e *dctRoles) GetRoles(account vmcommon.UserAccountHandler, tokenID []byte) ([][]byte, error) {
+	if check.IfNil(account) {
+		return nil, ErrNilUserAccount
+	}
+
+	dctTokenRoleKey := append(roleKeyPrefix, tokenID...)
+	roles, _, err := getDCTRolesForAcnt(e.marshalizer, account, dctTokenRoleKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return roles.Roles, nil
+}
+
+// GetAllRoles returns the roles held for each token, read from the full state of an account as it is returned by
+// the accounts adapter GetAllState function. Tokens without roles are not part of the result
+func (e *dctRoles) GetAllRoles(accountState map[string][]byte) (map[string][][]byte, error) {
+	allRoles := make(map[string][][]byte)
+	for key, marshaledData := range accountState {
+		if !strings.HasPrefix(key, string(roleKeyPrefix)) || len(marshaledData) == 0 {
+			continue
+		}
+
+		roles := &dct.DCTRoles{}
+		err := e.marshalizer.Unmarshal(roles, marshaledData)
+		if err != nil {
+			return nil, err
+		}
+		if len(roles.Roles) == 0 {
+			continue
+		}
+
+		allRoles[key[len(roleKeyPrefix):]] = roles.Roles
+	}
+
+	return allRoles, nil
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *dctRoles) IsInterfaceNil() bool {
 	return e == nil
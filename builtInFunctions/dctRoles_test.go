@@ -14,16 +14,25 @@ import (
 func TestNewDCTRolesFunc_NilMarshalizerShouldErr(t *testing.T) {
 	t.Parallel()
 
-	dctRolesF, err := NewDCTRolesFunc(nil, false)
+	dctRolesF, err := NewDCTRolesFunc(nil, false, 0, &mock.EpochNotifierStub{})
 
 	require.Equal(t, ErrNilMarshalizer, err)
 	require.Nil(t, dctRolesF)
 }
 
+func TestNewDCTRolesFunc_NilEpochNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dctRolesF, err := NewDCTRolesFunc(&mock.MarshalizerMock{}, false, 0, nil)
+
+	require.Equal(t, ErrNilEpochHandler, err)
+	require.Nil(t, dctRolesF)
+}
+
 func TestDctRoles_ProcessBuiltinFunction_NilVMInputShouldErr(t *testing.T) {
 	t.Parallel()
 
-	dctRolesF, _ := NewDCTRolesFunc(nil, false)
+	dctRolesF, _ := NewDCTRolesFunc(nil, false, 0, &mock.EpochNotifierStub{})
 
 	_, err := dctRolesF.ProcessBuiltinFunction(nil, &mock.UserAccountStub{}, nil)
 	require.Equal(t, ErrNilVmInput, err)
@@ -32,7 +41,7 @@ func TestDctRoles_ProcessBuiltinFunction_NilVMInputShouldErr(t *testing.T) {
 func TestDctRoles_ProcessBuiltinFunction_WrongCalledShouldErr(t *testing.T) {
 	t.Parallel()
 
-	dctRolesF, _ := NewDCTRolesFunc(nil, false)
+	dctRolesF, _ := NewDCTRolesFunc(nil, false, 0, &mock.EpochNotifierStub{})
 
 	_, err := dctRolesF.ProcessBuiltinFunction(nil, &mock.UserAccountStub{}, &vmcommon.ContractCallInput{
 		VMInput: vmcommon.VMInput{
@@ -47,7 +56,7 @@ func TestDctRoles_ProcessBuiltinFunction_WrongCalledShouldErr(t *testing.T) {
 func TestDctRoles_ProcessBuiltinFunction_NilAccountDestShouldErr(t *testing.T) {
 	t.Parallel()
 
-	dctRolesF, _ := NewDCTRolesFunc(nil, false)
+	dctRolesF, _ := NewDCTRolesFunc(nil, false, 0, &mock.EpochNotifierStub{})
 
 	_, err := dctRolesF.ProcessBuiltinFunction(nil, nil, &vmcommon.ContractCallInput{
 		VMInput: vmcommon.VMInput{
@@ -62,7 +71,7 @@ func TestDctRoles_ProcessBuiltinFunction_NilAccountDestShouldErr(t *testing.T) {
 func TestDctRoles_ProcessBuiltinFunction_GetRolesFailShouldErr(t *testing.T) {
 	t.Parallel()
 
-	dctRolesF, _ := NewDCTRolesFunc(&mock.MarshalizerMock{Fail: true}, false)
+	dctRolesF, _ := NewDCTRolesFunc(&mock.MarshalizerMock{Fail: true}, false, 0, &mock.EpochNotifierStub{})
 
 	_, err := dctRolesF.ProcessBuiltinFunction(nil, &mock.UserAccountStub{
 		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
@@ -86,7 +95,7 @@ func TestDctRoles_ProcessBuiltinFunction_GetRolesFailShouldWorkEvenIfAccntTrieIs
 	t.Parallel()
 
 	saveKeyWasCalled := false
-	dctRolesF, _ := NewDCTRolesFunc(&mock.MarshalizerMock{}, false)
+	dctRolesF, _ := NewDCTRolesFunc(&mock.MarshalizerMock{}, false, 0, &mock.EpochNotifierStub{})
 
 	_, err := dctRolesF.ProcessBuiltinFunction(nil, &mock.UserAccountStub{
 		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
@@ -115,7 +124,7 @@ func TestDctRoles_ProcessBuiltinFunction_SetRolesShouldWork(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	dctRolesF, _ := NewDCTRolesFunc(marshalizer, true)
+	dctRolesF, _ := NewDCTRolesFunc(marshalizer, true, 0, &mock.EpochNotifierStub{})
 
 	acc := &mock.UserAccountStub{
 		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
@@ -147,7 +156,7 @@ func TestDctRoles_ProcessBuiltinFunction_SaveFailedShouldErr(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	dctRolesF, _ := NewDCTRolesFunc(marshalizer, true)
+	dctRolesF, _ := NewDCTRolesFunc(marshalizer, true, 0, &mock.EpochNotifierStub{})
 
 	localErr := errors.New("local err")
 	acc := &mock.UserAccountStub{
@@ -177,7 +186,7 @@ func TestDctRoles_ProcessBuiltinFunction_UnsetRolesDoesNotExistsShouldWork(t *te
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	dctRolesF, _ := NewDCTRolesFunc(marshalizer, false)
+	dctRolesF, _ := NewDCTRolesFunc(marshalizer, false, 0, &mock.EpochNotifierStub{})
 
 	acc := &mock.UserAccountStub{
 		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
@@ -209,7 +218,7 @@ func TestDctRoles_ProcessBuiltinFunction_UnsetRolesShouldWork(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	dctRolesF, _ := NewDCTRolesFunc(marshalizer, false)
+	dctRolesF, _ := NewDCTRolesFunc(marshalizer, false, 0, &mock.EpochNotifierStub{})
 
 	acc := &mock.UserAccountStub{
 		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
@@ -243,7 +252,7 @@ func TestDctRoles_CheckAllowedToExecuteNilAccountShouldErr(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	dctRolesF, _ := NewDCTRolesFunc(marshalizer, false)
+	dctRolesF, _ := NewDCTRolesFunc(marshalizer, false, 0, &mock.EpochNotifierStub{})
 
 	err := dctRolesF.CheckAllowedToExecute(nil, []byte("ID"), []byte(vmcommon.DCTRoleLocalBurn))
 	require.Equal(t, ErrNilUserAccount, err)
@@ -253,7 +262,7 @@ func TestDctRoles_CheckAllowedToExecuteCannotGetDCTRole(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{Fail: true}
-	dctRolesF, _ := NewDCTRolesFunc(marshalizer, false)
+	dctRolesF, _ := NewDCTRolesFunc(marshalizer, false, 0, &mock.EpochNotifierStub{})
 
 	err := dctRolesF.CheckAllowedToExecute(&mock.UserAccountStub{
 		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
@@ -271,7 +280,7 @@ func TestDctRoles_CheckAllowedToExecuteIsNewNotAllowed(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	dctRolesF, _ := NewDCTRolesFunc(marshalizer, false)
+	dctRolesF, _ := NewDCTRolesFunc(marshalizer, false, 0, &mock.EpochNotifierStub{})
 
 	err := dctRolesF.CheckAllowedToExecute(&mock.UserAccountStub{
 		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
@@ -289,7 +298,7 @@ func TestDctRoles_CheckAllowed_ShouldWork(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	dctRolesF, _ := NewDCTRolesFunc(marshalizer, false)
+	dctRolesF, _ := NewDCTRolesFunc(marshalizer, false, 0, &mock.EpochNotifierStub{})
 
 	err := dctRolesF.CheckAllowedToExecute(&mock.UserAccountStub{
 		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
@@ -310,7 +319,7 @@ func TestDctRoles_CheckAllowedToExecuteRoleNotFind(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	dctRolesF, _ := NewDCTRolesFunc(marshalizer, false)
+	dctRolesF, _ := NewDCTRolesFunc(marshalizer, false, 0, &mock.EpochNotifierStub{})
 
 	err := dctRolesF.CheckAllowedToExecute(&mock.UserAccountStub{
 		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
@@ -326,3 +335,138 @@ func TestDctRoles_CheckAllowedToExecuteRoleNotFind(t *testing.T) {
 	}, []byte("ID"), []byte(vmcommon.DCTRoleLocalMint))
 	require.Equal(t, ErrActionNotAllowed, err)
 }
+
+func TestDctRoles_ProcessBuiltinFunction_SetRolesTwiceShouldNotDuplicate(t *testing.T) {
+	t.Parallel()
+
+	dctRolesF, _ := NewDCTRolesFunc(&mock.MarshalizerMock{}, true, 0, &mock.EpochNotifierStub{})
+	acnt := mock.NewUserAccount([]byte("addr"))
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: vmcommon.DCTSCAddress,
+			Arguments:  [][]byte{[]byte("ID"), []byte(vmcommon.DCTRoleLocalMint), []byte(vmcommon.DCTRoleLocalMint)},
+		},
+	}
+
+	vmOutput, err := dctRolesF.ProcessBuiltinFunction(nil, acnt, input)
+	require.Nil(t, err)
+	require.Len(t, vmOutput.Logs, 1)
+	require.Equal(t, []byte(vmcommon.BuiltInFunctionSetDCTRole), vmOutput.Logs[0].Identifier)
+	require.Equal(t, acnt.AddressBytes(), vmOutput.Logs[0].Address)
+	require.Equal(t, input.Arguments, vmOutput.Logs[0].Topics)
+
+	input.Arguments = [][]byte{[]byte("ID"), []byte(vmcommon.DCTRoleLocalMint), []byte(vmcommon.DCTRoleLocalBurn)}
+	_, err = dctRolesF.ProcessBuiltinFunction(nil, acnt, input)
+	require.Nil(t, err)
+
+	roles, err := dctRolesF.GetRoles(acnt, []byte("ID"))
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{[]byte(vmcommon.DCTRoleLocalMint), []byte(vmcommon.DCTRoleLocalBurn)}, roles)
+}
+
+func TestDctRoles_ProcessBuiltinFunction_SetInvalidRoleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dctRolesF, _ := NewDCTRolesFunc(&mock.MarshalizerMock{}, true, 0, &mock.EpochNotifierStub{})
+	acnt := mock.NewUserAccount([]byte("addr"))
+	vmOutput, err := dctRolesF.ProcessBuiltinFunction(nil, acnt, &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: vmcommon.DCTSCAddress,
+			Arguments:  [][]byte{[]byte("ID"), []byte(vmcommon.DCTRoleLocalMint), []byte("DCTRoleInvalid")},
+		},
+	})
+	require.Nil(t, vmOutput)
+	require.True(t, errors.Is(err, ErrInvalidRole))
+
+	roles, err := dctRolesF.GetRoles(acnt, []byte("ID"))
+	require.Nil(t, err)
+	require.Len(t, roles, 0)
+}
+
+func TestDctRoles_ProcessBuiltinFunction_SetRolesBeforeRolesCheckEpochShouldNotValidate(t *testing.T) {
+	t.Parallel()
+
+	dctRolesF, _ := NewDCTRolesFunc(&mock.MarshalizerMock{}, true, 1, &mock.EpochNotifierStub{})
+	acnt := mock.NewUserAccount([]byte("addr"))
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: vmcommon.DCTSCAddress,
+			Arguments:  [][]byte{[]byte("ID"), []byte(vmcommon.DCTRoleLocalMint), []byte("DCTRoleInvalid")},
+		},
+	}
+
+	_, err := dctRolesF.ProcessBuiltinFunction(nil, acnt, input)
+	require.Nil(t, err)
+	roles, _ := dctRolesF.GetRoles(acnt, []byte("ID"))
+	require.Equal(t, [][]byte{[]byte(vmcommon.DCTRoleLocalMint), []byte("DCTRoleInvalid")}, roles)
+
+	dctRolesF.EpochConfirmed(1, 0)
+	_, err = dctRolesF.ProcessBuiltinFunction(nil, acnt, input)
+	require.True(t, errors.Is(err, ErrInvalidRole))
+}
+
+func TestDctRoles_ProcessBuiltinFunction_UnsetRolesShouldLog(t *testing.T) {
+	t.Parallel()
+
+	dctRolesF, _ := NewDCTRolesFunc(&mock.MarshalizerMock{}, false, 0, &mock.EpochNotifierStub{})
+	acnt := mock.NewUserAccount([]byte("addr"))
+	arguments := [][]byte{[]byte("ID"), []byte(vmcommon.DCTRoleLocalMint)}
+	vmOutput, err := dctRolesF.ProcessBuiltinFunction(nil, acnt, &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: vmcommon.DCTSCAddress,
+			Arguments:  arguments,
+		},
+	})
+	require.Nil(t, err)
+	require.Len(t, vmOutput.Logs, 1)
+	require.Equal(t, []byte(vmcommon.BuiltInFunctionUnSetDCTRole), vmOutput.Logs[0].Identifier)
+	require.Equal(t, acnt.AddressBytes(), vmOutput.Logs[0].Address)
+	require.Equal(t, arguments, vmOutput.Logs[0].Topics)
+}
+
+func TestDctRoles_GetRoles(t *testing.T) {
+	t.Parallel()
+
+	dctRolesF, _ := NewDCTRolesFunc(&mock.MarshalizerMock{}, true, 0, &mock.EpochNotifierStub{})
+	_, err := dctRolesF.GetRoles(nil, []byte("ID"))
+	require.Equal(t, ErrNilUserAccount, err)
+
+	acnt := mock.NewUserAccount([]byte("addr"))
+	roles, err := dctRolesF.GetRoles(acnt, []byte("ID"))
+	require.Nil(t, err)
+	require.Len(t, roles, 0)
+}
+
+func TestDctRoles_GetAllRoles(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	dctRolesF, _ := NewDCTRolesFunc(marshalizer, true, 0, &mock.EpochNotifierStub{})
+
+	rolesID1, _ := marshalizer.Marshal(&dct.DCTRoles{Roles: [][]byte{[]byte(vmcommon.DCTRoleLocalMint)}})
+	rolesID2, _ := marshalizer.Marshal(&dct.DCTRoles{Roles: [][]byte{[]byte(vmcommon.DCTRoleNFTCreate), []byte(vmcommon.DCTRoleNFTBurn)}})
+	emptyRoles, _ := marshalizer.Marshal(&dct.DCTRoles{})
+	accountState := map[string][]byte{
+		string(roleKeyPrefix) + "ID1":                     rolesID1,
+		string(roleKeyPrefix) + "ID2":                     rolesID2,
+		string(roleKeyPrefix) + "ID3":                     emptyRoles,
+		string(keyPrefix) + "ID1":                         []byte("balance"),
+		vmcommon.DharitriProtectedKeyPrefix + "something": []byte("value"),
+	}
+
+	allRoles, err := dctRolesF.GetAllRoles(accountState)
+	require.Nil(t, err)
+	require.Equal(t, map[string][][]byte{
+		"ID1": {[]byte(vmcommon.DCTRoleLocalMint)},
+		"ID2": {[]byte(vmcommon.DCTRoleNFTCreate), []byte(vmcommon.DCTRoleNFTBurn)},
+	}, allRoles)
+
+	accountState[string(roleKeyPrefix)+"ID4"] = []byte("invalid")
+	allRoles, err = dctRolesF.GetAllRoles(accountState)
+	require.NotNil(t, err)
+	require.Nil(t, allRoles)
+}
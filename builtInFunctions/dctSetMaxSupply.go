@@ -0,0 +1,116 @@
+package builtInFunctions
+
+import (
+	"bytes"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/atomic"
+	"github.com/Dharitri-org/me-vm-common/check"
+)
+
+type dctSetMaxSupply struct {
+	*baseEnabled
+	accounts vmcommon.AccountsAdapter
+}
+
+// NewDCTSetMaxSupplyFunc returns the dct set max supply built-in function component. The max supply marks the token as
+// capped, the mints being then limited by the mint allowance the dct system smart contract grants to every shard
+func NewDCTSetMaxSupplyFunc(
+	accounts vmcommon.AccountsAdapter,
+	activationEpoch uint32,
+	epochNotifier vmcommon.EpochNotifier,
+) (*dctSetMaxSupply, error) {
+	if check.IfNil(accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, ErrNilEpochHandler
+	}
+
+	e := &dctSetMaxSupply{
+		accounts: accounts,
+	}
+
+	e.baseEnabled = &baseEnabled{
+		function:        vmcommon.BuiltInFunctionDCTSetMaxSupply,
+		activationEpoch: activationEpoch,
+		flagActivated:   atomic.Flag{},
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *dctSetMaxSupply) SetNewGasConfig(_ *vmcommon.GasCost) {
+}
+
+// ProcessBuiltinFunction resolves DCT set max supply function call
+// Requires 2 arguments:
+// arg0 - token identifier
+// arg1 - max supply, an empty or zero value removes the max supply
+func (e *dctSetMaxSupply) ProcessBuiltinFunction(
+	_, _ vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if vmInput == nil {
+		return nil, ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) != 2 {
+		return nil, ErrInvalidArguments
+	}
+	if len(vmInput.Arguments[1]) > vmcommon.MaxLenForDCTIssueMint {
+		return nil, ErrInvalidArguments
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmcommon.DCTSCAddress) {
+		return nil, ErrAddressIsNotDCTSystemSC
+	}
+	if !vmcommon.IsSystemAccountAddress(vmInput.RecipientAddr) {
+		return nil, ErrOnlySystemAccountAccepted
+	}
+
+	systemSCAccount, err := e.getSystemAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	maxSupply := bytes.TrimLeft(vmInput.Arguments[1], "\x00")
+	if len(maxSupply) == 0 {
+		maxSupply = nil
+	}
+	err = systemSCAccount.AccountDataHandler().SaveKeyValue(getMaxSupplyKey(vmInput.Arguments[0]), maxSupply)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.accounts.SaveAccount(systemSCAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
+	return vmOutput, nil
+}
+
+func (e *dctSetMaxSupply) getSystemAccount() (vmcommon.UserAccountHandler, error) {
+	systemSCAccount, err := e.accounts.LoadAccount(vmcommon.SystemAccountAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	userAcc, ok := systemSCAccount.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	return userAcc, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *dctSetMaxSupply) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,102 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDCTSetMaxSupplyFunc(t *testing.T) {
+	t.Parallel()
+
+	setMaxSupplyFunc, err := NewDCTSetMaxSupplyFunc(nil, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(setMaxSupplyFunc))
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+
+	setMaxSupplyFunc, err = NewDCTSetMaxSupplyFunc(&mock.AccountsStub{}, 0, nil)
+	assert.True(t, check.IfNil(setMaxSupplyFunc))
+	assert.Equal(t, ErrNilEpochHandler, err)
+
+	setMaxSupplyFunc, err = NewDCTSetMaxSupplyFunc(&mock.AccountsStub{}, 1, &mock.EpochNotifierStub{})
+	assert.False(t, check.IfNil(setMaxSupplyFunc))
+	assert.Nil(t, err)
+	assert.False(t, setMaxSupplyFunc.IsActive())
+
+	setMaxSupplyFunc.EpochConfirmed(1, 0)
+	assert.True(t, setMaxSupplyFunc.IsActive())
+}
+
+func TestDCTSetMaxSupply_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	setMaxSupplyFunc, _ := NewDCTSetMaxSupplyFunc(&mock.AccountsStub{}, 0, &mock.EpochNotifierStub{})
+	_, err := setMaxSupplyFunc.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, ErrNilVmInput, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue: big.NewInt(1),
+		},
+	}
+	_, err = setMaxSupplyFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrBuiltInFunctionCalledWithValue, err)
+
+	input.CallValue = big.NewInt(0)
+	input.Arguments = [][]byte{[]byte("token")}
+	_, err = setMaxSupplyFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{[]byte("token"), make([]byte, vmcommon.MaxLenForDCTIssueMint+1)}
+	_, err = setMaxSupplyFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{[]byte("token"), big.NewInt(100).Bytes()}
+	_, err = setMaxSupplyFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrAddressIsNotDCTSystemSC, err)
+
+	input.CallerAddr = vmcommon.DCTSCAddress
+	_, err = setMaxSupplyFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrOnlySystemAccountAccepted, err)
+}
+
+func TestDCTSetMaxSupply_ProcessBuiltInFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	supplyHandler, systemAccount := createSupplyHandlerWithSystemAccount()
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return systemAccount, nil
+		},
+	}
+	setMaxSupplyFunc, _ := NewDCTSetMaxSupplyFunc(accounts, 0, &mock.EpochNotifierStub{})
+
+	token := []byte("token")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: vmcommon.DCTSCAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  [][]byte{token, big.NewInt(100).Bytes()},
+		},
+		RecipientAddr: vmcommon.SystemAccountAddress,
+	}
+	vmOutput, err := setMaxSupplyFunc.ProcessBuiltinFunction(nil, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+
+	maxSupply, _ := systemAccount.AccountDataHandler().RetrieveValue(getMaxSupplyKey(token))
+	assert.Equal(t, big.NewInt(100).Bytes(), maxSupply)
+	assert.Equal(t, ErrMaxSupplyExceeded, supplyHandler.UseMintAllowance(token, big.NewInt(101)))
+
+	input.Arguments[1] = []byte{0}
+	_, err = setMaxSupplyFunc.ProcessBuiltinFunction(nil, nil, input)
+	require.Nil(t, err)
+
+	maxSupply, _ = systemAccount.AccountDataHandler().RetrieveValue(getMaxSupplyKey(token))
+	assert.Empty(t, maxSupply)
+	assert.Nil(t, supplyHandler.UseMintAllowance(token, big.NewInt(101)))
+}
@@ -0,0 +1,92 @@
+package builtInFunctions
+
+import (
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+)
+
+type dctSetNewURIs struct {
+	*baseDCTMetaDataModify
+}
+
+// NewDCTSetNewURIsFunc returns the dct NFT set new URIs built-in function component
+func NewDCTSetNewURIsFunc(
+	funcGasCost uint64,
+	gasConfig vmcommon.BaseOperationCost,
+	marshalizer vmcommon.Marshalizer,
+	storageHandler vmcommon.DCTNFTStorageHandler,
+	rolesHandler vmcommon.DCTRoleHandler,
+	activationEpoch uint32,
+	epochNotifier vmcommon.EpochNotifier,
+) (*dctSetNewURIs, error) {
+	base, err := newBaseDCTMetaDataModify(
+		vmcommon.BuiltInFunctionDCTSetNewURIs,
+		funcGasCost,
+		gasConfig,
+		marshalizer,
+		storageHandler,
+		rolesHandler,
+		activationEpoch,
+		epochNotifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &dctSetNewURIs{
+		baseDCTMetaDataModify: base,
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *dctSetNewURIs) SetNewGasConfig(gasCost *vmcommon.GasCost) {
+	if gasCost == nil {
+		return
+	}
+
+	e.setGasConfig(gasCost.BuiltInCost.DCTSetNewURIs, gasCost.BaseOperationCost)
+}
+
+// ProcessBuiltinFunction resolves DCT NFT set new URIs function call
+// Requires at least 3 arguments:
+// arg0 - token identifier
+// arg1 - nonce
+// arg2+ - the URIs which replace the existing ones (minimum 1)
+func (e *dctSetNewURIs) ProcessBuiltinFunction(
+	acntSnd, _ vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	err := checkDCTNFTCreateBurnAddInput(acntSnd, vmInput, e.funcGasCost)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) < 3 {
+		return nil, ErrInvalidArguments
+	}
+
+	lenURIs := uint64(0)
+	for _, uri := range vmInput.Arguments[2:] {
+		lenURIs += uint64(len(uri))
+	}
+	vmOutput, _, err := e.modifyMetaData(acntSnd, vmInput, vmcommon.DCTRoleSetNewURI, lenURIs, func(metaData *dct.MetaData) {
+		metaData.URIs = vmInput.Arguments[2:]
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput.Logs[0].Topics = append(vmOutput.Logs[0].Topics, vmInput.Arguments[2:]...)
+	return vmOutput, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *dctSetNewURIs) IsInterfaceNil() bool {
+	return e == nil
+}
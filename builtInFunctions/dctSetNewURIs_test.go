@@ -0,0 +1,110 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDCTSetNewURIsFunc(t *testing.T) {
+	t.Parallel()
+
+	e, err := NewDCTSetNewURIsFunc(10, vmcommon.BaseOperationCost{}, nil, createNewDCTDataStorageHandler(), &mock.DCTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	require.True(t, check.IfNil(e))
+	require.Equal(t, ErrNilMarshalizer, err)
+
+	e, err = NewDCTSetNewURIsFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, nil, &mock.DCTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	require.True(t, check.IfNil(e))
+	require.Equal(t, ErrNilDCTNFTStorageHandler, err)
+
+	e, err = NewDCTSetNewURIsFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, createNewDCTDataStorageHandler(), nil, 0, &mock.EpochNotifierStub{})
+	require.True(t, check.IfNil(e))
+	require.Equal(t, ErrNilRolesHandler, err)
+
+	e, err = NewDCTSetNewURIsFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, createNewDCTDataStorageHandler(), &mock.DCTRoleHandlerStub{}, 0, nil)
+	require.True(t, check.IfNil(e))
+	require.Equal(t, ErrNilEpochHandler, err)
+
+	e, err = NewDCTSetNewURIsFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, createNewDCTDataStorageHandler(), &mock.DCTRoleHandlerStub{}, 1, &mock.EpochNotifierStub{})
+	require.False(t, check.IfNil(e))
+	require.Nil(t, err)
+	require.False(t, e.IsActive())
+
+	e.EpochConfirmed(1, 0)
+	require.True(t, e.IsActive())
+}
+
+func TestDCTSetNewURIs_SetNewGasConfig(t *testing.T) {
+	t.Parallel()
+
+	e, _ := NewDCTSetNewURIsFunc(10, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, createNewDCTDataStorageHandler(), &mock.DCTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	e.SetNewGasConfig(nil)
+	require.Equal(t, uint64(10), e.funcGasCost)
+
+	gasCost := createMockGasCost()
+	e.SetNewGasConfig(&gasCost)
+	require.Equal(t, gasCost.BuiltInCost.DCTSetNewURIs, e.funcGasCost)
+	require.Equal(t, gasCost.BaseOperationCost, e.gasConfig)
+}
+
+func TestDCTSetNewURIs_ProcessBuiltinFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	caller := []byte("caller")
+	e, _ := NewDCTSetNewURIsFunc(10, vmcommon.BaseOperationCost{StorePerByte: 1}, &mock.MarshalizerMock{}, createNewDCTDataStorageHandler(), &mock.DCTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	userAcc := mock.NewUserAccount(caller)
+
+	output, err := e.ProcessBuiltinFunction(userAcc, nil, createMetaDataModifyInput(caller, []byte("TKN"), big.NewInt(1).Bytes()))
+	require.Nil(t, output)
+	require.Equal(t, ErrInvalidArguments, err)
+
+	input := createMetaDataModifyInput(caller, []byte("TKN"), big.NewInt(1).Bytes(), []byte("new uri"))
+	input.GasProvided = 15
+	_, err = e.ProcessBuiltinFunction(userAcc, nil, input)
+	require.Equal(t, ErrNotEnoughGas, err)
+
+	_, err = e.ProcessBuiltinFunction(userAcc, nil, createMetaDataModifyInput(caller, []byte("TKN"), big.NewInt(0).Bytes(), []byte("new uri")))
+	require.Equal(t, ErrNFTDoesNotHaveMetadata, err)
+
+	_, err = e.ProcessBuiltinFunction(userAcc, nil, createMetaDataModifyInput(caller, []byte("TKN"), big.NewInt(1).Bytes(), []byte("new uri")))
+	require.Equal(t, ErrNewNFTDataOnSenderAddress, err)
+
+	localErr := errors.New("local err")
+	e.rolesHandler = &mock.DCTRoleHandlerStub{
+		CheckAllowedToExecuteCalled: func(_ vmcommon.UserAccountHandler, _ []byte, action []byte) error {
+			require.Equal(t, []byte(vmcommon.DCTRoleSetNewURI), action)
+			return localErr
+		},
+	}
+	_, err = e.ProcessBuiltinFunction(userAcc, nil, createMetaDataModifyInput(caller, []byte("TKN"), big.NewInt(1).Bytes(), []byte("new uri")))
+	require.Equal(t, localErr, err)
+}
+
+func TestDCTSetNewURIs_ProcessBuiltinFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	caller := []byte("caller")
+	tokenID := []byte("TKN")
+	storageHandler := createNewDCTDataStorageHandler()
+	e, _ := NewDCTSetNewURIsFunc(10, vmcommon.BaseOperationCost{StorePerByte: 1}, &mock.MarshalizerMock{}, storageHandler, &mock.DCTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	userAcc := mock.NewUserAccount(caller)
+	createNFTWithMetaData(t, storageHandler, userAcc, tokenID, 1)
+
+	newURIs := [][]byte{[]byte("uri1"), []byte("uri2")}
+	output, err := e.ProcessBuiltinFunction(userAcc, nil, createMetaDataModifyInput(caller, tokenID, big.NewInt(1).Bytes(), newURIs[0], newURIs[1]))
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.Ok, output.ReturnCode)
+	require.Equal(t, uint64(1000-10-8), output.GasRemaining)
+
+	dctData, _ := storageHandler.GetDCTNFTTokenOnSender(userAcc, append(keyPrefix, tokenID...), 1)
+	require.Equal(t, newURIs, dctData.TokenMetaData.URIs)
+
+	require.Equal(t, 1, len(output.Logs))
+	require.Equal(t, []byte(vmcommon.BuiltInFunctionDCTSetNewURIs), output.Logs[0].Identifier)
+	require.Equal(t, [][]byte{tokenID, big.NewInt(1).Bytes(), newURIs[0], newURIs[1]}, output.Logs[0].Topics)
+}
@@ -0,0 +1,139 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+)
+
+const maxNumDecimals = 18
+
+var decimalsKeyPrefix = []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTDecimalsIdentifier + vmcommon.DCTKeyIdentifier)
+
+type dctSetTokenType struct {
+	baseAlwaysActive
+	keyPrefix []byte
+	accounts  vmcommon.AccountsAdapter
+}
+
+// NewDCTSetTokenTypeFunc returns the dct set token type built-in function component
+func NewDCTSetTokenTypeFunc(
+	accounts vmcommon.AccountsAdapter,
+) (*dctSetTokenType, error) {
+	if check.IfNil(accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	e := &dctSetTokenType{
+		keyPrefix: []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
+		accounts:  accounts,
+	}
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *dctSetTokenType) SetNewGasConfig(_ *vmcommon.GasCost) {
+}
+
+// ProcessBuiltinFunction resolves DCT set token type function call
+// Requires 2 or 3 arguments:
+// arg0 - token identifier
+// arg1 - token type
+// arg2 - number of decimals, only for MetaDCT tokens
+func (e *dctSetTokenType) ProcessBuiltinFunction(
+	_, _ vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if vmInput == nil {
+		return nil, ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) != 2 && len(vmInput.Arguments) != 3 {
+		return nil, ErrInvalidArguments
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmcommon.DCTSCAddress) {
+		return nil, ErrAddressIsNotDCTSystemSC
+	}
+	if !vmcommon.IsSystemAccountAddress(vmInput.RecipientAddr) {
+		return nil, ErrOnlySystemAccountAccepted
+	}
+
+	tokenType, err := vmcommon.ConvertDCTTypeToUint32(string(vmInput.Arguments[1]))
+	if err != nil {
+		return nil, err
+	}
+
+	hasDecimals := len(vmInput.Arguments) == 3
+	if hasDecimals && tokenType != uint32(vmcommon.MetaFungible) {
+		return nil, ErrInvalidArguments
+	}
+	numDecimals := uint64(0)
+	if hasDecimals {
+		numDecimalsBig := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+		if !numDecimalsBig.IsUint64() || numDecimalsBig.Uint64() > maxNumDecimals {
+			return nil, ErrInvalidNumOfDecimals
+		}
+		numDecimals = numDecimalsBig.Uint64()
+	}
+
+	err = e.saveTokenType(vmInput.Arguments[0], tokenType, numDecimals)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
+	return vmOutput, nil
+}
+
+func (e *dctSetTokenType) saveTokenType(tokenID []byte, tokenType uint32, numDecimals uint64) error {
+	systemSCAccount, err := e.getSystemAccount()
+	if err != nil {
+		return err
+	}
+
+	dctTokenKey := append(e.keyPrefix, tokenID...)
+	val, _ := systemSCAccount.AccountDataHandler().RetrieveValue(dctTokenKey)
+	dctMetaData := DCTGlobalMetadataFromBytes(val)
+	dctMetaData.TokenType = byte(tokenType)
+	err = systemSCAccount.AccountDataHandler().SaveKeyValue(dctTokenKey, dctMetaData.ToBytes())
+	if err != nil {
+		return err
+	}
+
+	if tokenType == uint32(vmcommon.MetaFungible) {
+		err = systemSCAccount.AccountDataHandler().SaveKeyValue(getDecimalsKey(tokenID), big.NewInt(0).SetUint64(numDecimals).Bytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.accounts.SaveAccount(systemSCAccount)
+}
+
+func (e *dctSetTokenType) getSystemAccount() (vmcommon.UserAccountHandler, error) {
+	systemSCAccount, err := e.accounts.LoadAccount(vmcommon.SystemAccountAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	userAcc, ok := systemSCAccount.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	return userAcc, nil
+}
+
+func getDecimalsKey(tokenID []byte) []byte {
+	return append(decimalsKeyPrefix, tokenID...)
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *dctSetTokenType) IsInterfaceNil() bool {
+	return e == nil
+}
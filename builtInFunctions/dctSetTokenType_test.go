@@ -0,0 +1,102 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDCTSetTokenTypeFunc(t *testing.T) {
+	t.Parallel()
+
+	setTypeFunc, err := NewDCTSetTokenTypeFunc(nil)
+	assert.True(t, check.IfNil(setTypeFunc))
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+
+	setTypeFunc, err = NewDCTSetTokenTypeFunc(&mock.AccountsStub{})
+	assert.False(t, check.IfNil(setTypeFunc))
+	assert.Nil(t, err)
+	assert.True(t, setTypeFunc.IsActive())
+}
+
+func TestDCTSetTokenType_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	setTypeFunc, _ := NewDCTSetTokenTypeFunc(&mock.AccountsStub{})
+	_, err := setTypeFunc.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, ErrNilVmInput, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue: big.NewInt(1),
+		},
+	}
+	_, err = setTypeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrBuiltInFunctionCalledWithValue, err)
+
+	input.CallValue = big.NewInt(0)
+	input.Arguments = [][]byte{[]byte("token")}
+	_, err = setTypeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{[]byte("token"), []byte(vmcommon.MetaDCT)}
+	_, err = setTypeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrAddressIsNotDCTSystemSC, err)
+
+	input.CallerAddr = vmcommon.DCTSCAddress
+	_, err = setTypeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrOnlySystemAccountAccepted, err)
+
+	input.RecipientAddr = vmcommon.SystemAccountAddress
+	input.Arguments = [][]byte{[]byte("token"), []byte("invalid")}
+	_, err = setTypeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, vmcommon.ErrInvalidDCTType, err)
+
+	input.Arguments = [][]byte{[]byte("token"), []byte(vmcommon.SemiFungibleDCT), big.NewInt(5).Bytes()}
+	_, err = setTypeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{[]byte("token"), []byte(vmcommon.MetaDCT), big.NewInt(maxNumDecimals + 1).Bytes()}
+	_, err = setTypeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrInvalidNumOfDecimals, err)
+}
+
+func TestDCTSetTokenType_ProcessBuiltInFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	acnt := mock.NewUserAccount(vmcommon.SystemAccountAddress)
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return acnt, nil
+		},
+	}
+	setTypeFunc, _ := NewDCTSetTokenTypeFunc(accounts)
+	pauseFunc, _ := NewDCTPauseFunc(accounts, true)
+
+	token := []byte("token")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: vmcommon.DCTSCAddress,
+			Arguments:  [][]byte{token},
+		},
+		RecipientAddr: vmcommon.SystemAccountAddress,
+	}
+	_, err := pauseFunc.ProcessBuiltinFunction(nil, nil, input)
+	require.Nil(t, err)
+
+	input.Arguments = [][]byte{token, []byte(vmcommon.MetaDCT), big.NewInt(6).Bytes()}
+	vmOutput, err := setTypeFunc.ProcessBuiltinFunction(nil, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+
+	dctTokenKey := []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier + string(token))
+	assert.Equal(t, uint32(vmcommon.MetaFungible), pauseFunc.GetTokenType(dctTokenKey))
+	assert.Equal(t, uint32(6), pauseFunc.GetNumDecimals(dctTokenKey))
+	assert.True(t, pauseFunc.IsPaused(dctTokenKey))
+}
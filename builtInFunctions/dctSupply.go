@@ -0,0 +1,250 @@
+package builtInFunctions
+
+import (
+	"math/big"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/atomic"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+)
+
+var supplyKeyPrefix = []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTSupplyIdentifier + vmcommon.DCTKeyIdentifier)
+
+var maxSupplyKeyPrefix = []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTMaxSupplyIdentifier + vmcommon.DCTKeyIdentifier)
+
+var mintAllowanceKeyPrefix = []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTMintAllowanceIdentifier + vmcommon.DCTKeyIdentifier)
+
+type dctSupply struct {
+	accounts          vmcommon.AccountsAdapter
+	marshalizer       vmcommon.Marshalizer
+	supplyEnableEpoch uint32
+	flagSupply        atomic.Flag
+}
+
+// NewDCTSupplyHandler returns the component which keeps the supply of the dct tokens on the system account, starting
+// with the supply enable epoch. Each shard has its own system account, so the supply stored in a shard is the
+// contribution of that shard only: the tokens minted and burned there. A token minted in one shard and burned in
+// another one leaves a positive contribution in the first shard and a negative one in the second. Every mint and
+// burn is accounted in exactly one shard, so the global supply is the sum of the contributions of all the shards,
+// see ComputeGlobalSupply
+func NewDCTSupplyHandler(
+	accounts vmcommon.AccountsAdapter,
+	marshalizer vmcommon.Marshalizer,
+	supplyEnableEpoch uint32,
+	epochNotifier vmcommon.EpochNotifier,
+) (*dctSupply, error) {
+	if check.IfNil(accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, ErrNilEpochHandler
+	}
+
+	s := &dctSupply{
+		accounts:          accounts,
+		marshalizer:       marshalizer,
+		supplyEnableEpoch: supplyEnableEpoch,
+		flagSupply:        atomic.Flag{},
+	}
+	epochNotifier.RegisterNotifyHandler(s)
+
+	return s, nil
+}
+
+// UpdateSupply adds the value to the supply contribution of this shard for the token. A positive value is accounted
+// as minted and a negative one as burned. For nonce different than 0 the supply of the given nonce is updated as well.
+// Nothing is saved before the supply enable epoch
+func (s *dctSupply) UpdateSupply(tokenID []byte, nonce uint64, value *big.Int) error {
+	if !s.flagSupply.IsSet() || value == nil || value.Sign() == 0 {
+		return nil
+	}
+
+	systemSCAccount, err := s.getSystemAccount()
+	if err != nil {
+		return err
+	}
+
+	err = s.addToSupply(systemSCAccount, getSupplyKey(tokenID, 0), value)
+	if err != nil {
+		return err
+	}
+	if nonce > 0 {
+		err = s.addToSupply(systemSCAccount, getSupplyKey(tokenID, nonce), value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.accounts.SaveAccount(systemSCAccount)
+}
+
+// GetSupply returns the supply contribution of this shard for the token, or for the given token nonce if nonce is
+// different than 0. The contribution might be negative, use ComputeGlobalSupply over the contributions of all the
+// shards to get the supply of the token
+func (s *dctSupply) GetSupply(tokenID []byte, nonce uint64) (*dct.DCTSupply, error) {
+	systemSCAccount, err := s.getSystemAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getSupply(systemSCAccount, getSupplyKey(tokenID, nonce))
+}
+
+// UseMintAllowance consumes the value out of the mint allowance granted to this shard for the token and returns
+// ErrMaxSupplyExceeded if the allowance left is not enough. The max supply of a token is a global cap kept by the
+// dct system smart contract, which grants every shard a mint allowance out of it through DCTAddMintAllowance, so
+// that the allowances of all the shards never add up to more than the max supply. Burning tokens does not give the
+// allowance back, so the tokens minted over all the shards never exceed the max supply. Tokens without a max supply
+// are not capped
+func (s *dctSupply) UseMintAllowance(tokenID []byte, value *big.Int) error {
+	if value == nil || value.Sign() <= 0 {
+		return nil
+	}
+
+	systemSCAccount, err := s.getSystemAccount()
+	if err != nil {
+		return err
+	}
+
+	maxSupplyBytes, err := systemSCAccount.AccountDataHandler().RetrieveValue(getMaxSupplyKey(tokenID))
+	if err != nil {
+		return err
+	}
+	if len(maxSupplyBytes) == 0 {
+		return nil
+	}
+
+	mintAllowanceKey := getMintAllowanceKey(tokenID)
+	mintAllowanceBytes, err := systemSCAccount.AccountDataHandler().RetrieveValue(mintAllowanceKey)
+	if err != nil {
+		return err
+	}
+
+	mintAllowance := big.NewInt(0).SetBytes(mintAllowanceBytes)
+	if mintAllowance.Cmp(value) < 0 {
+		return ErrMaxSupplyExceeded
+	}
+
+	mintAllowance.Sub(mintAllowance, value)
+	err = systemSCAccount.AccountDataHandler().SaveKeyValue(mintAllowanceKey, mintAllowance.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return s.accounts.SaveAccount(systemSCAccount)
+}
+
+func (s *dctSupply) addToSupply(systemSCAccount vmcommon.UserAccountHandler, supplyKey []byte, value *big.Int) error {
+	supply, err := s.getSupply(systemSCAccount, supplyKey)
+	if err != nil {
+		return err
+	}
+
+	supply.Supply.Add(supply.Supply, value)
+	if value.Sign() > 0 {
+		supply.Minted.Add(supply.Minted, value)
+	} else {
+		supply.Burned.Sub(supply.Burned, value)
+	}
+
+	marshaledData, err := s.marshalizer.Marshal(supply)
+	if err != nil {
+		return err
+	}
+
+	return systemSCAccount.AccountDataHandler().SaveKeyValue(supplyKey, marshaledData)
+}
+
+func (s *dctSupply) getSupply(systemSCAccount vmcommon.UserAccountHandler, supplyKey []byte) (*dct.DCTSupply, error) {
+	supply := &dct.DCTSupply{
+		Supply: big.NewInt(0),
+		Minted: big.NewInt(0),
+		Burned: big.NewInt(0),
+	}
+	marshaledData, err := systemSCAccount.AccountDataHandler().RetrieveValue(supplyKey)
+	if err != nil || len(marshaledData) == 0 {
+		return supply, nil
+	}
+
+	err = s.marshalizer.Unmarshal(supply, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return supply, nil
+}
+
+func (s *dctSupply) getSystemAccount() (vmcommon.UserAccountHandler, error) {
+	systemSCAccount, err := s.accounts.LoadAccount(vmcommon.SystemAccountAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	userAcc, ok := systemSCAccount.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	return userAcc, nil
+}
+
+func getSupplyKey(tokenID []byte, nonce uint64) []byte {
+	supplyKey := make([]byte, 0, len(supplyKeyPrefix)+len(tokenID))
+	supplyKey = append(supplyKey, supplyKeyPrefix...)
+	supplyKey = append(supplyKey, tokenID...)
+	return computeDCTNFTTokenKey(supplyKey, nonce)
+}
+
+func getMaxSupplyKey(tokenID []byte) []byte {
+	maxSupplyKey := make([]byte, 0, len(maxSupplyKeyPrefix)+len(tokenID))
+	maxSupplyKey = append(maxSupplyKey, maxSupplyKeyPrefix...)
+	return append(maxSupplyKey, tokenID...)
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (s *dctSupply) EpochConfirmed(epoch uint32, _ uint64) {
+	s.flagSupply.Toggle(epoch >= s.supplyEnableEpoch)
+	log.Debug("dct supply accounting", "enabled", s.flagSupply.IsSet())
+}
+
+// ComputeGlobalSupply sums the supply contributions read from the system accounts of all the shards into the supply
+// of the token
+func ComputeGlobalSupply(shardSupplies []*dct.DCTSupply) *dct.DCTSupply {
+	globalSupply := &dct.DCTSupply{
+		Supply: big.NewInt(0),
+		Minted: big.NewInt(0),
+		Burned: big.NewInt(0),
+	}
+	for _, shardSupply := range shardSupplies {
+		if shardSupply == nil {
+			continue
+		}
+
+		addIfNotNil(globalSupply.Supply, shardSupply.Supply)
+		addIfNotNil(globalSupply.Minted, shardSupply.Minted)
+		addIfNotNil(globalSupply.Burned, shardSupply.Burned)
+	}
+
+	return globalSupply
+}
+
+func addIfNotNil(sum *big.Int, value *big.Int) {
+	if value != nil {
+		sum.Add(sum, value)
+	}
+}
+
+func getMintAllowanceKey(tokenID []byte) []byte {
+	mintAllowanceKey := make([]byte, 0, len(mintAllowanceKeyPrefix)+len(tokenID))
+	mintAllowanceKey = append(mintAllowanceKey, mintAllowanceKeyPrefix...)
+	return append(mintAllowanceKey, tokenID...)
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (s *dctSupply) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,239 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSupplyHandlerWithSystemAccount() (*dctSupply, *mock.Account) {
+	systemAccount := mock.NewUserAccount(vmcommon.SystemAccountAddress)
+	supplyHandler, _ := NewDCTSupplyHandler(&mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return systemAccount, nil
+		},
+	}, &mock.MarshalizerMock{}, 0, &mock.EpochNotifierStub{})
+
+	return supplyHandler, systemAccount
+}
+
+func TestNewDCTSupplyHandler(t *testing.T) {
+	t.Parallel()
+
+	supplyHandler, err := NewDCTSupplyHandler(nil, &mock.MarshalizerMock{}, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(supplyHandler))
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+
+	supplyHandler, err = NewDCTSupplyHandler(&mock.AccountsStub{}, nil, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(supplyHandler))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	supplyHandler, err = NewDCTSupplyHandler(&mock.AccountsStub{}, &mock.MarshalizerMock{}, 0, nil)
+	assert.True(t, check.IfNil(supplyHandler))
+	assert.Equal(t, ErrNilEpochHandler, err)
+
+	supplyHandler, err = NewDCTSupplyHandler(&mock.AccountsStub{}, &mock.MarshalizerMock{}, 0, &mock.EpochNotifierStub{})
+	assert.False(t, check.IfNil(supplyHandler))
+	assert.Nil(t, err)
+}
+
+func TestDCTSupply_UpdateSupplyLoadAccountFails(t *testing.T) {
+	t.Parallel()
+
+	localErr := errors.New("local err")
+	supplyHandler, _ := NewDCTSupplyHandler(&mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return nil, localErr
+		},
+	}, &mock.MarshalizerMock{}, 0, &mock.EpochNotifierStub{})
+
+	err := supplyHandler.UpdateSupply([]byte("token"), 0, big.NewInt(10))
+	assert.Equal(t, localErr, err)
+
+	err = supplyHandler.UpdateSupply([]byte("token"), 0, big.NewInt(0))
+	assert.Nil(t, err)
+}
+
+func TestDCTSupply_UpdateSupplyFungible(t *testing.T) {
+	t.Parallel()
+
+	supplyHandler, systemAccount := createSupplyHandlerWithSystemAccount()
+	token := []byte("token")
+
+	err := supplyHandler.UpdateSupply(token, 0, big.NewInt(100))
+	require.Nil(t, err)
+	err = supplyHandler.UpdateSupply(token, 0, big.NewInt(-30))
+	require.Nil(t, err)
+
+	supply, err := supplyHandler.GetSupply(token, 0)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(70), supply.Supply)
+	assert.Equal(t, big.NewInt(100), supply.Minted)
+	assert.Equal(t, big.NewInt(30), supply.Burned)
+
+	val, _ := systemAccount.AccountDataHandler().RetrieveValue(getSupplyKey(token, 0))
+	assert.NotEmpty(t, val)
+}
+
+func TestDCTSupply_UpdateSupplyBeforeEnableEpochShouldNotSave(t *testing.T) {
+	t.Parallel()
+
+	systemAccount := mock.NewUserAccount(vmcommon.SystemAccountAddress)
+	supplyHandler, _ := NewDCTSupplyHandler(&mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return systemAccount, nil
+		},
+	}, &mock.MarshalizerMock{}, 1, &mock.EpochNotifierStub{})
+	token := []byte("token")
+
+	err := supplyHandler.UpdateSupply(token, 1, big.NewInt(100))
+	require.Nil(t, err)
+	assert.Empty(t, systemAccount.Storage)
+
+	supplyHandler.EpochConfirmed(1, 0)
+	err = supplyHandler.UpdateSupply(token, 1, big.NewInt(100))
+	require.Nil(t, err)
+	supply, _ := supplyHandler.GetSupply(token, 1)
+	assert.Equal(t, big.NewInt(100), supply.Supply)
+}
+
+func TestComputeGlobalSupply(t *testing.T) {
+	t.Parallel()
+
+	mintingShard, _ := createSupplyHandlerWithSystemAccount()
+	burningShard, _ := createSupplyHandlerWithSystemAccount()
+	token := []byte("token")
+	_ = mintingShard.UpdateSupply(token, 0, big.NewInt(100))
+	_ = burningShard.UpdateSupply(token, 0, big.NewInt(-40))
+
+	mintingShardSupply, _ := mintingShard.GetSupply(token, 0)
+	burningShardSupply, _ := burningShard.GetSupply(token, 0)
+	assert.Equal(t, big.NewInt(-40), burningShardSupply.Supply)
+
+	globalSupply := ComputeGlobalSupply([]*dct.DCTSupply{mintingShardSupply, burningShardSupply, nil})
+	assert.Equal(t, big.NewInt(60), globalSupply.Supply)
+	assert.Equal(t, big.NewInt(100), globalSupply.Minted)
+	assert.Equal(t, big.NewInt(40), globalSupply.Burned)
+}
+
+func TestDCTSupply_UpdateSupplyWithNonceUpdatesTokenAndNonce(t *testing.T) {
+	t.Parallel()
+
+	supplyHandler, _ := createSupplyHandlerWithSystemAccount()
+	token := []byte("token")
+
+	_ = supplyHandler.UpdateSupply(token, 1, big.NewInt(10))
+	_ = supplyHandler.UpdateSupply(token, 2, big.NewInt(5))
+	_ = supplyHandler.UpdateSupply(token, 1, big.NewInt(-4))
+
+	supply, _ := supplyHandler.GetSupply(token, 0)
+	assert.Equal(t, big.NewInt(11), supply.Supply)
+	assert.Equal(t, big.NewInt(15), supply.Minted)
+	assert.Equal(t, big.NewInt(4), supply.Burned)
+
+	supply, _ = supplyHandler.GetSupply(token, 1)
+	assert.Equal(t, big.NewInt(6), supply.Supply)
+	assert.Equal(t, big.NewInt(10), supply.Minted)
+	assert.Equal(t, big.NewInt(4), supply.Burned)
+
+	supply, _ = supplyHandler.GetSupply(token, 2)
+	assert.Equal(t, big.NewInt(5), supply.Supply)
+	assert.Equal(t, big.NewInt(5), supply.Minted)
+	assert.Equal(t, big.NewInt(0), supply.Burned)
+}
+
+func TestDCTSupply_GetSupplyNotStoredShouldReturnZero(t *testing.T) {
+	t.Parallel()
+
+	supplyHandler, _ := createSupplyHandlerWithSystemAccount()
+
+	supply, err := supplyHandler.GetSupply([]byte("token"), 3)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(0), supply.Supply)
+	assert.Equal(t, big.NewInt(0), supply.Minted)
+	assert.Equal(t, big.NewInt(0), supply.Burned)
+}
+
+func TestDCTSupply_BurnBuiltInShouldUpdateSupply(t *testing.T) {
+	t.Parallel()
+
+	supplyHandler, _ := createSupplyHandlerWithSystemAccount()
+	marshalizer := &mock.MarshalizerMock{}
+	burnFunc, _ := NewDCTBurnFunc(10, marshalizer, &mock.PauseHandlerStub{}, supplyHandler, &mock.LockedBalanceHandlerStub{})
+
+	token := []byte("token")
+	_ = supplyHandler.UpdateSupply(token, 0, big.NewInt(100))
+
+	accSnd := mock.NewUserAccount([]byte("snd"))
+	dctKey := append([]byte(vmcommon.DharitriProtectedKeyPrefix+vmcommon.DCTKeyIdentifier), token...)
+	err := addToDCTBalance(accSnd, dctKey, big.NewInt(100), marshalizer, &mock.PauseHandlerStub{}, &mock.LockedBalanceHandlerStub{}, false)
+	require.Nil(t, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			GasProvided: 50,
+			CallValue:   big.NewInt(0),
+			Arguments:   [][]byte{token, big.NewInt(40).Bytes()},
+		},
+		RecipientAddr: vmcommon.DCTSCAddress,
+	}
+	_, err = burnFunc.ProcessBuiltinFunction(accSnd, nil, input)
+	require.Nil(t, err)
+
+	supply, _ := supplyHandler.GetSupply(token, 0)
+	assert.Equal(t, big.NewInt(60), supply.Supply)
+	assert.Equal(t, big.NewInt(40), supply.Burned)
+}
+
+func TestGetSupplyKey(t *testing.T) {
+	t.Parallel()
+
+	key := getSupplyKey([]byte("token"), 0)
+	assert.Equal(t, []byte(vmcommon.DharitriProtectedKeyPrefix+vmcommon.DCTSupplyIdentifier+vmcommon.DCTKeyIdentifier+"token"), key)
+
+	key = getSupplyKey([]byte("token"), 5)
+	assert.Equal(t, []byte(vmcommon.DharitriProtectedKeyPrefix+vmcommon.DCTSupplyIdentifier+vmcommon.DCTKeyIdentifier+"token\x05"), key)
+}
+
+func TestDCTSupply_UseMintAllowance(t *testing.T) {
+	t.Parallel()
+
+	supplyHandler, systemAccount := createSupplyHandlerWithSystemAccount()
+	token := []byte("token")
+
+	err := supplyHandler.UseMintAllowance(token, big.NewInt(1000))
+	assert.Nil(t, err, "token without max supply is not capped")
+
+	_ = systemAccount.AccountDataHandler().SaveKeyValue(getMaxSupplyKey(token), big.NewInt(100).Bytes())
+	assert.Equal(t, ErrMaxSupplyExceeded, supplyHandler.UseMintAllowance(token, big.NewInt(1)), "no allowance granted")
+
+	_ = systemAccount.AccountDataHandler().SaveKeyValue(getMintAllowanceKey(token), big.NewInt(40).Bytes())
+	assert.Nil(t, supplyHandler.UseMintAllowance(token, big.NewInt(30)))
+	assert.Equal(t, ErrMaxSupplyExceeded, supplyHandler.UseMintAllowance(token, big.NewInt(11)))
+	assert.Nil(t, supplyHandler.UseMintAllowance(token, big.NewInt(0)))
+	assert.Nil(t, supplyHandler.UseMintAllowance(token, big.NewInt(10)))
+
+	_ = supplyHandler.UpdateSupply(token, 0, big.NewInt(-30))
+	assert.Equal(t, ErrMaxSupplyExceeded, supplyHandler.UseMintAllowance(token, big.NewInt(1)), "burns do not give the allowance back")
+}
+
+func TestGetMintAllowanceKey(t *testing.T) {
+	t.Parallel()
+
+	key := getMintAllowanceKey([]byte("token"))
+	assert.Equal(t, []byte(vmcommon.DharitriProtectedKeyPrefix+vmcommon.DCTMintAllowanceIdentifier+vmcommon.DCTKeyIdentifier+"token"), key)
+}
+
+func TestGetMaxSupplyKey(t *testing.T) {
+	t.Parallel()
+
+	key := getMaxSupplyKey([]byte("token"))
+	assert.Equal(t, []byte(vmcommon.DharitriProtectedKeyPrefix+vmcommon.DCTMaxSupplyIdentifier+vmcommon.DCTKeyIdentifier+"token"), key)
+}
@@ -15,39 +15,54 @@ var zero = big.NewInt(0)
 
 type dctTransfer struct {
 	baseAlwaysActive
-	funcGasCost      uint64
-	marshalizer      vmcommon.Marshalizer
-	keyPrefix        []byte
-	pauseHandler     vmcommon.DCTPauseHandler
-	payableHandler   vmcommon.PayableHandler
-	shardCoordinator vmcommon.Coordinator
-	mutExecution     sync.RWMutex
+	function              string
+	funcGasCost           uint64
+	marshalizer           vmcommon.Marshalizer
+	keyPrefix             []byte
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler
+	rolesHandler          vmcommon.DCTRoleHandler
+	supplyHandler         vmcommon.DCTSupplyHandler
+	payableHandler        vmcommon.PayableHandler
+	shardCoordinator      vmcommon.Coordinator
+	lockedBalanceHandler  vmcommon.DCTLockedBalanceHandler
+	mutExecution          sync.RWMutex
 }
 
 // NewDCTTransferFunc returns the dct transfer built-in function component
 func NewDCTTransferFunc(
 	funcGasCost uint64,
 	marshalizer vmcommon.Marshalizer,
-	pauseHandler vmcommon.DCTPauseHandler,
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler,
+	rolesHandler vmcommon.DCTRoleHandler,
 	shardCoordinator vmcommon.Coordinator,
+	lockedBalanceHandler vmcommon.DCTLockedBalanceHandler,
 ) (*dctTransfer, error) {
 	if check.IfNil(marshalizer) {
 		return nil, ErrNilMarshalizer
 	}
-	if check.IfNil(pauseHandler) {
-		return nil, ErrNilPauseHandler
+	if check.IfNil(globalSettingsHandler) {
+		return nil, ErrNilGlobalSettingsHandler
+	}
+	if check.IfNil(rolesHandler) {
+		return nil, ErrNilRolesHandler
 	}
 	if check.IfNil(shardCoordinator) {
 		return nil, ErrNilShardCoordinator
 	}
+	if check.IfNil(lockedBalanceHandler) {
+		return nil, ErrNilLockedBalanceHandler
+	}
 
 	e := &dctTransfer{
-		funcGasCost:      funcGasCost,
-		marshalizer:      marshalizer,
-		keyPrefix:        []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
-		pauseHandler:     pauseHandler,
-		payableHandler:   &disabledPayableHandler{},
-		shardCoordinator: shardCoordinator,
+		function:              vmcommon.BuiltInFunctionDCTTransfer,
+		funcGasCost:           funcGasCost,
+		marshalizer:           marshalizer,
+		keyPrefix:             []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
+		globalSettingsHandler: globalSettingsHandler,
+		rolesHandler:          rolesHandler,
+		payableHandler:        &disabledPayableHandler{},
+		shardCoordinator:      shardCoordinator,
+		lockedBalanceHandler:  lockedBalanceHandler,
 	}
 
 	return e, nil
@@ -91,11 +106,17 @@ func (e *dctTransfer) ProcessBuiltinFunction(
 
 	if !check.IfNil(acntSnd) {
 		// gas is paid only by sender
+		traceGas(vmInput, gasComponentFunction, 1, e.funcGasCost)
 		if vmInput.GasProvided < e.funcGasCost {
 			return nil, ErrNotEnoughGas
 		}
 
-		err = addToDCTBalance(acntSnd, dctTokenKey, big.NewInt(0).Neg(value), e.marshalizer, e.pauseHandler, vmInput.ReturnCallAfterError)
+		err = checkLimitedTransfer(acntSnd, tokenID, dctTokenKey, e.globalSettingsHandler, e.rolesHandler, vmInput.ReturnCallAfterError)
+		if err != nil {
+			return nil, err
+		}
+
+		err = addToDCTBalance(acntSnd, dctTokenKey, big.NewInt(0).Neg(value), e.marshalizer, e.globalSettingsHandler, e.lockedBalanceHandler, vmInput.ReturnCallAfterError)
 		if err != nil {
 			return nil, err
 		}
@@ -115,11 +136,24 @@ func (e *dctTransfer) ProcessBuiltinFunction(
 			}
 		}
 
-		err = addToDCTBalance(acntDst, dctTokenKey, value, e.marshalizer, e.pauseHandler, vmInput.ReturnCallAfterError)
+		err = checkLimitedTransfer(acntDst, tokenID, dctTokenKey, e.globalSettingsHandler, e.rolesHandler, vmInput.ReturnCallAfterError)
+		if err != nil {
+			return nil, err
+		}
+
+		err = addToDCTBalance(acntDst, dctTokenKey, value, e.marshalizer, e.globalSettingsHandler, e.lockedBalanceHandler, vmInput.ReturnCallAfterError)
 		if err != nil {
 			return nil, err
 		}
 
+		// only the mint transfer reports the credited tokens as newly issued or minted ones
+		if !check.IfNil(e.supplyHandler) {
+			err = e.supplyHandler.UpdateSupply(tokenID, 0, value)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		if isSCCallAfter {
 			vmOutput.GasRemaining, err = vmcommon.SafeSubUint64(vmInput.GasProvided, e.funcGasCost)
 			var callArgs [][]byte
@@ -136,7 +170,7 @@ func (e *dctTransfer) ProcessBuiltinFunction(
 				vmInput.CallType,
 				vmOutput)
 
-			addDCTEntryInVMOutput(vmOutput, []byte(vmcommon.BuiltInFunctionDCTTransfer), tokenID, value, vmInput.CallerAddr, acntDst.AddressBytes())
+			addDCTEntryInVMOutput(vmOutput, []byte(e.function), tokenID, value, vmInput.CallerAddr, acntDst.AddressBytes())
 			return vmOutput, nil
 		}
 
@@ -145,7 +179,7 @@ func (e *dctTransfer) ProcessBuiltinFunction(
 			vmOutput.GasRemaining = vmInput.GasProvided
 		}
 
-		addDCTEntryInVMOutput(vmOutput, []byte(vmcommon.BuiltInFunctionDCTTransfer), tokenID, value, vmInput.CallerAddr, acntDst.AddressBytes())
+		addDCTEntryInVMOutput(vmOutput, []byte(e.function), tokenID, value, vmInput.CallerAddr, acntDst.AddressBytes())
 		return vmOutput, nil
 	}
 
@@ -153,7 +187,7 @@ func (e *dctTransfer) ProcessBuiltinFunction(
 	if vmcommon.IsSmartContractAddress(vmInput.CallerAddr) {
 		addOutputTransferToVMOutput(
 			vmInput.CallerAddr,
-			vmcommon.BuiltInFunctionDCTTransfer,
+			e.function,
 			vmInput.Arguments,
 			vmInput.RecipientAddr,
 			vmInput.GasLocked,
@@ -161,7 +195,7 @@ func (e *dctTransfer) ProcessBuiltinFunction(
 			vmOutput)
 	}
 
-	addDCTEntryInVMOutput(vmOutput, []byte(vmcommon.BuiltInFunctionDCTTransfer), tokenID, value, vmInput.CallerAddr)
+	addDCTEntryInVMOutput(vmOutput, []byte(e.function), tokenID, value, vmInput.CallerAddr)
 	return vmOutput, nil
 }
 
@@ -209,12 +243,47 @@ func addOutputTransferToVMOutput(
 	vmOutput.GasRemaining = 0
 }
 
+// appendOutputTransferToVMOutput adds an output transfer without gas to the recipient, keeping the output
+// transfers already created for other recipients
+func appendOutputTransferToVMOutput(
+	senderAddress []byte,
+	function string,
+	arguments [][]byte,
+	recipient []byte,
+	gasLocked uint64,
+	callType vmcommon.CallType,
+	vmOutput *vmcommon.VMOutput,
+) {
+	txData := function
+	for _, arg := range arguments {
+		txData += "@" + hex.EncodeToString(arg)
+	}
+	outTransfer := vmcommon.OutputTransfer{
+		Value:         big.NewInt(0),
+		GasLocked:     gasLocked,
+		Data:          []byte(txData),
+		CallType:      callType,
+		SenderAddress: senderAddress,
+	}
+
+	if vmOutput.OutputAccounts == nil {
+		vmOutput.OutputAccounts = make(map[string]*vmcommon.OutputAccount)
+	}
+	outAcc, ok := vmOutput.OutputAccounts[string(recipient)]
+	if !ok {
+		outAcc = &vmcommon.OutputAccount{Address: recipient}
+		vmOutput.OutputAccounts[string(recipient)] = outAcc
+	}
+	outAcc.OutputTransfers = append(outAcc.OutputTransfers, outTransfer)
+}
+
 func addToDCTBalance(
 	userAcnt vmcommon.UserAccountHandler,
 	key []byte,
 	value *big.Int,
 	marshalizer vmcommon.Marshalizer,
 	pauseHandler vmcommon.DCTPauseHandler,
+	lockedBalanceHandler vmcommon.DCTLockedBalanceHandler,
 	isReturnWithError bool,
 ) error {
 	dctData, err := getDCTDataFromKey(userAcnt, key, marshalizer)
@@ -235,6 +304,12 @@ func addToDCTBalance(
 	if dctData.Value.Cmp(zero) < 0 {
 		return ErrInsufficientFunds
 	}
+	if value.Cmp(zero) < 0 {
+		err = checkLockedBalance(dctData, lockedBalanceHandler)
+		if err != nil {
+			return err
+		}
+	}
 
 	err = saveDCTData(userAcnt, dctData, key, marshalizer)
 	if err != nil {
@@ -244,6 +319,19 @@ func addToDCTBalance(
 	return nil
 }
 
+// checkLockedBalance returns error if the remaining balance is lower than the amount locked in the current epoch
+func checkLockedBalance(dctData *dct.DCToken, lockedBalanceHandler vmcommon.DCTLockedBalanceHandler) error {
+	locked, err := lockedBalanceHandler.GetLockedBalance(dctData)
+	if err != nil {
+		return err
+	}
+	if dctData.Value.Cmp(locked) < 0 {
+		return ErrInsufficientUnlockedFunds
+	}
+
+	return nil
+}
+
 func checkFrozeAndPause(
 	senderAddr []byte,
 	key []byte,
@@ -270,6 +358,33 @@ func checkFrozeAndPause(
 	return nil
 }
 
+// checkLimitedTransfer verifies that the account holds the transfer role if the token has limited transfer set
+func checkLimitedTransfer(
+	account vmcommon.UserAccountHandler,
+	tokenID []byte,
+	dctTokenKey []byte,
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler,
+	rolesHandler vmcommon.DCTRoleHandler,
+	isReturnWithError bool,
+) error {
+	if isReturnWithError {
+		return nil
+	}
+	if bytes.Equal(account.AddressBytes(), vmcommon.DCTSCAddress) {
+		return nil
+	}
+	if !globalSettingsHandler.IsLimitedTransfer(dctTokenKey) {
+		return nil
+	}
+
+	err := rolesHandler.CheckAllowedToExecute(account, tokenID, []byte(vmcommon.DCTRoleTransfer))
+	if err != nil {
+		return ErrDCTTransferIsLimited
+	}
+
+	return nil
+}
+
 func arePropertiesEmpty(properties []byte) bool {
 	for _, property := range properties {
 		if property != 0 {
@@ -0,0 +1,309 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+	"sync"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/atomic"
+	"github.com/Dharitri-org/me-vm-common/check"
+)
+
+type dctTransferFrom struct {
+	*baseEnabled
+	funcGasCost           uint64
+	marshalizer           vmcommon.Marshalizer
+	keyPrefix             []byte
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler
+	rolesHandler          vmcommon.DCTRoleHandler
+	payableHandler        vmcommon.PayableHandler
+	accounts              vmcommon.AccountsAdapter
+	shardCoordinator      vmcommon.Coordinator
+	lockedBalanceHandler  vmcommon.DCTLockedBalanceHandler
+	mutExecution          sync.RWMutex
+}
+
+// NewDCTTransferFromFunc returns the dct transfer from built-in function component
+func NewDCTTransferFromFunc(
+	funcGasCost uint64,
+	marshalizer vmcommon.Marshalizer,
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler,
+	rolesHandler vmcommon.DCTRoleHandler,
+	accounts vmcommon.AccountsAdapter,
+	shardCoordinator vmcommon.Coordinator,
+	lockedBalanceHandler vmcommon.DCTLockedBalanceHandler,
+	activationEpoch uint32,
+	epochNotifier vmcommon.EpochNotifier,
+) (*dctTransferFrom, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(globalSettingsHandler) {
+		return nil, ErrNilGlobalSettingsHandler
+	}
+	if check.IfNil(rolesHandler) {
+		return nil, ErrNilRolesHandler
+	}
+	if check.IfNil(accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(shardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+	if check.IfNil(lockedBalanceHandler) {
+		return nil, ErrNilLockedBalanceHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, ErrNilEpochHandler
+	}
+
+	e := &dctTransferFrom{
+		funcGasCost:           funcGasCost,
+		marshalizer:           marshalizer,
+		keyPrefix:             []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
+		globalSettingsHandler: globalSettingsHandler,
+		rolesHandler:          rolesHandler,
+		payableHandler:        &disabledPayableHandler{},
+		accounts:              accounts,
+		shardCoordinator:      shardCoordinator,
+		mutExecution:          sync.RWMutex{},
+		lockedBalanceHandler:  lockedBalanceHandler,
+	}
+
+	e.baseEnabled = &baseEnabled{
+		function:        vmcommon.BuiltInFunctionDCTTransferFrom,
+		activationEpoch: activationEpoch,
+		flagActivated:   atomic.Flag{},
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetPayableHandler will set the payable handler to the function
+func (e *dctTransferFrom) SetPayableHandler(payableHandler vmcommon.PayableHandler) error {
+	if check.IfNil(payableHandler) {
+		return ErrNilPayableHandler
+	}
+
+	e.payableHandler = payableHandler
+	return nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *dctTransferFrom) SetNewGasConfig(gasCost *vmcommon.GasCost) {
+	if gasCost == nil {
+		return
+	}
+
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.DCTTransferFrom
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves DCT transfer from function call. The caller is the spender and the call is
+// sent to the owner, so that the allowance and the balance are updated in the shard of the owner
+// Requires 4 arguments:
+// arg0 - token identifier
+// arg1 - owner address, must be the recipient of the call
+// arg2 - address which receives the tokens
+// arg3 - value to transfer
+func (e *dctTransferFrom) ProcessBuiltinFunction(
+	acntSnd, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	err := checkBasicDCTArguments(vmInput)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) != vmcommon.MinLenArgumentsDCTTransferFrom {
+		return nil, ErrInvalidArguments
+	}
+
+	tokenID := vmInput.Arguments[0]
+	owner := vmInput.Arguments[1]
+	recipient := vmInput.Arguments[2]
+	if !bytes.Equal(owner, vmInput.RecipientAddr) {
+		return nil, ErrInvalidRcvAddr
+	}
+	if len(recipient) != len(owner) {
+		return nil, ErrInvalidArguments
+	}
+	if e.shardCoordinator.ComputeId(recipient) == vmcommon.MetachainShardId {
+		return nil, ErrInvalidRcvAddr
+	}
+
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[3])
+	if value.Cmp(zero) <= 0 {
+		return nil, ErrNegativeValue
+	}
+
+	// gas is paid only by the spender
+	if !check.IfNil(acntSnd) {
+		traceGas(vmInput, gasComponentFunction, 1, e.funcGasCost)
+		if vmInput.GasProvided < e.funcGasCost {
+			return nil, ErrNotEnoughGas
+		}
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		GasRemaining: computeGasRemaining(acntSnd, vmInput.GasProvided, e.funcGasCost),
+		ReturnCode:   vmcommon.Ok,
+	}
+	if check.IfNil(acntDst) {
+		// cross-shard DCT transfer from call through a smart contract
+		if vmcommon.IsSmartContractAddress(vmInput.CallerAddr) {
+			addOutputTransferToVMOutput(
+				vmInput.CallerAddr,
+				vmcommon.BuiltInFunctionDCTTransferFrom,
+				vmInput.Arguments,
+				vmInput.RecipientAddr,
+				vmInput.GasLocked,
+				vmInput.CallType,
+				vmOutput)
+		}
+
+		return vmOutput, nil
+	}
+
+	remainingAllowance, err := e.spendFromOwner(acntDst, vmInput.CallerAddr, tokenID, value, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.addToRecipient(acntSnd, acntDst, vmInput, vmOutput, recipient, value)
+	if err != nil {
+		return nil, err
+	}
+
+	logEntry := newEntryForDCT([]byte(vmcommon.BuiltInFunctionDCTTransferFrom), tokenID, value, vmInput.CallerAddr, owner)
+	logEntry.Topics = append(logEntry.Topics, recipient, remainingAllowance.Bytes())
+	vmOutput.Logs = append(vmOutput.Logs, logEntry)
+
+	return vmOutput, nil
+}
+
+func (e *dctTransferFrom) spendFromOwner(
+	acntOwner vmcommon.UserAccountHandler,
+	spender []byte,
+	tokenID []byte,
+	value *big.Int,
+	isReturnWithError bool,
+) (*big.Int, error) {
+	allowance, err := getAllowance(acntOwner, tokenID, spender)
+	if err != nil {
+		return nil, err
+	}
+	if allowance.Cmp(value) < 0 {
+		return nil, ErrInsufficientAllowance
+	}
+
+	dctTokenKey := append(e.keyPrefix, tokenID...)
+	err = checkLimitedTransfer(acntOwner, tokenID, dctTokenKey, e.globalSettingsHandler, e.rolesHandler, isReturnWithError)
+	if err != nil {
+		return nil, err
+	}
+
+	err = addToDCTBalance(acntOwner, dctTokenKey, big.NewInt(0).Neg(value), e.marshalizer, e.globalSettingsHandler, e.lockedBalanceHandler, isReturnWithError)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance.Sub(allowance, value)
+	err = saveAllowance(acntOwner, tokenID, spender, allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return allowance, nil
+}
+
+func (e *dctTransferFrom) addToRecipient(
+	acntSnd vmcommon.UserAccountHandler,
+	acntOwner vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+	vmOutput *vmcommon.VMOutput,
+	recipient []byte,
+	value *big.Int,
+) error {
+	tokenID := vmInput.Arguments[0]
+	if e.shardCoordinator.SelfId() != e.shardCoordinator.ComputeId(recipient) {
+		addOutputTransferToVMOutput(
+			acntOwner.AddressBytes(),
+			vmcommon.BuiltInFunctionDCTTransfer,
+			[][]byte{tokenID, value.Bytes()},
+			recipient,
+			vmInput.GasLocked,
+			vmInput.CallType,
+			vmOutput)
+		return nil
+	}
+
+	acntRecipient, mustSave, err := e.getRecipientAccount(acntSnd, acntOwner, recipient)
+	if err != nil {
+		return err
+	}
+
+	if mustVerifyPayable(vmInput, vmcommon.MinLenArgumentsDCTTransferFrom) {
+		isPayable, errPayable := e.payableHandler.IsPayable(recipient)
+		if errPayable != nil {
+			return errPayable
+		}
+		if !isPayable {
+			return ErrAccountNotPayable
+		}
+	}
+
+	dctTokenKey := append(e.keyPrefix, tokenID...)
+	err = checkLimitedTransfer(acntRecipient, tokenID, dctTokenKey, e.globalSettingsHandler, e.rolesHandler, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return err
+	}
+
+	err = addToDCTBalance(acntRecipient, dctTokenKey, value, e.marshalizer, e.globalSettingsHandler, e.lockedBalanceHandler, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return err
+	}
+
+	if !mustSave {
+		return nil
+	}
+
+	return e.accounts.SaveAccount(acntRecipient)
+}
+
+// getRecipientAccount returns the already loaded account if the recipient is the spender or the owner. The
+// returned bool is true if the account was loaded here and must be saved
+func (e *dctTransferFrom) getRecipientAccount(
+	acntSnd vmcommon.UserAccountHandler,
+	acntOwner vmcommon.UserAccountHandler,
+	recipient []byte,
+) (vmcommon.UserAccountHandler, bool, error) {
+	if bytes.Equal(recipient, acntOwner.AddressBytes()) {
+		return acntOwner, false, nil
+	}
+	if !check.IfNil(acntSnd) && bytes.Equal(recipient, acntSnd.AddressBytes()) {
+		return acntSnd, false, nil
+	}
+
+	accountHandler, err := e.accounts.LoadAccount(recipient)
+	if err != nil {
+		return nil, false, err
+	}
+	userAccount, ok := accountHandler.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil, false, ErrWrongTypeAssertion
+	}
+
+	return userAccount, true, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *dctTransferFrom) IsInterfaceNil() bool {
+	return e == nil
+}
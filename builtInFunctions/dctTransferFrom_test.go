@@ -0,0 +1,274 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTransferFromWithMockArguments(selfShard uint32) (*dctTransferFrom, map[string]vmcommon.UserAccountHandler) {
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.CurrentShard = selfShard
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		return uint32(address[len(address)-1])
+	}
+	mapAccounts := make(map[string]vmcommon.UserAccountHandler)
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			_, ok := mapAccounts[string(address)]
+			if !ok {
+				mapAccounts[string(address)] = mock.NewUserAccount(address)
+			}
+			return mapAccounts[string(address)], nil
+		},
+	}
+
+	transferFrom, _ := NewDCTTransferFromFunc(
+		10,
+		&mock.MarshalizerMock{},
+		&mock.GlobalSettingsHandlerStub{},
+		&mock.DCTRoleHandlerStub{},
+		accounts,
+		shardCoordinator,
+		&mock.LockedBalanceHandlerStub{},
+		0,
+		&mock.EpochNotifierStub{},
+	)
+	_ = transferFrom.SetPayableHandler(&mock.PayableHandlerStub{})
+
+	return transferFrom, mapAccounts
+}
+
+func setTransferFromBalance(t *testing.T, account vmcommon.UserAccountHandler, tokenID []byte, value int64) {
+	marshaledData, _ := (&mock.MarshalizerMock{}).Marshal(&dct.DCToken{Value: big.NewInt(value)})
+	err := account.AccountDataHandler().SaveKeyValue(append(keyPrefix, tokenID...), marshaledData)
+	require.Nil(t, err)
+}
+
+func createTransferFromInput(spender, owner, recipient []byte, value int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  spender,
+			CallValue:   big.NewInt(0),
+			GasProvided: 100,
+			Arguments:   [][]byte{[]byte("TKN"), owner, recipient, big.NewInt(value).Bytes()},
+		},
+		RecipientAddr: owner,
+	}
+}
+
+func TestNewDCTTransferFromFunc(t *testing.T) {
+	t.Parallel()
+
+	e, err := NewDCTTransferFromFunc(10, nil, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.AccountsStub{}, &mock.ShardCoordinatorStub{}, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	e, err = NewDCTTransferFromFunc(10, &mock.MarshalizerMock{}, nil, &mock.DCTRoleHandlerStub{}, &mock.AccountsStub{}, &mock.ShardCoordinatorStub{}, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilGlobalSettingsHandler, err)
+
+	e, err = NewDCTTransferFromFunc(10, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, nil, &mock.AccountsStub{}, &mock.ShardCoordinatorStub{}, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilRolesHandler, err)
+
+	e, err = NewDCTTransferFromFunc(10, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, nil, &mock.ShardCoordinatorStub{}, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+
+	e, err = NewDCTTransferFromFunc(10, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.AccountsStub{}, nil, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilShardCoordinator, err)
+
+	e, err = NewDCTTransferFromFunc(10, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.AccountsStub{}, &mock.ShardCoordinatorStub{}, &mock.LockedBalanceHandlerStub{}, 0, nil)
+	assert.True(t, check.IfNil(e))
+	assert.Equal(t, ErrNilEpochHandler, err)
+
+	e, err = NewDCTTransferFromFunc(10, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.AccountsStub{}, &mock.ShardCoordinatorStub{}, &mock.LockedBalanceHandlerStub{}, 1, &mock.EpochNotifierStub{})
+	assert.False(t, check.IfNil(e))
+	assert.Nil(t, err)
+	assert.False(t, e.IsActive())
+
+	assert.Equal(t, ErrNilPayableHandler, e.SetPayableHandler(nil))
+	assert.Nil(t, e.SetPayableHandler(&mock.PayableHandlerStub{}))
+}
+
+func TestDCTTransferFrom_SetNewGasConfig(t *testing.T) {
+	t.Parallel()
+
+	e, _ := createTransferFromWithMockArguments(0)
+	e.SetNewGasConfig(nil)
+	assert.Equal(t, uint64(10), e.funcGasCost)
+
+	e.SetNewGasConfig(&vmcommon.GasCost{BuiltInCost: vmcommon.BuiltInCost{DCTTransferFrom: 37}})
+	assert.Equal(t, uint64(37), e.funcGasCost)
+}
+
+func TestDCTTransferFrom_ProcessBuiltinFunctionInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	e, _ := createTransferFromWithMockArguments(0)
+	spender := createSettleSaleAddress(1, 0)
+	owner := createSettleSaleAddress(2, 0)
+	recipient := createSettleSaleAddress(3, 0)
+
+	output, err := e.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Nil(t, output)
+	assert.Equal(t, ErrNilVmInput, err)
+
+	input := createTransferFromInput(spender, owner, recipient, 10)
+	input.Arguments = input.Arguments[:3]
+	output, err = e.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, output)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	input = createTransferFromInput(spender, owner, recipient, 10)
+	input.RecipientAddr = recipient
+	output, err = e.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, output)
+	assert.Equal(t, ErrInvalidRcvAddr, err)
+
+	input = createTransferFromInput(spender, owner, []byte("short"), 10)
+	output, err = e.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, output)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	input = createTransferFromInput(spender, owner, recipient, 0)
+	output, err = e.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, output)
+	assert.Equal(t, ErrNegativeValue, err)
+
+	input = createTransferFromInput(spender, owner, recipient, 10)
+	input.GasProvided = 1
+	output, err = e.ProcessBuiltinFunction(mock.NewUserAccount(spender), nil, input)
+	assert.Nil(t, output)
+	assert.Equal(t, ErrNotEnoughGas, err)
+}
+
+func TestDCTTransferFrom_ProcessBuiltinFunctionInsufficientAllowanceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	e, _ := createTransferFromWithMockArguments(0)
+	spender := mock.NewUserAccount(createSettleSaleAddress(1, 0))
+	owner := mock.NewUserAccount(createSettleSaleAddress(2, 0))
+	recipient := createSettleSaleAddress(3, 0)
+	setTransferFromBalance(t, owner, []byte("TKN"), 100)
+	_ = saveAllowance(owner, []byte("TKN"), spender.AddressBytes(), big.NewInt(5))
+
+	input := createTransferFromInput(spender.AddressBytes(), owner.AddressBytes(), recipient, 10)
+	output, err := e.ProcessBuiltinFunction(spender, owner, input)
+	assert.Nil(t, output)
+	assert.Equal(t, ErrInsufficientAllowance, err)
+}
+
+func TestDCTTransferFrom_ProcessBuiltinFunctionInsufficientBalanceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	e, _ := createTransferFromWithMockArguments(0)
+	spender := mock.NewUserAccount(createSettleSaleAddress(1, 0))
+	owner := mock.NewUserAccount(createSettleSaleAddress(2, 0))
+	recipient := createSettleSaleAddress(3, 0)
+	setTransferFromBalance(t, owner, []byte("TKN"), 5)
+	_ = saveAllowance(owner, []byte("TKN"), spender.AddressBytes(), big.NewInt(50))
+
+	input := createTransferFromInput(spender.AddressBytes(), owner.AddressBytes(), recipient, 10)
+	output, err := e.ProcessBuiltinFunction(spender, owner, input)
+	assert.Nil(t, output)
+	assert.Equal(t, ErrInsufficientFunds, err)
+}
+
+func TestDCTTransferFrom_ProcessBuiltinFunctionOnSameShardShouldWork(t *testing.T) {
+	t.Parallel()
+
+	e, mapAccounts := createTransferFromWithMockArguments(0)
+	spender := mock.NewUserAccount(createSettleSaleAddress(1, 0))
+	owner := mock.NewUserAccount(createSettleSaleAddress(2, 0))
+	recipient := createSettleSaleAddress(3, 0)
+	setTransferFromBalance(t, owner, []byte("TKN"), 100)
+	_ = saveAllowance(owner, []byte("TKN"), spender.AddressBytes(), big.NewInt(50))
+
+	input := createTransferFromInput(spender.AddressBytes(), owner.AddressBytes(), recipient, 30)
+	output, err := e.ProcessBuiltinFunction(spender, owner, input)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(90), output.GasRemaining)
+	assert.Equal(t, 0, len(output.OutputAccounts))
+
+	assert.Equal(t, big.NewInt(70), getSettleSaleBalance(owner, []byte("TKN")))
+	assert.Equal(t, big.NewInt(30), getSettleSaleBalance(mapAccounts[string(recipient)], []byte("TKN")))
+	allowance, _ := getAllowance(owner, []byte("TKN"), spender.AddressBytes())
+	assert.Equal(t, big.NewInt(20), allowance)
+
+	require.Equal(t, 1, len(output.Logs))
+	assert.Equal(t, []byte(vmcommon.BuiltInFunctionDCTTransferFrom), output.Logs[0].Identifier)
+	assert.Equal(t, spender.AddressBytes(), output.Logs[0].Address)
+	assert.Equal(t, [][]byte{[]byte("TKN"), big.NewInt(30).Bytes(), owner.AddressBytes(), recipient, big.NewInt(20).Bytes()}, output.Logs[0].Topics)
+
+	input = createTransferFromInput(spender.AddressBytes(), owner.AddressBytes(), spender.AddressBytes(), 20)
+	_, err = e.ProcessBuiltinFunction(spender, owner, input)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(50), getSettleSaleBalance(owner, []byte("TKN")))
+	assert.Equal(t, big.NewInt(20), getSettleSaleBalance(spender, []byte("TKN")))
+
+	input = createTransferFromInput(spender.AddressBytes(), owner.AddressBytes(), recipient, 1)
+	_, err = e.ProcessBuiltinFunction(spender, owner, input)
+	assert.Equal(t, ErrInsufficientAllowance, err)
+}
+
+func TestDCTTransferFrom_ProcessBuiltinFunctionRecipientNotPayableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	e, _ := createTransferFromWithMockArguments(0)
+	_ = e.SetPayableHandler(&mock.PayableHandlerStub{
+		IsPayableCalled: func(address []byte) (bool, error) {
+			return false, nil
+		},
+	})
+	spender := mock.NewUserAccount(createSettleSaleAddress(1, 0))
+	owner := mock.NewUserAccount(createSettleSaleAddress(2, 0))
+	recipient := make([]byte, 32)
+	setTransferFromBalance(t, owner, []byte("TKN"), 100)
+	_ = saveAllowance(owner, []byte("TKN"), spender.AddressBytes(), big.NewInt(50))
+
+	input := createTransferFromInput(spender.AddressBytes(), owner.AddressBytes(), recipient, 30)
+	output, err := e.ProcessBuiltinFunction(spender, owner, input)
+	assert.Nil(t, output)
+	assert.Equal(t, ErrAccountNotPayable, err)
+}
+
+func TestDCTTransferFrom_ProcessBuiltinFunctionCrossShard(t *testing.T) {
+	t.Parallel()
+
+	spender := createSettleSaleAddress(1, 0)
+	owner := createSettleSaleAddress(2, 1)
+	recipient := createSettleSaleAddress(3, 0)
+
+	eSender, _ := createTransferFromWithMockArguments(0)
+	input := createTransferFromInput(spender, owner, recipient, 30)
+	output, err := eSender.ProcessBuiltinFunction(mock.NewUserAccount(spender), nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(90), output.GasRemaining)
+	assert.Equal(t, 0, len(output.OutputAccounts))
+
+	eOwner, _ := createTransferFromWithMockArguments(1)
+	acntOwner := mock.NewUserAccount(owner)
+	setTransferFromBalance(t, acntOwner, []byte("TKN"), 100)
+	_ = saveAllowance(acntOwner, []byte("TKN"), spender, big.NewInt(50))
+
+	output, err = eOwner.ProcessBuiltinFunction(nil, acntOwner, input)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(70), getSettleSaleBalance(acntOwner, []byte("TKN")))
+
+	require.Equal(t, 1, len(output.OutputAccounts))
+	outAcc := output.OutputAccounts[string(recipient)]
+	require.NotNil(t, outAcc)
+	require.Equal(t, 1, len(outAcc.OutputTransfers))
+	expectedData := []byte(vmcommon.BuiltInFunctionDCTTransfer + "@" + "544b4e" + "@" + "1e")
+	assert.Equal(t, expectedData, outAcc.OutputTransfers[0].Data)
+	assert.Equal(t, owner, outAcc.OutputTransfers[0].SenderAddress)
+}
@@ -15,7 +15,7 @@ func TestDCTTransfer_ProcessBuiltInFunctionErrors(t *testing.T) {
 	t.Parallel()
 
 	shardC := &mock.ShardCoordinatorStub{}
-	transferFunc, _ := NewDCTTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, shardC)
+	transferFunc, _ := NewDCTTransferFunc(10, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, shardC, &mock.LockedBalanceHandlerStub{})
 	_ = transferFunc.SetPayableHandler(&mock.PayableHandlerStub{})
 	_, err := transferFunc.ProcessBuiltinFunction(nil, nil, nil)
 	assert.Equal(t, err, ErrNilVmInput)
@@ -58,7 +58,7 @@ func TestDCTTransfer_ProcessBuiltInFunctionSingleShard(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	transferFunc, _ := NewDCTTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, &mock.ShardCoordinatorStub{})
+	transferFunc, _ := NewDCTTransferFunc(10, marshalizer, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.ShardCoordinatorStub{}, &mock.LockedBalanceHandlerStub{})
 	_ = transferFunc.SetPayableHandler(&mock.PayableHandlerStub{})
 
 	input := &vmcommon.ContractCallInput{
@@ -96,7 +96,7 @@ func TestDCTTransfer_ProcessBuiltInFunctionSenderInShard(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	transferFunc, _ := NewDCTTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, &mock.ShardCoordinatorStub{})
+	transferFunc, _ := NewDCTTransferFunc(10, marshalizer, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.ShardCoordinatorStub{}, &mock.LockedBalanceHandlerStub{})
 	_ = transferFunc.SetPayableHandler(&mock.PayableHandlerStub{})
 
 	input := &vmcommon.ContractCallInput{
@@ -126,7 +126,7 @@ func TestDCTTransfer_ProcessBuiltInFunctionDestInShard(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	transferFunc, _ := NewDCTTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, &mock.ShardCoordinatorStub{})
+	transferFunc, _ := NewDCTTransferFunc(10, marshalizer, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.ShardCoordinatorStub{}, &mock.LockedBalanceHandlerStub{})
 	_ = transferFunc.SetPayableHandler(&mock.PayableHandlerStub{})
 
 	input := &vmcommon.ContractCallInput{
@@ -156,7 +156,7 @@ func TestDCTTransfer_SndDstFrozen(t *testing.T) {
 	marshalizer := &mock.MarshalizerMock{}
 	accountStub := &mock.AccountsStub{}
 	dctPauseFunc, _ := NewDCTPauseFunc(accountStub, true)
-	transferFunc, _ := NewDCTTransferFunc(10, marshalizer, dctPauseFunc, &mock.ShardCoordinatorStub{})
+	transferFunc, _ := NewDCTTransferFunc(10, marshalizer, dctPauseFunc, &mock.DCTRoleHandlerStub{}, &mock.ShardCoordinatorStub{}, &mock.LockedBalanceHandlerStub{})
 	_ = transferFunc.SetPayableHandler(&mock.PayableHandlerStub{})
 
 	input := &vmcommon.ContractCallInput{
@@ -230,7 +230,7 @@ func TestDCTTransfer_ProcessBuiltInFunctionOnAsyncCallBack(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	transferFunc, _ := NewDCTTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, &mock.ShardCoordinatorStub{})
+	transferFunc, _ := NewDCTTransferFunc(10, marshalizer, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.ShardCoordinatorStub{}, &mock.LockedBalanceHandlerStub{})
 	_ = transferFunc.SetPayableHandler(&mock.PayableHandlerStub{})
 
 	input := &vmcommon.ContractCallInput{
@@ -268,3 +268,65 @@ func TestDCTTransfer_ProcessBuiltInFunctionOnAsyncCallBack(t *testing.T) {
 	_ = marshalizer.Unmarshal(dctToken, marshaledData)
 	assert.True(t, dctToken.Value.Cmp(big.NewInt(90)) == 0)
 }
+
+func TestDCTTransfer_ProcessBuiltInFunctionLimitedTransfer(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	accountsWithRole := make(map[string]bool)
+	rolesHandler := &mock.DCTRoleHandlerStub{
+		CheckAllowedToExecuteCalled: func(account vmcommon.UserAccountHandler, tokenID []byte, action []byte) error {
+			assert.Equal(t, []byte(vmcommon.DCTRoleTransfer), action)
+			if accountsWithRole[string(account.AddressBytes())] {
+				return nil
+			}
+			return ErrActionNotAllowed
+		},
+	}
+	globalSettingsHandler := &mock.GlobalSettingsHandlerStub{
+		IsLimitedTransferCalled: func(_ []byte) bool {
+			return true
+		},
+	}
+	transferFunc, _ := NewDCTTransferFunc(10, marshalizer, globalSettingsHandler, rolesHandler, &mock.ShardCoordinatorStub{}, &mock.LockedBalanceHandlerStub{})
+	_ = transferFunc.SetPayableHandler(&mock.PayableHandlerStub{})
+
+	key := []byte("key")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			GasProvided: 50,
+			CallValue:   big.NewInt(0),
+			Arguments:   [][]byte{key, big.NewInt(10).Bytes()},
+		},
+	}
+	accSnd := mock.NewUserAccount([]byte("snd"))
+	accDst := mock.NewUserAccount([]byte("dst"))
+
+	dctKey := append(transferFunc.keyPrefix, key...)
+	dctToken := &dct.DCToken{Value: big.NewInt(100)}
+	marshaledData, _ := marshalizer.Marshal(dctToken)
+	_ = accSnd.AccountDataHandler().SaveKeyValue(dctKey, marshaledData)
+
+	_, err := transferFunc.ProcessBuiltinFunction(accSnd, accDst, input)
+	assert.Equal(t, ErrDCTTransferIsLimited, err)
+
+	accountsWithRole["snd"] = true
+	_, err = transferFunc.ProcessBuiltinFunction(accSnd, accDst, input)
+	assert.Equal(t, ErrDCTTransferIsLimited, err)
+
+	_, err = transferFunc.ProcessBuiltinFunction(nil, accDst, input)
+	assert.Equal(t, ErrDCTTransferIsLimited, err)
+
+	accountsWithRole["dst"] = true
+	_, err = transferFunc.ProcessBuiltinFunction(accSnd, accDst, input)
+	assert.Nil(t, err)
+
+	marshaledData, _ = accDst.AccountDataHandler().RetrieveValue(dctKey)
+	_ = marshalizer.Unmarshal(dctToken, marshaledData)
+	assert.True(t, dctToken.Value.Cmp(big.NewInt(10)) == 0)
+
+	delete(accountsWithRole, "dst")
+	input.ReturnCallAfterError = true
+	_, err = transferFunc.ProcessBuiltinFunction(nil, accDst, input)
+	assert.Nil(t, err)
+}
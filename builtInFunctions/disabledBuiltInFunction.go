@@ -0,0 +1,37 @@
+package builtInFunctions
+
+import (
+	"fmt"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+)
+
+// disabledBuiltInFunction stands in for a versioned built in function when none of its versions is valid in the
+// current epoch. It is never active, so the call is not routed to it, and it refuses to be executed
+type disabledBuiltInFunction struct {
+	function string
+}
+
+// ProcessBuiltinFunction returns error as there is no version of the function valid in the current epoch
+func (d *disabledBuiltInFunction) ProcessBuiltinFunction(_, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	return nil, fmt.Errorf("%w, function %s", ErrNoVersionForEpoch, d.function)
+}
+
+// SetNewGasConfig does nothing as this is a disabled built in function
+func (d *disabledBuiltInFunction) SetNewGasConfig(_ *vmcommon.GasCost) {
+}
+
+// SetPayableHandler does nothing as this is a disabled built in function
+func (d *disabledBuiltInFunction) SetPayableHandler(_ vmcommon.PayableHandler) error {
+	return nil
+}
+
+// IsActive returns false as this is a disabled built in function
+func (d *disabledBuiltInFunction) IsActive() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (d *disabledBuiltInFunction) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -0,0 +1,87 @@
+package builtInFunctions
+
+import (
+	"fmt"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+)
+
+// BuiltInFunctionEnableEpochs holds the epochs in which a built in function is enabled. The function is active from
+// the activation epoch until, excluding, the deactivation epoch. A zero deactivation epoch means the function is
+// never deactivated
+type BuiltInFunctionEnableEpochs struct {
+	ActivationEpoch   uint32
+	DeactivationEpoch uint32
+}
+
+func checkBuiltInFunctionsEnableEpochs(enableEpochsConfig map[string]BuiltInFunctionEnableEpochs) error {
+	for name, enableEpochs := range enableEpochsConfig {
+		if enableEpochs.DeactivationEpoch > 0 && enableEpochs.DeactivationEpoch <= enableEpochs.ActivationEpoch {
+			return fmt.Errorf("%w for %s", ErrInvalidEnableEpochs, name)
+		}
+	}
+
+	return nil
+}
+
+// legacyActivationEpochs returns, for each built in function activated by one of the per feature enable epoch
+// arguments, the activation epoch that argument sets
+func legacyActivationEpochs(args ArgsCreateBuiltInFunctionContainer) map[string]uint32 {
+	return map[string]uint32{
+		vmcommon.BuiltInFunctionDCTNFTUpdateAttributes: args.DCTNFTImprovementV1ActivationEpoch,
+		vmcommon.BuiltInFunctionDCTNFTAddURI:           args.DCTNFTImprovementV1ActivationEpoch,
+		vmcommon.BuiltInFunctionMultiDCTNFTTransfer:    args.DCTNFTImprovementV1ActivationEpoch,
+		vmcommon.BuiltInFunctionDCTNFTSettleSale:       args.DCTNFTSettleSaleEnableEpoch,
+		vmcommon.BuiltInFunctionDCTModifyRoyalties:     args.DCTMetaDataModifyEnableEpoch,
+		vmcommon.BuiltInFunctionDCTSetNewURIs:          args.DCTMetaDataModifyEnableEpoch,
+		vmcommon.BuiltInFunctionDCTNFTRecreate:         args.DCTMetaDataModifyEnableEpoch,
+		vmcommon.BuiltInFunctionDCTSetMaxSupply:        args.DCTMaxSupplyEnableEpoch,
+		vmcommon.BuiltInFunctionDCTAddMintAllowance:    args.DCTMaxSupplyEnableEpoch,
+		vmcommon.BuiltInFunctionDCTApprove:             args.DCTAllowanceEnableEpoch,
+		vmcommon.BuiltInFunctionDCTTransferFrom:        args.DCTAllowanceEnableEpoch,
+		vmcommon.BuiltInFunctionDCTLockedTransfer:      args.DCTLockedBalanceEnableEpoch,
+		vmcommon.BuiltInFunctionDCTClaimUnlocked:       args.DCTLockedBalanceEnableEpoch,
+		vmcommon.BuiltInFunctionDCTNFTCreateBatch:      args.DCTNFTCreateBatchEnableEpoch,
+		vmcommon.BuiltInFunctionDCTMultiDistribute:     args.DCTMultiDistributeEnableEpoch,
+		vmcommon.BuiltInFunctionDCTMintTransfer:        args.DCTMintTransferEnableEpoch,
+	}
+}
+
+// checkLegacyActivationEpochs rejects a per feature enable epoch argument which would activate a built in function in
+// another epoch than its entry in the enable epochs map. The map is authoritative for the functions it lists, so the
+// argument has to be left at zero or set to the same activation epoch
+func checkLegacyActivationEpochs(args ArgsCreateBuiltInFunctionContainer) error {
+	legacyEpochs := legacyActivationEpochs(args)
+	for name, enableEpochs := range args.BuiltInFunctionsEnableEpochs {
+		legacyEpoch, ok := legacyEpochs[name]
+		if !ok || legacyEpoch == 0 || legacyEpoch == enableEpochs.ActivationEpoch {
+			continue
+		}
+
+		return fmt.Errorf("%w for %s: activation epoch %d in the enable epochs map, %d in the per feature argument",
+			ErrConflictingEnableEpochs, name, enableEpochs.ActivationEpoch, legacyEpoch)
+	}
+
+	return nil
+}
+
+// applyEnableEpochs gates every configured built in function by its enable epochs. The gating adds up with the
+// activation epochs the function might already have, which checkLegacyActivationEpochs keeps from contradicting the configured
+// ones, and applies to all the versions of a versioned function
+func (b *builtInFuncFactory) applyEnableEpochs() error {
+	for name, enableEpochs := range b.builtInFunctionsEnableEpochs {
+		_, exists := b.builtInFunctions.Keys()[name]
+		if !exists {
+			return fmt.Errorf("%w: %s", ErrUnknownBuiltInFunction, name)
+		}
+
+		err := b.builtInFunctions.wrap(name, func(builtInFunc vmcommon.BuiltinFunction) vmcommon.BuiltinFunction {
+			return newEpochGatedBuiltInFunction(name, builtInFunc, enableEpochs, b.epochNotifier)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
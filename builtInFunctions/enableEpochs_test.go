@@ -0,0 +1,123 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"testing"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBaseEnabled_EpochConfirmedWithDeactivationEpoch(t *testing.T) {
+	t.Parallel()
+
+	b := &baseEnabled{activationEpoch: 2, deactivationEpoch: 4}
+	for epoch, expected := range []bool{false, false, true, true, false, false} {
+		b.EpochConfirmed(uint32(epoch), 0)
+		assert.Equal(t, expected, b.IsActive(), "epoch %d", epoch)
+	}
+
+	b = &baseEnabled{activationEpoch: 2}
+	b.EpochConfirmed(1000, 0)
+	assert.True(t, b.IsActive())
+}
+
+func TestNewBuiltInFunctionsFactory_InvalidEnableEpochs(t *testing.T) {
+	t.Parallel()
+
+	args := createFactoryArgs(nil, &mock.EpochNotifierStub{})
+	args.BuiltInFunctionsEnableEpochs = map[string]BuiltInFunctionEnableEpochs{
+		vmcommon.BuiltInFunctionDCTTransfer: {ActivationEpoch: 4, DeactivationEpoch: 4},
+	}
+
+	_, err := NewBuiltInFunctionsFactory(args)
+	assert.True(t, errors.Is(err, ErrInvalidEnableEpochs))
+}
+
+func TestNewBuiltInFunctionsFactory_ConflictingLegacyEnableEpochs(t *testing.T) {
+	t.Parallel()
+
+	args := createFactoryArgs(nil, &mock.EpochNotifierStub{})
+	args.DCTAllowanceEnableEpoch = 3
+	args.BuiltInFunctionsEnableEpochs = map[string]BuiltInFunctionEnableEpochs{
+		vmcommon.BuiltInFunctionDCTTransferFrom: {ActivationEpoch: 5},
+	}
+
+	_, err := NewBuiltInFunctionsFactory(args)
+	assert.True(t, errors.Is(err, ErrConflictingEnableEpochs))
+
+	args.BuiltInFunctionsEnableEpochs[vmcommon.BuiltInFunctionDCTTransferFrom] = BuiltInFunctionEnableEpochs{ActivationEpoch: 3, DeactivationEpoch: 7}
+	_, err = NewBuiltInFunctionsFactory(args)
+	assert.Nil(t, err)
+
+	args.DCTAllowanceEnableEpoch = 0
+	args.BuiltInFunctionsEnableEpochs[vmcommon.BuiltInFunctionDCTTransferFrom] = BuiltInFunctionEnableEpochs{ActivationEpoch: 5}
+	_, err = NewBuiltInFunctionsFactory(args)
+	assert.Nil(t, err, "the map alone sets the activation epoch")
+
+	args.DCTAllowanceEnableEpoch = 3
+	args.BuiltInFunctionsEnableEpochs = map[string]BuiltInFunctionEnableEpochs{
+		vmcommon.BuiltInFunctionDCTTransfer: {ActivationEpoch: 5},
+	}
+	_, err = NewBuiltInFunctionsFactory(args)
+	assert.Nil(t, err, "the argument does not activate the function")
+}
+
+func TestBuiltInFuncFactory_CreateBuiltInFunctionContainerAppliesEnableEpochs(t *testing.T) {
+	t.Parallel()
+
+	var epochHandlers []vmcommon.EpochSubscriberHandler
+	epochNotifier := &mock.EpochNotifierStub{
+		RegisterNotifyHandlerCalled: func(handler vmcommon.EpochSubscriberHandler) {
+			epochHandlers = append(epochHandlers, handler)
+			handler.EpochConfirmed(0, 0)
+		},
+	}
+	args := createFactoryArgs(nil, epochNotifier)
+	args.BuiltInFunctionsEnableEpochs = map[string]BuiltInFunctionEnableEpochs{
+		vmcommon.BuiltInFunctionDCTTransfer: {ActivationEpoch: 2, DeactivationEpoch: 4},
+		vmcommon.BuiltInFunctionDCTBurn:     {DeactivationEpoch: 3},
+	}
+	factory, err := NewBuiltInFunctionsFactory(args)
+	require.Nil(t, err)
+
+	container, err := factory.CreateBuiltInFunctionContainer()
+	require.Nil(t, err)
+	transferFunc, _ := container.Get(vmcommon.BuiltInFunctionDCTTransfer)
+	burnFunc, _ := container.Get(vmcommon.BuiltInFunctionDCTBurn)
+	localMintFunc, _ := container.Get(vmcommon.BuiltInFunctionDCTLocalMint)
+
+	confirmEpoch := func(epoch uint32) {
+		for _, handler := range epochHandlers {
+			handler.EpochConfirmed(epoch, 0)
+		}
+	}
+	confirmEpoch(0)
+	assert.False(t, transferFunc.IsActive())
+	assert.True(t, burnFunc.IsActive())
+	confirmEpoch(3)
+	assert.True(t, transferFunc.IsActive())
+	assert.False(t, burnFunc.IsActive())
+	confirmEpoch(4)
+	assert.False(t, transferFunc.IsActive())
+	assert.True(t, localMintFunc.IsActive())
+
+	err = SetPayableHandler(container, &mock.PayableHandlerStub{})
+	assert.Nil(t, err)
+}
+
+func TestBuiltInFuncFactory_CreateBuiltInFunctionContainerUnknownEnableEpochs(t *testing.T) {
+	t.Parallel()
+
+	args := createFactoryArgs(nil, &mock.EpochNotifierStub{})
+	args.BuiltInFunctionsEnableEpochs = map[string]BuiltInFunctionEnableEpochs{
+		"DCTTransferr": {ActivationEpoch: 1},
+	}
+	factory, err := NewBuiltInFunctionsFactory(args)
+	require.Nil(t, err)
+
+	_, err = factory.CreateBuiltInFunctionContainer()
+	assert.True(t, errors.Is(err, ErrUnknownBuiltInFunction))
+}
@@ -208,3 +208,88 @@ var ErrWrongTypeInContainer = errors.New("wrong type of object inside container"
 
 // ErrEmptyFunctionName signals that an empty function name has been provided
 var ErrEmptyFunctionName = errors.New("empty function name")
+
+// ErrNilGlobalSettingsHandler signals that nil global settings handler has been provided
+var ErrNilGlobalSettingsHandler = errors.New("nil global settings handler")
+
+// ErrInvalidNonFungibleQuantity signals that a quantity different from 1 was provided for a non fungible token
+var ErrInvalidNonFungibleQuantity = errors.New("invalid quantity for non fungible token, must be 1")
+
+// ErrAddQuantityNotAllowedForTokenType signals that add quantity is not allowed for the given token type
+var ErrAddQuantityNotAllowedForTokenType = errors.New("add quantity is not allowed for this token type")
+
+// ErrInvalidNumOfDecimals signals that an invalid number of decimals was provided
+var ErrInvalidNumOfDecimals = errors.New("invalid number of decimals")
+
+// ErrDCTTransferIsLimited signals that the token has limited transfer and the account does not hold the transfer role
+var ErrDCTTransferIsLimited = errors.New("dct transfer is limited to the accounts holding the transfer role")
+
+// ErrNilSupplyHandler signals that nil supply handler has been provided
+var ErrNilSupplyHandler = errors.New("nil supply handler")
+
+// ErrNilDCTNFTStorageHandler signals that nil dct NFT storage handler has been provided
+var ErrNilDCTNFTStorageHandler = errors.New("nil dct NFT storage handler")
+
+// ErrInvalidSaleParticipant signals that an invalid buyer or seller address was provided
+var ErrInvalidSaleParticipant = errors.New("invalid sale participant")
+
+// ErrMaxSupplyExceeded signals that the mint allowance granted to the shard out of the max supply is not enough
+var ErrMaxSupplyExceeded = errors.New("max supply exceeded, not enough mint allowance")
+
+// ErrInsufficientAllowance signals that the allowance of the spender is lower than the value to transfer
+var ErrInsufficientAllowance = errors.New("insufficient allowance")
+
+// ErrNilLockedBalanceHandler signals that nil locked balance handler has been provided
+var ErrNilLockedBalanceHandler = errors.New("nil locked balance handler")
+
+// ErrInsufficientUnlockedFunds signals that the operation would spend locked funds
+var ErrInsufficientUnlockedFunds = errors.New("insufficient unlocked funds")
+
+// ErrInvalidUnlockEpoch signals that the unlock epoch is not in the future
+var ErrInvalidUnlockEpoch = errors.New("invalid unlock epoch")
+
+// ErrTooManyLockedAmounts signals that the token balance already holds the maximum number of pending locked amounts
+var ErrTooManyLockedAmounts = errors.New("too many locked amounts")
+
+// ErrNilDCTData signals that nil dct data has been provided
+var ErrNilDCTData = errors.New("nil dct data")
+
+// ErrInvalidRole signals that an unknown dct role was provided
+var ErrInvalidRole = errors.New("invalid role")
+
+// ErrNilBuiltInFunctionContainer signals that a nil built in function container has been provided
+var ErrNilBuiltInFunctionContainer = errors.New("nil built in function container")
+
+// ErrMissingGenesisGasSchedule signals that there is no gas schedule which is active from epoch 0
+var ErrMissingGenesisGasSchedule = errors.New("missing gas schedule for epoch 0")
+
+// ErrInvalidGasSchedule signals that the gas schedule did not pass the strict validation
+var ErrInvalidGasSchedule = errors.New("invalid gas schedule")
+
+// ErrNilBuiltInFunctionConstructor signals that a custom built in function was provided without a constructor
+var ErrNilBuiltInFunctionConstructor = errors.New("nil built in function constructor")
+
+// ErrInvalidEnableEpochs signals that the deactivation epoch of a built in function is not after its activation epoch
+var ErrInvalidEnableEpochs = errors.New("invalid enable epochs")
+
+// ErrConflictingEnableEpochs signals that a built in function is activated in different epochs by the enable epochs map
+// and by a per feature enable epoch argument
+var ErrConflictingEnableEpochs = errors.New("conflicting enable epochs")
+
+// ErrUnknownBuiltInFunction signals that the enable epochs were configured for a built in function which does not exist
+var ErrUnknownBuiltInFunction = errors.New("unknown built in function")
+
+// ErrOverlappingVersions signals that the epochs of a new built in function version overlap an existing version
+var ErrOverlappingVersions = errors.New("overlapping built in function versions")
+
+// ErrNoVersionForEpoch signals that no version of the built in function is valid in the current epoch
+var ErrNoVersionForEpoch = errors.New("no built in function version for the current epoch")
+
+// ErrNilInterceptor signals that a nil built in function interceptor was provided
+var ErrNilInterceptor = errors.New("nil built in function interceptor")
+
+// ErrBuiltInFunctionPanic signals that a built in function panicked while being executed
+var ErrBuiltInFunctionPanic = errors.New("built in function panicked")
+
+// ErrMissingMetaDataOnSystemAccount signals that the metadata of an NFT is missing from the system account
+var ErrMissingMetaDataOnSystemAccount = errors.New("missing NFT metadata on system account")
@@ -6,7 +6,11 @@ import (
 	"github.com/mitchellh/mapstructure"
 )
 
-// ArgsCreateBuiltInFunctionContainer -
+// ArgsCreateBuiltInFunctionContainer holds the arguments of the built in functions factory. BuiltInFunctionsEnableEpochs
+// is authoritative for the activation of the functions it lists: a per feature enable epoch argument which activates
+// one of them must be zero or equal to its activation epoch in the map, otherwise the factory rejects the config.
+// The SaveNFTToSystemAccount, DCTRolesCheck, GasRefund, DCTSupply and DCTMOAMultiTransfer enable epochs switch
+// behaviours inside functions, not the activation of a function, so the map does not cover them
 type ArgsCreateBuiltInFunctionContainer struct {
 	GasMap                             map[string]map[string]uint64
 	MapDNSAddresses                    map[string]struct{}
@@ -16,6 +20,24 @@ type ArgsCreateBuiltInFunctionContainer struct {
 	ShardCoordinator                   vmcommon.Coordinator
 	EpochNotifier                      vmcommon.EpochNotifier
 	DCTNFTImprovementV1ActivationEpoch uint32
+	SaveNFTToSystemAccountEnableEpoch  uint32
+	DCTNFTSettleSaleEnableEpoch        uint32
+	DCTMetaDataModifyEnableEpoch       uint32
+	DCTMaxSupplyEnableEpoch            uint32
+	DCTAllowanceEnableEpoch            uint32
+	DCTLockedBalanceEnableEpoch        uint32
+	DCTNFTCreateBatchEnableEpoch       uint32
+	DCTMultiDistributeEnableEpoch      uint32
+	DCTMintTransferEnableEpoch         uint32
+	DCTRolesCheckEnableEpoch           uint32
+	GasRefundEnableEpoch               uint32
+	DCTSupplyEnableEpoch               uint32
+	DCTMOAMultiTransferEnableEpoch     uint32
+	StrictGasScheduleValidation        bool
+	CustomBuiltInFunctions             []CustomBuiltInFunction
+	BuiltInFunctionsEnableEpochs       map[string]BuiltInFunctionEnableEpochs
+	PreInterceptors                    []vmcommon.BuiltInFunctionPreInterceptor
+	PostInterceptors                   []vmcommon.BuiltInFunctionPostInterceptor
 }
 
 type builtInFuncFactory struct {
@@ -23,11 +45,29 @@ type builtInFuncFactory struct {
 	enableUserNameChange               bool
 	marshalizer                        vmcommon.Marshalizer
 	accounts                           vmcommon.AccountsAdapter
-	builtInFunctions                   vmcommon.BuiltInFunctionContainer
+	builtInFunctions                   *functionContainer
 	gasConfig                          *vmcommon.GasCost
 	shardCoordinator                   vmcommon.Coordinator
 	epochNotifier                      vmcommon.EpochNotifier
 	dctNFTImprovementV1ActivationEpoch uint32
+	saveNFTToSystemAccountEnableEpoch  uint32
+	dctNFTSettleSaleEnableEpoch        uint32
+	dctMetaDataModifyEnableEpoch       uint32
+	dctMaxSupplyEnableEpoch            uint32
+	dctAllowanceEnableEpoch            uint32
+	dctLockedBalanceEnableEpoch        uint32
+	dctNFTCreateBatchEnableEpoch       uint32
+	dctMultiDistributeEnableEpoch      uint32
+	dctMintTransferEnableEpoch         uint32
+	dctRolesCheckEnableEpoch           uint32
+	gasRefundEnableEpoch               uint32
+	dctSupplyEnableEpoch               uint32
+	dctMOAMultiTransferEnableEpoch     uint32
+	strictGasScheduleValidation        bool
+	customBuiltInFunctions             []CustomBuiltInFunction
+	builtInFunctionsEnableEpochs       map[string]BuiltInFunctionEnableEpochs
+	preInterceptors                    []vmcommon.BuiltInFunctionPreInterceptor
+	postInterceptors                   []vmcommon.BuiltInFunctionPostInterceptor
 }
 
 // NewBuiltInFunctionsFactory creates a factory which will instantiate the built in functions contracts
@@ -47,6 +87,22 @@ func NewBuiltInFunctionsFactory(args ArgsCreateBuiltInFunctionContainer) (*built
 	if check.IfNil(args.EpochNotifier) {
 		return nil, ErrNilEpochHandler
 	}
+	err := checkCustomBuiltInFunctions(args.CustomBuiltInFunctions)
+	if err != nil {
+		return nil, err
+	}
+	err = checkBuiltInFunctionsEnableEpochs(args.BuiltInFunctionsEnableEpochs)
+	if err != nil {
+		return nil, err
+	}
+	err = checkLegacyActivationEpochs(args)
+	if err != nil {
+		return nil, err
+	}
+	err = checkInterceptors(args.PreInterceptors, args.PostInterceptors)
+	if err != nil {
+		return nil, err
+	}
 
 	b := &builtInFuncFactory{
 		mapDNSAddresses:                    args.MapDNSAddresses,
@@ -56,43 +112,69 @@ func NewBuiltInFunctionsFactory(args ArgsCreateBuiltInFunctionContainer) (*built
 		shardCoordinator:                   args.ShardCoordinator,
 		epochNotifier:                      args.EpochNotifier,
 		dctNFTImprovementV1ActivationEpoch: args.DCTNFTImprovementV1ActivationEpoch,
+		saveNFTToSystemAccountEnableEpoch:  args.SaveNFTToSystemAccountEnableEpoch,
+		dctNFTSettleSaleEnableEpoch:        args.DCTNFTSettleSaleEnableEpoch,
+		dctMetaDataModifyEnableEpoch:       args.DCTMetaDataModifyEnableEpoch,
+		dctMaxSupplyEnableEpoch:            args.DCTMaxSupplyEnableEpoch,
+		dctAllowanceEnableEpoch:            args.DCTAllowanceEnableEpoch,
+		dctLockedBalanceEnableEpoch:        args.DCTLockedBalanceEnableEpoch,
+		dctNFTCreateBatchEnableEpoch:       args.DCTNFTCreateBatchEnableEpoch,
+		dctMultiDistributeEnableEpoch:      args.DCTMultiDistributeEnableEpoch,
+		dctMintTransferEnableEpoch:         args.DCTMintTransferEnableEpoch,
+		dctRolesCheckEnableEpoch:           args.DCTRolesCheckEnableEpoch,
+		gasRefundEnableEpoch:               args.GasRefundEnableEpoch,
+		dctSupplyEnableEpoch:               args.DCTSupplyEnableEpoch,
+		dctMOAMultiTransferEnableEpoch:     args.DCTMOAMultiTransferEnableEpoch,
+		strictGasScheduleValidation:        args.StrictGasScheduleValidation,
+		customBuiltInFunctions:             args.CustomBuiltInFunctions,
+		builtInFunctionsEnableEpochs:       args.BuiltInFunctionsEnableEpochs,
+		preInterceptors:                    args.PreInterceptors,
+		postInterceptors:                   args.PostInterceptors,
 	}
 
-	var err error
-	b.gasConfig, err = createGasConfig(args.GasMap)
+	b.gasConfig, err = createValidatedGasConfig(args.GasMap, args.StrictGasScheduleValidation)
+	if err != nil {
+		return nil, err
+	}
+	b.builtInFunctions, err = NewVersionedBuiltInFunctionContainer(b.epochNotifier)
 	if err != nil {
 		return nil, err
 	}
-	b.builtInFunctions = NewBuiltInFunctionContainer()
 
 	return b, nil
 }
 
 // GasScheduleChange is called when gas schedule is changed, thus all contracts must be updated
 func (b *builtInFuncFactory) GasScheduleChange(gasSchedule map[string]map[string]uint64) {
-	newGasConfig, err := createGasConfig(gasSchedule)
+	err := b.SetGasSchedule(gasSchedule)
+	if err != nil {
+		log.Error("built in functions factory: can not apply the new gas schedule", "error", err)
+	}
+}
+
+// SetGasSchedule validates the new gas schedule and pushes it to all the built in functions
+func (b *builtInFuncFactory) SetGasSchedule(gasSchedule map[string]map[string]uint64) error {
+	newGasConfig, err := createValidatedGasConfig(gasSchedule, b.strictGasScheduleValidation)
 	if err != nil {
-		return
+		return err
 	}
 
 	b.gasConfig = newGasConfig
-	for key := range b.builtInFunctions.Keys() {
-		builtInFunc, errGet := b.builtInFunctions.Get(key)
-		if errGet != nil {
-			return
-		}
 
-		builtInFunc.SetNewGasConfig(b.gasConfig)
-	}
+	return setGasConfigOnBuiltInFunctions(b.builtInFunctions, b.gasConfig)
 }
 
 // CreateBuiltInFunctionContainer will create the list of built-in functions
 func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (vmcommon.BuiltInFunctionContainer, error) {
+	var err error
+	b.builtInFunctions, err = NewVersionedBuiltInFunctionContainer(b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
 
-	b.builtInFunctions = NewBuiltInFunctionContainer()
 	var newFunc vmcommon.BuiltinFunction
 	newFunc = NewClaimDeveloperRewardsFunc(b.gasConfig.BuiltInCost.ClaimDeveloperRewards)
-	err := b.builtInFunctions.Add(vmcommon.BuiltInFunctionClaimDeveloperRewards, newFunc)
+	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionClaimDeveloperRewards, newFunc)
 	if err != nil {
 		return nil, err
 	}
@@ -112,7 +194,7 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (vmcommon.BuiltInF
 		return nil, err
 	}
 
-	newFunc, err = NewSaveKeyValueStorageFunc(b.gasConfig.BaseOperationCost, b.gasConfig.BuiltInCost.SaveKeyValue)
+	newFunc, err = NewSaveKeyValueStorageFunc(b.gasConfig.BaseOperationCost, b.gasConfig.BuiltInCost.SaveKeyValue, b.gasRefundEnableEpoch, b.epochNotifier)
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +212,37 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (vmcommon.BuiltInF
 		return nil, err
 	}
 
-	newFunc, err = NewDCTTransferFunc(b.gasConfig.BuiltInCost.DCTTransfer, b.marshalizer, pauseFunc, b.shardCoordinator)
+	setRoleFunc, err := NewDCTRolesFunc(b.marshalizer, true, b.dctRolesCheckEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionSetDCTRole, setRoleFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	supplyHandler, err := NewDCTSupplyHandler(b.accounts, b.marshalizer, b.dctSupplyEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+
+	lockedBalanceHandler, err := NewDCTLockedBalanceHandler(b.marshalizer, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+
+	storageHandler, err := NewDCTDataStorage(ArgsNewDCTDataStorage{
+		Accounts:                b.accounts,
+		GlobalSettingsHandler:   pauseFunc,
+		Marshalizer:             b.marshalizer,
+		SaveToSystemEnableEpoch: b.saveNFTToSystemAccountEnableEpoch,
+		EpochNotifier:           b.epochNotifier,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewDCTTransferFunc(b.gasConfig.BuiltInCost.DCTTransfer, b.marshalizer, pauseFunc, setRoleFunc, b.shardCoordinator, lockedBalanceHandler)
 	if err != nil {
 		return nil, err
 	}
@@ -139,7 +251,16 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (vmcommon.BuiltInF
 		return nil, err
 	}
 
-	newFunc, err = NewDCTBurnFunc(b.gasConfig.BuiltInCost.DCTBurn, b.marshalizer, pauseFunc)
+	newFunc, err = NewDCTMintTransferFunc(b.gasConfig.BuiltInCost.DCTTransfer, b.marshalizer, pauseFunc, setRoleFunc, b.shardCoordinator, supplyHandler, lockedBalanceHandler, b.dctMintTransferEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionDCTMintTransfer, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewDCTBurnFunc(b.gasConfig.BuiltInCost.DCTBurn, b.marshalizer, pauseFunc, supplyHandler, lockedBalanceHandler)
 	if err != nil {
 		return nil, err
 	}
@@ -148,7 +269,7 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (vmcommon.BuiltInF
 		return nil, err
 	}
 
-	newFunc, err = NewDCTFreezeWipeFunc(b.marshalizer, true, false)
+	newFunc, err = NewDCTFreezeWipeFunc(b.marshalizer, supplyHandler, true, false)
 	if err != nil {
 		return nil, err
 	}
@@ -157,7 +278,7 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (vmcommon.BuiltInF
 		return nil, err
 	}
 
-	newFunc, err = NewDCTFreezeWipeFunc(b.marshalizer, false, false)
+	newFunc, err = NewDCTFreezeWipeFunc(b.marshalizer, supplyHandler, false, false)
 	if err != nil {
 		return nil, err
 	}
@@ -166,7 +287,7 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (vmcommon.BuiltInF
 		return nil, err
 	}
 
-	newFunc, err = NewDCTFreezeWipeFunc(b.marshalizer, false, true)
+	newFunc, err = NewDCTFreezeWipeFunc(b.marshalizer, supplyHandler, false, true)
 	if err != nil {
 		return nil, err
 	}
@@ -175,6 +296,33 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (vmcommon.BuiltInF
 		return nil, err
 	}
 
+	newFunc, err = NewDCTFreezeWipeSingleNFTFunc(b.marshalizer, supplyHandler, true, false)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionDCTFreezeSingleNFT, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewDCTFreezeWipeSingleNFTFunc(b.marshalizer, supplyHandler, false, false)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionDCTUnFreezeSingleNFT, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewDCTFreezeWipeSingleNFTFunc(b.marshalizer, supplyHandler, false, true)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionDCTWipeSingleNFT, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
 	newFunc, err = NewDCTPauseFunc(b.accounts, false)
 	if err != nil {
 		return nil, err
@@ -184,25 +332,61 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (vmcommon.BuiltInF
 		return nil, err
 	}
 
-	newFunc, err = NewDCTRolesFunc(b.marshalizer, false)
+	newFunc, err = NewDCTGlobalSettingsFunc(b.accounts, true, vmcommon.BuiltInFunctionDCTSetLimitedTransfer)
 	if err != nil {
 		return nil, err
 	}
-	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionUnSetDCTRole, newFunc)
+	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionDCTSetLimitedTransfer, newFunc)
 	if err != nil {
 		return nil, err
 	}
 
-	setRoleFunc, err := NewDCTRolesFunc(b.marshalizer, true)
+	newFunc, err = NewDCTGlobalSettingsFunc(b.accounts, false, vmcommon.BuiltInFunctionDCTUnSetLimitedTransfer)
 	if err != nil {
 		return nil, err
 	}
-	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionSetDCTRole, setRoleFunc)
+	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionDCTUnSetLimitedTransfer, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewDCTSetTokenTypeFunc(b.accounts)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionDCTSetTokenType, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewDCTSetMaxSupplyFunc(b.accounts, b.dctMaxSupplyEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionDCTSetMaxSupply, newFunc)
 	if err != nil {
 		return nil, err
 	}
 
-	newFunc, err = NewDCTLocalBurnFunc(b.gasConfig.BuiltInCost.DCTLocalBurn, b.marshalizer, pauseFunc, setRoleFunc)
+	newFunc, err = NewDCTAddMintAllowanceFunc(b.accounts, b.dctMaxSupplyEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionDCTAddMintAllowance, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewDCTRolesFunc(b.marshalizer, false, b.dctRolesCheckEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionUnSetDCTRole, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewDCTLocalBurnFunc(b.gasConfig.BuiltInCost.DCTLocalBurn, b.gasConfig.BaseOperationCost, b.marshalizer, pauseFunc, setRoleFunc, supplyHandler, lockedBalanceHandler, b.gasRefundEnableEpoch, b.epochNotifier)
 	if err != nil {
 		return nil, err
 	}
@@ -211,7 +395,7 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (vmcommon.BuiltInF
 		return nil, err
 	}
 
-	newFunc, err = NewDCTLocalMintFunc(b.gasConfig.BuiltInCost.DCTLocalMint, b.marshalizer, pauseFunc, setRoleFunc)
+	newFunc, err = NewDCTLocalMintFunc(b.gasConfig.BuiltInCost.DCTLocalMint, b.marshalizer, pauseFunc, setRoleFunc, supplyHandler, lockedBalanceHandler)
 	if err != nil {
 		return nil, err
 	}
@@ -220,7 +404,7 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (vmcommon.BuiltInF
 		return nil, err
 	}
 
-	newFunc, err = NewDCTNFTAddQuantityFunc(b.gasConfig.BuiltInCost.DCTNFTAddQuantity, b.marshalizer, pauseFunc, setRoleFunc)
+	newFunc, err = NewDCTNFTAddQuantityFunc(b.gasConfig.BuiltInCost.DCTNFTAddQuantity, b.marshalizer, pauseFunc, setRoleFunc, supplyHandler, storageHandler)
 	if err != nil {
 		return nil, err
 	}
@@ -229,7 +413,7 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (vmcommon.BuiltInF
 		return nil, err
 	}
 
-	newFunc, err = NewDCTNFTBurnFunc(b.gasConfig.BuiltInCost.DCTNFTBurn, b.marshalizer, pauseFunc, setRoleFunc)
+	newFunc, err = NewDCTNFTBurnFunc(b.gasConfig.BuiltInCost.DCTNFTBurn, b.gasConfig.BaseOperationCost, b.marshalizer, pauseFunc, setRoleFunc, supplyHandler, storageHandler, b.gasRefundEnableEpoch, b.epochNotifier)
 	if err != nil {
 		return nil, err
 	}
@@ -238,7 +422,7 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (vmcommon.BuiltInF
 		return nil, err
 	}
 
-	newFunc, err = NewDCTNFTCreateFunc(b.gasConfig.BuiltInCost.DCTNFTCreate, b.gasConfig.BaseOperationCost, b.marshalizer, pauseFunc, setRoleFunc)
+	newFunc, err = NewDCTNFTCreateFunc(b.gasConfig.BuiltInCost.DCTNFTCreate, b.gasConfig.BaseOperationCost, b.marshalizer, pauseFunc, setRoleFunc, supplyHandler, storageHandler)
 	if err != nil {
 		return nil, err
 	}
@@ -247,7 +431,7 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (vmcommon.BuiltInF
 		return nil, err
 	}
 
-	newFunc, err = NewDCTNFTTransferFunc(b.gasConfig.BuiltInCost.DCTNFTTransfer, b.marshalizer, pauseFunc, b.accounts, b.shardCoordinator, b.gasConfig.BaseOperationCost)
+	newFunc, err = NewDCTNFTTransferFunc(b.gasConfig.BuiltInCost.DCTNFTTransfer, b.marshalizer, pauseFunc, setRoleFunc, b.accounts, b.shardCoordinator, b.gasConfig.BaseOperationCost, storageHandler)
 	if err != nil {
 		return nil, err
 	}
@@ -265,7 +449,7 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (vmcommon.BuiltInF
 		return nil, err
 	}
 
-	newFunc, err = NewDCTNFTUpdateAttributesFunc(b.gasConfig.BuiltInCost.DCTNFTUpdateAttributes, b.gasConfig.BaseOperationCost, b.marshalizer, pauseFunc, setRoleFunc, b.dctNFTImprovementV1ActivationEpoch, b.epochNotifier)
+	newFunc, err = NewDCTNFTUpdateAttributesFunc(b.gasConfig.BuiltInCost.DCTNFTUpdateAttributes, b.gasConfig.BaseOperationCost, b.marshalizer, storageHandler, setRoleFunc, b.dctNFTImprovementV1ActivationEpoch, b.epochNotifier)
 	if err != nil {
 		return nil, err
 	}
@@ -274,7 +458,7 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (vmcommon.BuiltInF
 		return nil, err
 	}
 
-	newFunc, err = NewDCTNFTAddUriFunc(b.gasConfig.BuiltInCost.DCTNFTAddURI, b.gasConfig.BaseOperationCost, b.marshalizer, pauseFunc, setRoleFunc, b.dctNFTImprovementV1ActivationEpoch, b.epochNotifier)
+	newFunc, err = NewDCTNFTAddUriFunc(b.gasConfig.BuiltInCost.DCTNFTAddURI, b.gasConfig.BaseOperationCost, b.marshalizer, storageHandler, setRoleFunc, b.dctNFTImprovementV1ActivationEpoch, b.epochNotifier)
 	if err != nil {
 		return nil, err
 	}
@@ -283,7 +467,7 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (vmcommon.BuiltInF
 		return nil, err
 	}
 
-	newFunc, err = NewDCTNFTMultiTransferFunc(b.gasConfig.BuiltInCost.DCTNFTMultiTransfer, b.marshalizer, pauseFunc, b.accounts, b.shardCoordinator, b.gasConfig.BaseOperationCost, b.dctNFTImprovementV1ActivationEpoch, b.epochNotifier)
+	newFunc, err = NewDCTNFTMultiTransferFunc(b.gasConfig.BuiltInCost.DCTNFTMultiTransfer, b.marshalizer, pauseFunc, setRoleFunc, b.accounts, b.shardCoordinator, b.gasConfig.BaseOperationCost, storageHandler, lockedBalanceHandler, b.dctNFTImprovementV1ActivationEpoch, b.dctMOAMultiTransferEnableEpoch, b.epochNotifier)
 	if err != nil {
 		return nil, err
 	}
@@ -292,6 +476,119 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (vmcommon.BuiltInF
 		return nil, err
 	}
 
+	newFunc, err = NewDCTNFTSettleSaleFunc(b.gasConfig.BuiltInCost.DCTNFTSettleSale, b.gasConfig.BaseOperationCost, b.marshalizer, pauseFunc, setRoleFunc, storageHandler, b.accounts, b.shardCoordinator, b.dctNFTSettleSaleEnableEpoch, b.epochNotifier, lockedBalanceHandler)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionDCTNFTSettleSale, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewDCTModifyRoyaltiesFunc(b.gasConfig.BuiltInCost.DCTModifyRoyalties, b.gasConfig.BaseOperationCost, b.marshalizer, storageHandler, setRoleFunc, b.dctMetaDataModifyEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionDCTModifyRoyalties, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewDCTSetNewURIsFunc(b.gasConfig.BuiltInCost.DCTSetNewURIs, b.gasConfig.BaseOperationCost, b.marshalizer, storageHandler, setRoleFunc, b.dctMetaDataModifyEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionDCTSetNewURIs, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewDCTNFTRecreateFunc(b.gasConfig.BuiltInCost.DCTNFTRecreate, b.gasConfig.BaseOperationCost, b.marshalizer, storageHandler, setRoleFunc, b.dctMetaDataModifyEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionDCTNFTRecreate, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewDCTApproveFunc(b.gasConfig.BuiltInCost.DCTApprove, b.dctAllowanceEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionDCTApprove, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewDCTTransferFromFunc(b.gasConfig.BuiltInCost.DCTTransferFrom, b.marshalizer, pauseFunc, setRoleFunc, b.accounts, b.shardCoordinator, lockedBalanceHandler, b.dctAllowanceEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionDCTTransferFrom, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewDCTLockedTransferFunc(b.gasConfig.BuiltInCost.DCTLockedTransfer, b.marshalizer, pauseFunc, setRoleFunc, b.shardCoordinator, b.gasConfig.BaseOperationCost, lockedBalanceHandler, b.dctLockedBalanceEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionDCTLockedTransfer, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewDCTClaimUnlockedFunc(b.gasConfig.BuiltInCost.DCTClaimUnlocked, b.marshalizer, lockedBalanceHandler, b.dctLockedBalanceEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionDCTClaimUnlocked, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewDCTNFTCreateBatchFunc(b.gasConfig.BuiltInCost.DCTNFTCreateBatch, b.gasConfig.BaseOperationCost, b.marshalizer, pauseFunc, setRoleFunc, supplyHandler, storageHandler, b.dctNFTCreateBatchEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionDCTNFTCreateBatch, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewDCTMultiDistributeFunc(b.gasConfig.BuiltInCost.DCTMultiDistribute, b.marshalizer, pauseFunc, setRoleFunc, b.accounts, b.shardCoordinator, b.gasConfig.BaseOperationCost, storageHandler, lockedBalanceHandler, b.dctMultiDistributeEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionDCTMultiDistribute, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.addCustomBuiltInFunctions(BuiltInFunctionDependencies{
+		Marshalizer:           b.marshalizer,
+		Accounts:              b.accounts,
+		GlobalSettingsHandler: pauseFunc,
+		RolesHandler:          setRoleFunc,
+		ShardCoordinator:      b.shardCoordinator,
+		EpochNotifier:         b.epochNotifier,
+		GasConfig:             b.gasConfig,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.applyEnableEpochs()
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.applyInterceptors()
+	if err != nil {
+		return nil, err
+	}
+
 	return b.builtInFunctions, nil
 }
 
@@ -326,12 +623,16 @@ func createGasConfig(gasMap map[string]map[string]uint64) (*vmcommon.GasCost, er
 	return &gasCost, nil
 }
 
-// SetPayableHandler sets the payable interface to the needed functions
+// SetPayableHandler sets the payable interface to the needed functions and to any other function in the container,
+// like the custom ones, which accepts a payable handler
 func SetPayableHandler(container vmcommon.BuiltInFunctionContainer, payableHandler vmcommon.PayableHandler) error {
 	listOfTransferFunc := []string{
 		vmcommon.BuiltInFunctionMultiDCTNFTTransfer,
 		vmcommon.BuiltInFunctionDCTNFTTransfer,
-		vmcommon.BuiltInFunctionDCTTransfer}
+		vmcommon.BuiltInFunctionDCTTransfer,
+		vmcommon.BuiltInFunctionDCTTransferFrom,
+		vmcommon.BuiltInFunctionDCTLockedTransfer,
+		vmcommon.BuiltInFunctionDCTMultiDistribute}
 
 	for _, transferFunc := range listOfTransferFunc {
 		builtInFunc, err := container.Get(transferFunc)
@@ -339,15 +640,29 @@ func SetPayableHandler(container vmcommon.BuiltInFunctionContainer, payableHandl
 			return err
 		}
 
-		dctTransferFunc, ok := builtInFunc.(vmcommon.AcceptPayableHandler)
+		_, ok := builtInFunc.(vmcommon.AcceptPayableHandler)
 		if !ok {
 			return ErrWrongTypeAssertion
 		}
+	}
 
-		err = dctTransferFunc.SetPayableHandler(payableHandler)
+	for key := range container.Keys() {
+		versions, err := getAllBuiltInFunctionVersions(container, key)
 		if err != nil {
 			return err
 		}
+
+		for _, builtInFunc := range versions {
+			acceptPayableHandler, ok := builtInFunc.(vmcommon.AcceptPayableHandler)
+			if !ok {
+				continue
+			}
+
+			err = acceptPayableHandler.SetPayableHandler(payableHandler)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
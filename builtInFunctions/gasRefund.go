@@ -0,0 +1,53 @@
+package builtInFunctions
+
+import (
+	"math/big"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/atomic"
+)
+
+// gasRefundCapDivisor limits the gas refunded to a call to a fraction of the gas used by that call
+const gasRefundCapDivisor = 2
+
+// baseGasRefund holds the enable epoch from which a built in function refunds the gas of the storage it releases
+type baseGasRefund struct {
+	function             string
+	gasRefundEnableEpoch uint32
+	flagGasRefund        atomic.Flag
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (b *baseGasRefund) EpochConfirmed(epoch uint32, _ uint64) {
+	b.flagGasRefund.Toggle(epoch >= b.gasRefundEnableEpoch)
+	log.Debug("gas refund for released storage", "function", b.function, "enabled", b.flagGasRefund.IsSet())
+}
+
+// computeGasRefund returns the gas refunded for the released storage bytes, capped relative to the gas used
+func computeGasRefund(releasedBytes uint64, releasePerByte uint64, gasUsed uint64) *big.Int {
+	refund := big.NewInt(0).Mul(big.NewInt(0).SetUint64(releasedBytes), big.NewInt(0).SetUint64(releasePerByte))
+	maxRefund := big.NewInt(0).SetUint64(gasUsed / gasRefundCapDivisor)
+	if refund.Cmp(maxRefund) > 0 {
+		return maxRefund
+	}
+
+	return refund
+}
+
+// computeReleasedBytes returns by how many bytes the value saved under the key shrank
+func computeReleasedBytes(oldLength uint64, newLength uint64) uint64 {
+	if oldLength <= newLength {
+		return 0
+	}
+
+	return oldLength - newLength
+}
+
+func getStoredLength(acnt vmcommon.UserAccountHandler, key []byte) (uint64, error) {
+	value, err := acnt.AccountDataHandler().RetrieveValue(key)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(len(value)), nil
+}
@@ -0,0 +1,217 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeGasRefund(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, big.NewInt(0), computeGasRefund(0, 10, 100))
+	assert.Equal(t, big.NewInt(30), computeGasRefund(3, 10, 100))
+	assert.Equal(t, big.NewInt(50), computeGasRefund(30, 10, 100))
+
+	assert.Equal(t, uint64(0), computeReleasedBytes(5, 5))
+	assert.Equal(t, uint64(0), computeReleasedBytes(5, 8))
+	assert.Equal(t, uint64(3), computeReleasedBytes(8, 5))
+}
+
+func TestSaveKeyValue_ProcessBuiltinFunctionRefundsReleasedBytes(t *testing.T) {
+	t.Parallel()
+
+	gasConfig := vmcommon.BaseOperationCost{StorePerByte: 1, ReleasePerByte: 2, DataCopyPerByte: 1, PersistPerByte: 1, CompilePerByte: 1, AoTPreparePerByte: 1}
+	skv, _ := NewSaveKeyValueStorageFunc(gasConfig, 100, 0, &mock.EpochNotifierStub{})
+
+	addr := []byte("addr")
+	acc := mock.NewUserAccount(addr)
+	_ = acc.SaveKeyValue([]byte("key1"), []byte("a long value"))
+	_ = acc.SaveKeyValue([]byte("key2"), []byte("deleted"))
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  addr,
+			GasProvided: 1000,
+			CallValue:   big.NewInt(0),
+			Arguments:   [][]byte{[]byte("key1"), []byte("short"), []byte("key2"), nil},
+		},
+		RecipientAddr: addr,
+	}
+
+	vmOutput, err := skv.ProcessBuiltinFunction(acc, nil, vmInput)
+	require.Nil(t, err)
+	// 7 bytes released by key1 and 7 bytes released by key2
+	assert.Equal(t, big.NewInt(28), vmOutput.GasRefund)
+}
+
+func TestDctLocalBurn_ProcessBuiltinFunctionRefundsReleasedBytes(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	gasConfig := vmcommon.BaseOperationCost{ReleasePerByte: 1}
+	dctLocalBurnF, _ := NewDCTLocalBurnFunc(1000, gasConfig, marshalizer, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
+
+	acc := mock.NewUserAccount([]byte("addr"))
+	tokenKey := []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier + "TKN")
+	marshaledData, _ := marshalizer.Marshal(&dct.DCToken{Value: big.NewInt(100)})
+	_ = acc.SaveKeyValue(tokenKey, marshaledData)
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			Arguments:   [][]byte{[]byte("TKN"), big.NewInt(100).Bytes()},
+			GasProvided: 2000,
+		},
+	}
+
+	vmOutput, err := dctLocalBurnF.ProcessBuiltinFunction(acc, nil, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(0).SetUint64(uint64(len(marshaledData))), vmOutput.GasRefund)
+	assert.Empty(t, acc.Storage[string(tokenKey)])
+}
+
+func TestDctNFTBurnFunc_ProcessBuiltinFunctionRefundsReleasedBytes(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	gasConfig := vmcommon.BaseOperationCost{ReleasePerByte: 100}
+	ebf, _ := NewDCTNFTBurnFunc(10, gasConfig, marshalizer, &mock.GlobalSettingsHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, createNewDCTDataStorageHandler(), 0, &mock.EpochNotifierStub{})
+
+	userAcc := mock.NewAccountWrapMock([]byte("addr"))
+	nonce := big.NewInt(33)
+	tokenKey := append([]byte(vmcommon.DharitriProtectedKeyPrefix+vmcommon.DCTKeyIdentifier+"testTkn"), nonce.Bytes()...)
+	dctDataBytes, _ := marshalizer.Marshal(&dct.DCToken{TokenMetaData: &dct.MetaData{Name: []byte("test")}, Value: big.NewInt(2)})
+	_ = userAcc.AccountDataHandler().SaveKeyValue(tokenKey, dctDataBytes)
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			Arguments:   [][]byte{[]byte("testTkn"), nonce.Bytes(), big.NewInt(2).Bytes()},
+			CallerAddr:  []byte("address 1"),
+			GasProvided: 12,
+		},
+		RecipientAddr: []byte("address 1"),
+	}
+
+	output, err := ebf.ProcessBuiltinFunction(userAcc, nil, vmInput)
+	require.Nil(t, err)
+	// the refund is capped to half of the gas used
+	assert.Equal(t, big.NewInt(5), output.GasRefund)
+}
+
+func TestSaveKeyValue_ProcessBuiltinFunctionBeforeGasRefundEpochShouldNotRefund(t *testing.T) {
+	t.Parallel()
+
+	gasConfig := vmcommon.BaseOperationCost{StorePerByte: 1, ReleasePerByte: 2, PersistPerByte: 1}
+	skv, _ := NewSaveKeyValueStorageFunc(gasConfig, 100, 1, &mock.EpochNotifierStub{})
+
+	addr := []byte("addr")
+	acc := mock.NewUserAccount(addr)
+	_ = acc.SaveKeyValue([]byte("key"), []byte("a long value"))
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  addr,
+			GasProvided: 1000,
+			CallValue:   big.NewInt(0),
+			Arguments:   [][]byte{[]byte("key"), []byte("short")},
+		},
+		RecipientAddr: addr,
+	}
+
+	vmOutput, err := skv.ProcessBuiltinFunction(acc, nil, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(0), vmOutput.GasRefund)
+
+	skv.EpochConfirmed(1, 0)
+	_ = acc.SaveKeyValue([]byte("key"), []byte("a long value"))
+	vmOutput, err = skv.ProcessBuiltinFunction(acc, nil, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(14), vmOutput.GasRefund)
+}
+
+func TestDctLocalBurn_ProcessBuiltinFunctionBeforeGasRefundEpochShouldNotRefund(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	gasConfig := vmcommon.BaseOperationCost{ReleasePerByte: 1}
+	dctLocalBurnF, _ := NewDCTLocalBurnFunc(1000, gasConfig, marshalizer, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{}, 1, &mock.EpochNotifierStub{})
+
+	acc := mock.NewUserAccount([]byte("addr"))
+	tokenKey := []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier + "TKN")
+	marshaledData, _ := marshalizer.Marshal(&dct.DCToken{Value: big.NewInt(100)})
+	_ = acc.SaveKeyValue(tokenKey, marshaledData)
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			Arguments:   [][]byte{[]byte("TKN"), big.NewInt(100).Bytes()},
+			GasProvided: 2000,
+		},
+	}
+
+	vmOutput, err := dctLocalBurnF.ProcessBuiltinFunction(acc, nil, vmInput)
+	require.Nil(t, err)
+	assert.Nil(t, vmOutput.GasRefund)
+	assert.Empty(t, acc.Storage[string(tokenKey)])
+}
+
+func TestSaveKeyValue_ProcessBuiltinFunctionRetrieveValueErrorAfterGasRefundEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	skv, _ := NewSaveKeyValueStorageFunc(vmcommon.BaseOperationCost{}, 100, 1, &mock.EpochNotifierStub{})
+
+	expectedErr := errors.New("expected error")
+	savedValues := make(map[string][]byte)
+	acc := &mock.UserAccountStub{
+		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
+			return &mock.DataTrieTrackerStub{
+				RetrieveValueCalled: func(_ []byte) ([]byte, error) {
+					return nil, expectedErr
+				},
+				SaveKeyValueCalled: func(key []byte, value []byte) error {
+					savedValues[string(key)] = value
+					return nil
+				},
+			}
+		},
+	}
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  []byte("addr"),
+			GasProvided: 1000,
+			CallValue:   big.NewInt(0),
+			Arguments:   [][]byte{[]byte("key"), []byte("value")},
+		},
+		RecipientAddr: []byte("addr"),
+	}
+
+	_, err := skv.ProcessBuiltinFunction(acc, nil, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, []byte("value"), savedValues["key"])
+
+	skv.EpochConfirmed(1, 0)
+	_, err = skv.ProcessBuiltinFunction(acc, nil, vmInput)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestGetStoredLength_RetrieveValueErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	acc := &mock.UserAccountStub{
+		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
+			return &mock.DataTrieTrackerStub{
+				RetrieveValueCalled: func(_ []byte) ([]byte, error) {
+					return nil, expectedErr
+				},
+			}
+		},
+	}
+
+	length, err := getStoredLength(acc, []byte("key"))
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, uint64(0), length)
+}
@@ -0,0 +1,146 @@
+package builtInFunctions
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+)
+
+// ArgsGasScheduleManager defines the arguments needed for the gas schedule manager
+type ArgsGasScheduleManager struct {
+	GasSchedules     map[uint32]map[string]map[string]uint64
+	BuiltInFunctions vmcommon.BuiltInFunctionContainer
+	EpochNotifier    vmcommon.EpochNotifier
+	StrictValidation bool
+}
+
+type epochGasConfig struct {
+	activationEpoch uint32
+	gasConfig       *vmcommon.GasCost
+}
+
+type gasScheduleManager struct {
+	builtInFunctions vmcommon.BuiltInFunctionContainer
+	gasConfigs       []*epochGasConfig
+	mutGasConfig     sync.RWMutex
+	current          *epochGasConfig
+}
+
+// NewGasScheduleManager creates the component which holds the gas schedules keyed by their activation epoch and
+// pushes the matching one to all the built in functions whenever a new epoch is confirmed. All the gas schedules are
+// validated at construction time, so a wrong schedule is reported before its activation epoch is reached
+func NewGasScheduleManager(args ArgsGasScheduleManager) (*gasScheduleManager, error) {
+	if check.IfNil(args.BuiltInFunctions) {
+		return nil, ErrNilBuiltInFunctionContainer
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, ErrNilEpochHandler
+	}
+	if _, ok := args.GasSchedules[0]; !ok {
+		return nil, ErrMissingGenesisGasSchedule
+	}
+
+	gasConfigs := make([]*epochGasConfig, 0, len(args.GasSchedules))
+	for activationEpoch, gasSchedule := range args.GasSchedules {
+		gasConfig, err := createValidatedGasConfig(gasSchedule, args.StrictValidation)
+		if err != nil {
+			return nil, fmt.Errorf("%w for gas schedule activated in epoch %d", err, activationEpoch)
+		}
+
+		gasConfigs = append(gasConfigs, &epochGasConfig{
+			activationEpoch: activationEpoch,
+			gasConfig:       gasConfig,
+		})
+	}
+	sort.Slice(gasConfigs, func(i, j int) bool {
+		return gasConfigs[i].activationEpoch < gasConfigs[j].activationEpoch
+	})
+
+	g := &gasScheduleManager{
+		builtInFunctions: args.BuiltInFunctions,
+		gasConfigs:       gasConfigs,
+	}
+	args.EpochNotifier.RegisterNotifyHandler(g)
+
+	return g, nil
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed and switches to the gas schedule active in that epoch
+func (g *gasScheduleManager) EpochConfirmed(epoch uint32, _ uint64) {
+	err := g.applyGasScheduleForEpoch(epoch)
+	if err != nil {
+		log.Error("gas schedule manager: can not apply the gas schedule", "epoch", epoch, "error", err)
+	}
+}
+
+func (g *gasScheduleManager) applyGasScheduleForEpoch(epoch uint32) error {
+	g.mutGasConfig.Lock()
+	defer g.mutGasConfig.Unlock()
+
+	active := g.getGasConfigForEpoch(epoch)
+	if active == g.current {
+		return nil
+	}
+
+	err := setGasConfigOnBuiltInFunctions(g.builtInFunctions, active.gasConfig)
+	if err != nil {
+		return err
+	}
+
+	g.current = active
+	log.Debug("gas schedule manager: switched gas schedule", "epoch", epoch, "activation epoch", active.activationEpoch)
+
+	return nil
+}
+
+func (g *gasScheduleManager) getGasConfigForEpoch(epoch uint32) *epochGasConfig {
+	active := g.gasConfigs[0]
+	for _, gasConfig := range g.gasConfigs {
+		if gasConfig.activationEpoch > epoch {
+			break
+		}
+		active = gasConfig
+	}
+
+	return active
+}
+
+// GasConfigForEpoch returns the gas schedule which is active in the given epoch
+func (g *gasScheduleManager) GasConfigForEpoch(epoch uint32) *vmcommon.GasCost {
+	return g.getGasConfigForEpoch(epoch).gasConfig
+}
+
+// CurrentGasConfig returns the gas schedule which was pushed to the built in functions on the last epoch change
+func (g *gasScheduleManager) CurrentGasConfig() *vmcommon.GasCost {
+	g.mutGasConfig.RLock()
+	defer g.mutGasConfig.RUnlock()
+
+	if g.current == nil {
+		return nil
+	}
+
+	return g.current.gasConfig
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (g *gasScheduleManager) IsInterfaceNil() bool {
+	return g == nil
+}
+
+func setGasConfigOnBuiltInFunctions(container vmcommon.BuiltInFunctionContainer, gasConfig *vmcommon.GasCost) error {
+	for key := range container.Keys() {
+		versions, err := getAllBuiltInFunctionVersions(container, key)
+		if err != nil {
+			return err
+		}
+
+		for _, builtInFunc := range versions {
+			builtInFunc.SetNewGasConfig(gasConfig)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createGasScheduleMap(value uint64) map[string]map[string]uint64 {
+	createSection := func(structure interface{}) map[string]uint64 {
+		section := make(map[string]uint64)
+		structType := reflect.TypeOf(structure)
+		for i := 0; i < structType.NumField(); i++ {
+			section[structType.Field(i).Name] = value
+		}
+		return section
+	}
+
+	return map[string]map[string]uint64{
+		vmcommon.BaseOperationCostString: createSection(vmcommon.BaseOperationCost{}),
+		vmcommon.BuiltInCostString:       createSection(vmcommon.BuiltInCost{}),
+	}
+}
+
+func createGasScheduleManagerArgs(container vmcommon.BuiltInFunctionContainer) ArgsGasScheduleManager {
+	return ArgsGasScheduleManager{
+		GasSchedules: map[uint32]map[string]map[string]uint64{
+			0:  createGasScheduleMap(1),
+			10: createGasScheduleMap(2),
+			20: createGasScheduleMap(3),
+		},
+		BuiltInFunctions: container,
+		EpochNotifier:    &mock.EpochNotifierStub{},
+	}
+}
+
+func TestNewGasScheduleManager(t *testing.T) {
+	t.Parallel()
+
+	args := createGasScheduleManagerArgs(nil)
+	manager, err := NewGasScheduleManager(args)
+	assert.Equal(t, ErrNilBuiltInFunctionContainer, err)
+	assert.True(t, check.IfNil(manager))
+
+	args = createGasScheduleManagerArgs(NewBuiltInFunctionContainer())
+	args.EpochNotifier = nil
+	manager, err = NewGasScheduleManager(args)
+	assert.Equal(t, ErrNilEpochHandler, err)
+	assert.True(t, check.IfNil(manager))
+
+	args = createGasScheduleManagerArgs(NewBuiltInFunctionContainer())
+	delete(args.GasSchedules, 0)
+	manager, err = NewGasScheduleManager(args)
+	assert.Equal(t, ErrMissingGenesisGasSchedule, err)
+	assert.True(t, check.IfNil(manager))
+
+	args = createGasScheduleManagerArgs(NewBuiltInFunctionContainer())
+	delete(args.GasSchedules[10][vmcommon.BuiltInCostString], "DCTTransfer")
+	manager, err = NewGasScheduleManager(args)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "epoch 10")
+	assert.True(t, check.IfNil(manager))
+
+	args = createGasScheduleManagerArgs(NewBuiltInFunctionContainer())
+	manager, err = NewGasScheduleManager(args)
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(manager))
+	assert.Equal(t, uint64(1), manager.CurrentGasConfig().BuiltInCost.DCTTransfer)
+}
+
+func TestGasScheduleManager_EpochConfirmedSwitchesGasSchedule(t *testing.T) {
+	t.Parallel()
+
+	var lastGasCost *vmcommon.GasCost
+	numCalls := 0
+	container := NewBuiltInFunctionContainer()
+	_ = container.Add("func", &mock.BuiltInFunctionStub{
+		SetNewGasConfigCalled: func(gasCost *vmcommon.GasCost) {
+			lastGasCost = gasCost
+			numCalls++
+		},
+	})
+
+	manager, err := NewGasScheduleManager(createGasScheduleManagerArgs(container))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1), lastGasCost.BuiltInCost.DCTTransfer)
+	assert.Equal(t, 1, numCalls)
+
+	manager.EpochConfirmed(9, 0)
+	assert.Equal(t, 1, numCalls)
+
+	manager.EpochConfirmed(10, 0)
+	assert.Equal(t, uint64(2), lastGasCost.BuiltInCost.DCTTransfer)
+	assert.Equal(t, 2, numCalls)
+
+	manager.EpochConfirmed(15, 0)
+	assert.Equal(t, 2, numCalls)
+
+	manager.EpochConfirmed(25, 0)
+	assert.Equal(t, uint64(3), lastGasCost.BuiltInCost.DCTTransfer)
+	assert.Equal(t, lastGasCost, manager.CurrentGasConfig())
+	assert.Equal(t, 3, numCalls)
+
+	assert.Equal(t, uint64(2), manager.GasConfigForEpoch(19).BuiltInCost.DCTTransfer)
+}
+
+func TestGasScheduleManager_ApplyErrorIsReported(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	container := &mock.BuiltInFunctionContainerStub{
+		KeysCalled: func() map[string]struct{} {
+			return map[string]struct{}{"func": {}}
+		},
+		GetCalled: func(key string) (vmcommon.BuiltinFunction, error) {
+			return nil, expectedErr
+		},
+	}
+
+	manager, err := NewGasScheduleManager(createGasScheduleManagerArgs(container))
+	require.Nil(t, err)
+	assert.Nil(t, manager.CurrentGasConfig())
+
+	err = manager.applyGasScheduleForEpoch(10)
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, manager.CurrentGasConfig())
+}
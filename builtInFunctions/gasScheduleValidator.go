@@ -0,0 +1,120 @@
+package builtInFunctions
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+)
+
+// maxGasCostPerByte is the highest accepted per byte cost, so that multiplying it with any data length fits in uint64
+const maxGasCostPerByte = math.MaxUint32
+
+// maxGasCost is the highest accepted cost, so that it can be safely converted to a signed value
+const maxGasCost = math.MaxInt64
+
+var gasScheduleSections = map[string]reflect.Type{
+	vmcommon.BaseOperationCostString: reflect.TypeOf(vmcommon.BaseOperationCost{}),
+	vmcommon.BuiltInCostString:       reflect.TypeOf(vmcommon.BuiltInCost{}),
+}
+
+// ValidateGasSchedule strictly checks the sections of the gas schedule used by the built in functions. It rejects
+// unknown keys, missing or zero costs and values which are too high to be safely used, reporting all the problems at
+// once instead of only the first one
+func ValidateGasSchedule(gasSchedule map[string]map[string]uint64) error {
+	problems := make([]string, 0)
+	for sectionName, sectionType := range gasScheduleSections {
+		section, ok := gasSchedule[sectionName]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("missing section %s", sectionName))
+			continue
+		}
+
+		problems = append(problems, validateGasScheduleSection(sectionName, sectionType, section)...)
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	sort.Strings(problems)
+
+	return fmt.Errorf("%w: %s", ErrInvalidGasSchedule, strings.Join(problems, "; "))
+}
+
+func validateGasScheduleSection(sectionName string, sectionType reflect.Type, section map[string]uint64) []string {
+	problems := make([]string, 0)
+	knownKeys := make(map[string]struct{}, sectionType.NumField())
+	for i := 0; i < sectionType.NumField(); i++ {
+		key := sectionType.Field(i).Name
+		knownKeys[key] = struct{}{}
+
+		value, ok := section[key]
+		switch {
+		case !ok:
+			problems = append(problems, fmt.Sprintf("missing %s.%s", sectionName, key))
+		case value == 0:
+			problems = append(problems, fmt.Sprintf("zero %s.%s", sectionName, key))
+		case strings.HasSuffix(key, "PerByte") && value > maxGasCostPerByte:
+			problems = append(problems, fmt.Sprintf("per byte cost %s.%s = %d is above %d", sectionName, key, value, uint64(maxGasCostPerByte)))
+		case value > maxGasCost:
+			problems = append(problems, fmt.Sprintf("cost %s.%s = %d is above %d", sectionName, key, value, uint64(maxGasCost)))
+		}
+	}
+
+	for key := range section {
+		if _, ok := knownKeys[key]; !ok {
+			problems = append(problems, fmt.Sprintf("unknown %s.%s", sectionName, key))
+		}
+	}
+
+	return problems
+}
+
+// DiffGasSchedules returns a human readable description of the differences between two gas schedules, one line for
+// each added, removed or changed cost. The result is empty if the schedules hold the same costs
+func DiffGasSchedules(oldGasSchedule map[string]map[string]uint64, newGasSchedule map[string]map[string]uint64) string {
+	lines := make([]string, 0)
+	for sectionName, oldSection := range oldGasSchedule {
+		newSection := newGasSchedule[sectionName]
+		for key, oldValue := range oldSection {
+			newValue, ok := newSection[key]
+			if !ok {
+				lines = append(lines, fmt.Sprintf("- %s.%s: %d", sectionName, key, oldValue))
+				continue
+			}
+			if newValue != oldValue {
+				lines = append(lines, fmt.Sprintf("~ %s.%s: %d -> %d", sectionName, key, oldValue, newValue))
+			}
+		}
+	}
+
+	for sectionName, newSection := range newGasSchedule {
+		oldSection := oldGasSchedule[sectionName]
+		for key, newValue := range newSection {
+			if _, ok := oldSection[key]; !ok {
+				lines = append(lines, fmt.Sprintf("+ %s.%s: %d", sectionName, key, newValue))
+			}
+		}
+	}
+
+	sort.Slice(lines, func(i, j int) bool {
+		return lines[i][2:] < lines[j][2:]
+	})
+
+	return strings.Join(lines, "\n")
+}
+
+func createValidatedGasConfig(gasSchedule map[string]map[string]uint64, strictValidation bool) (*vmcommon.GasCost, error) {
+	if strictValidation {
+		err := ValidateGasSchedule(gasSchedule)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return createGasConfig(gasSchedule)
+}
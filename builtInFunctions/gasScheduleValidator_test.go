@@ -0,0 +1,69 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGasSchedule(t *testing.T) {
+	t.Parallel()
+
+	gasSchedule := createGasScheduleMap(1)
+	assert.Nil(t, ValidateGasSchedule(gasSchedule))
+
+	delete(gasSchedule[vmcommon.BuiltInCostString], "DCTTransfer")
+	gasSchedule[vmcommon.BuiltInCostString]["DCTTransfr"] = 10
+	gasSchedule[vmcommon.BuiltInCostString]["DCTBurn"] = 0
+	gasSchedule[vmcommon.BaseOperationCostString]["StorePerByte"] = math.MaxUint32 + 1
+	gasSchedule[vmcommon.BuiltInCostString]["SaveKeyValue"] = math.MaxUint64
+
+	err := ValidateGasSchedule(gasSchedule)
+	require.True(t, errors.Is(err, ErrInvalidGasSchedule))
+	assert.Equal(t, "invalid gas schedule: "+
+		"cost BuiltInCost.SaveKeyValue = 18446744073709551615 is above 9223372036854775807; "+
+		"missing BuiltInCost.DCTTransfer; "+
+		"per byte cost BaseOperationCost.StorePerByte = 4294967296 is above 4294967295; "+
+		"unknown BuiltInCost.DCTTransfr; "+
+		"zero BuiltInCost.DCTBurn", err.Error())
+
+	delete(gasSchedule, vmcommon.BaseOperationCostString)
+	err = ValidateGasSchedule(gasSchedule)
+	assert.Contains(t, err.Error(), "missing section BaseOperationCost")
+}
+
+func TestCreateValidatedGasConfig(t *testing.T) {
+	t.Parallel()
+
+	gasSchedule := createGasScheduleMap(1)
+	gasSchedule[vmcommon.BuiltInCostString]["DCTTransfr"] = 10
+
+	gasConfig, err := createValidatedGasConfig(gasSchedule, false)
+	assert.Nil(t, err)
+	assert.NotNil(t, gasConfig)
+
+	gasConfig, err = createValidatedGasConfig(gasSchedule, true)
+	assert.True(t, errors.Is(err, ErrInvalidGasSchedule))
+	assert.Nil(t, gasConfig)
+}
+
+func TestDiffGasSchedules(t *testing.T) {
+	t.Parallel()
+
+	oldGasSchedule := map[string]map[string]uint64{
+		vmcommon.BuiltInCostString: {"DCTTransfer": 100, "DCTBurn": 50, "Removed": 1},
+	}
+	newGasSchedule := map[string]map[string]uint64{
+		vmcommon.BuiltInCostString:       {"DCTTransfer": 200, "DCTBurn": 50},
+		vmcommon.BaseOperationCostString: {"StorePerByte": 10},
+	}
+
+	assert.Equal(t, "", DiffGasSchedules(oldGasSchedule, oldGasSchedule))
+	assert.Equal(t, "+ BaseOperationCost.StorePerByte: 10\n"+
+		"~ BuiltInCost.DCTTransfer: 100 -> 200\n"+
+		"- BuiltInCost.Removed: 1", DiffGasSchedules(oldGasSchedule, newGasSchedule))
+}
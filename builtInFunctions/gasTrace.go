@@ -0,0 +1,78 @@
+package builtInFunctions
+
+import (
+	"sync"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+)
+
+const (
+	gasComponentFunction        = "BuiltInCost"
+	gasComponentStorePerByte    = "StorePerByte"
+	gasComponentPersistPerByte  = "PersistPerByte"
+	gasComponentDataCopyPerByte = "DataCopyPerByte"
+)
+
+// traceGas reports a gas component to the tracer of the call, if there is one
+func traceGas(vmInput *vmcommon.ContractCallInput, component string, units uint64, rate uint64) {
+	if check.IfNil(vmInput.GasTracer) {
+		return
+	}
+
+	vmInput.GasTracer.TraceGas(&vmcommon.GasTraceEntry{
+		Function:  vmInput.Function,
+		Component: component,
+		Units:     units,
+		Rate:      rate,
+		Total:     units * rate,
+	})
+}
+
+type gasTraceCollector struct {
+	mutEntries sync.RWMutex
+	entries    []*vmcommon.GasTraceEntry
+}
+
+// NewGasTraceCollector creates a gas tracer which keeps all the reported gas components in order
+func NewGasTraceCollector() *gasTraceCollector {
+	return &gasTraceCollector{
+		entries: make([]*vmcommon.GasTraceEntry, 0),
+	}
+}
+
+// TraceGas saves the gas component
+func (g *gasTraceCollector) TraceGas(entry *vmcommon.GasTraceEntry) {
+	g.mutEntries.Lock()
+	g.entries = append(g.entries, entry)
+	g.mutEntries.Unlock()
+}
+
+// Entries returns the gas components reported so far
+func (g *gasTraceCollector) Entries() []*vmcommon.GasTraceEntry {
+	g.mutEntries.RLock()
+	defer g.mutEntries.RUnlock()
+
+	entries := make([]*vmcommon.GasTraceEntry, len(g.entries))
+	copy(entries, g.entries)
+
+	return entries
+}
+
+// Total returns the sum of the gas components reported so far
+func (g *gasTraceCollector) Total() uint64 {
+	g.mutEntries.RLock()
+	defer g.mutEntries.RUnlock()
+
+	total := uint64(0)
+	for _, entry := range g.entries {
+		total += entry.Total
+	}
+
+	return total
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (g *gasTraceCollector) IsInterfaceNil() bool {
+	return g == nil
+}
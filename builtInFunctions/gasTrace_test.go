@@ -0,0 +1,138 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGasTraceCollector(t *testing.T) {
+	t.Parallel()
+
+	collector := NewGasTraceCollector()
+	assert.False(t, check.IfNil(collector))
+	assert.Empty(t, collector.Entries())
+
+	vmInput := &vmcommon.ContractCallInput{Function: "function"}
+	traceGas(vmInput, gasComponentFunction, 1, 10)
+
+	vmInput.GasTracer = collector
+	traceGas(vmInput, gasComponentFunction, 1, 10)
+	traceGas(vmInput, gasComponentStorePerByte, 5, 3)
+
+	assert.Equal(t, []*vmcommon.GasTraceEntry{
+		{Function: "function", Component: gasComponentFunction, Units: 1, Rate: 10, Total: 10},
+		{Function: "function", Component: gasComponentStorePerByte, Units: 5, Rate: 3, Total: 15},
+	}, collector.Entries())
+	assert.Equal(t, uint64(25), collector.Total())
+}
+
+func TestSaveKeyValue_ProcessBuiltinFunctionTracesGas(t *testing.T) {
+	t.Parallel()
+
+	gasConfig := vmcommon.BaseOperationCost{
+		StorePerByte:      3,
+		ReleasePerByte:    1,
+		DataCopyPerByte:   1,
+		PersistPerByte:    2,
+		CompilePerByte:    1,
+		AoTPreparePerByte: 1,
+	}
+	skv, _ := NewSaveKeyValueStorageFunc(gasConfig, 5, 0, &mock.EpochNotifierStub{})
+
+	addr := []byte("addr")
+	acc := mock.NewUserAccount(addr)
+	collector := NewGasTraceCollector()
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  addr,
+			GasProvided: 20,
+			CallValue:   big.NewInt(0),
+			Arguments:   [][]byte{[]byte("key"), []byte("value")},
+			GasTracer:   collector,
+		},
+		RecipientAddr: addr,
+		Function:      vmcommon.BuiltInFunctionSaveKeyValue,
+	}
+
+	_, err := skv.ProcessBuiltinFunction(acc, nil, vmInput)
+	require.Equal(t, ErrNotEnoughGas, err)
+	assert.Equal(t, []*vmcommon.GasTraceEntry{
+		{Function: vmcommon.BuiltInFunctionSaveKeyValue, Component: gasComponentFunction, Units: 1, Rate: 5, Total: 5},
+		{Function: vmcommon.BuiltInFunctionSaveKeyValue, Component: gasComponentPersistPerByte, Units: 8, Rate: 2, Total: 16},
+		{Function: vmcommon.BuiltInFunctionSaveKeyValue, Component: gasComponentStorePerByte, Units: 5, Rate: 3, Total: 15},
+	}, collector.Entries())
+	assert.Equal(t, uint64(36), collector.Total())
+}
+
+func TestDCTLocalBurn_ProcessBuiltinFunctionTracesGas(t *testing.T) {
+	t.Parallel()
+
+	localBurn, _ := NewDCTLocalBurnFunc(50, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.DCTRoleHandlerStub{}, &mock.SupplyHandlerStub{}, &mock.LockedBalanceHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	addr := []byte("addr")
+	collector := NewGasTraceCollector()
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  addr,
+			GasProvided: 40,
+			CallValue:   big.NewInt(0),
+			Arguments:   [][]byte{[]byte("TKN"), big.NewInt(1).Bytes()},
+			GasTracer:   collector,
+		},
+		RecipientAddr: addr,
+		Function:      vmcommon.BuiltInFunctionDCTLocalBurn,
+	}
+
+	_, err := localBurn.ProcessBuiltinFunction(mock.NewUserAccount(addr), nil, vmInput)
+	require.Equal(t, ErrNotEnoughGas, err)
+	assert.Equal(t, []*vmcommon.GasTraceEntry{
+		{Function: vmcommon.BuiltInFunctionDCTLocalBurn, Component: gasComponentFunction, Units: 1, Rate: 50, Total: 50},
+	}, collector.Entries())
+}
+
+func TestDCTModifyRoyalties_ProcessBuiltinFunctionTracesGas(t *testing.T) {
+	t.Parallel()
+
+	gasConfig := vmcommon.BaseOperationCost{StorePerByte: 7}
+	modifyRoyalties, _ := NewDCTModifyRoyaltiesFunc(10, gasConfig, &mock.MarshalizerMock{}, createNewDCTDataStorageHandler(), &mock.DCTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	caller := []byte("caller")
+	collector := NewGasTraceCollector()
+	vmInput := createMetaDataModifyInput(caller, []byte("TKN"), big.NewInt(1).Bytes(), big.NewInt(500).Bytes())
+	vmInput.Function = vmcommon.BuiltInFunctionDCTModifyRoyalties
+	vmInput.GasTracer = collector
+	vmInput.GasProvided = 20
+
+	_, err := modifyRoyalties.ProcessBuiltinFunction(mock.NewUserAccount(caller), nil, vmInput)
+	require.Equal(t, ErrNotEnoughGas, err)
+	assert.Equal(t, []*vmcommon.GasTraceEntry{
+		{Function: vmcommon.BuiltInFunctionDCTModifyRoyalties, Component: gasComponentFunction, Units: 1, Rate: 10, Total: 10},
+		{Function: vmcommon.BuiltInFunctionDCTModifyRoyalties, Component: gasComponentStorePerByte, Units: 2, Rate: 7, Total: 14},
+	}, collector.Entries())
+}
+
+func TestDCTLockedTransfer_ProcessBuiltinFunctionTracesGas(t *testing.T) {
+	t.Parallel()
+
+	lockedTransfer, _ := createLockedTransferWithMockArguments()
+	lockedTransfer.gasConfig.StorePerByte = 1
+	sender := mock.NewUserAccount(createSettleSaleAddress(1, 0))
+	receiver := mock.NewUserAccount(createSettleSaleAddress(2, 0))
+	setTransferFromBalance(t, sender, []byte("TKN"), 100)
+	collector := NewGasTraceCollector()
+	vmInput := createLockedTransferInput(sender.AddressBytes(), receiver.AddressBytes(), 40, 5)
+	vmInput.Function = vmcommon.BuiltInFunctionDCTLockedTransfer
+	vmInput.GasTracer = collector
+
+	vmOutput, err := lockedTransfer.ProcessBuiltinFunction(sender, receiver, vmInput)
+	require.Nil(t, err)
+	entries := collector.Entries()
+	require.Equal(t, 2, len(entries))
+	assert.Equal(t, gasComponentFunction, entries[0].Component)
+	assert.Equal(t, gasComponentStorePerByte, entries[1].Component)
+	assert.Equal(t, vmInput.GasProvided-vmOutput.GasRemaining, collector.Total())
+}
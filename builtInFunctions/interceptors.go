@@ -0,0 +1,119 @@
+package builtInFunctions
+
+import (
+	"fmt"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+)
+
+func checkInterceptors(
+	preInterceptors []vmcommon.BuiltInFunctionPreInterceptor,
+	postInterceptors []vmcommon.BuiltInFunctionPostInterceptor,
+) error {
+	for index, preInterceptor := range preInterceptors {
+		if check.IfNil(preInterceptor) {
+			return fmt.Errorf("%w, pre interceptor at index %d", ErrNilInterceptor, index)
+		}
+	}
+	for index, postInterceptor := range postInterceptors {
+		if check.IfNil(postInterceptor) {
+			return fmt.Errorf("%w, post interceptor at index %d", ErrNilInterceptor, index)
+		}
+	}
+
+	return nil
+}
+
+// interceptedBuiltInFunction runs the pre interceptors, in the order they were registered, before executing the
+// wrapped built in function and then the post interceptors, in the order they were registered, on its result.
+// The first pre interceptor returning an error stops the execution. A panic of the wrapped function is turned into
+// an ErrBuiltInFunctionPanic error which is handed to the post interceptors
+type interceptedBuiltInFunction struct {
+	vmcommon.BuiltinFunction
+	function         string
+	preInterceptors  []vmcommon.BuiltInFunctionPreInterceptor
+	postInterceptors []vmcommon.BuiltInFunctionPostInterceptor
+}
+
+func newInterceptedBuiltInFunction(
+	name string,
+	builtInFunc vmcommon.BuiltinFunction,
+	preInterceptors []vmcommon.BuiltInFunctionPreInterceptor,
+	postInterceptors []vmcommon.BuiltInFunctionPostInterceptor,
+) *interceptedBuiltInFunction {
+	return &interceptedBuiltInFunction{
+		BuiltinFunction:  builtInFunc,
+		function:         name,
+		preInterceptors:  preInterceptors,
+		postInterceptors: postInterceptors,
+	}
+}
+
+// ProcessBuiltinFunction executes the wrapped built in function surrounded by the interceptors
+func (i *interceptedBuiltInFunction) ProcessBuiltinFunction(
+	acntSnd, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	for _, preInterceptor := range i.preInterceptors {
+		err := preInterceptor.PreProcess(i.function, acntSnd, acntDst, vmInput)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	vmOutput, err := i.processRecoveringPanic(acntSnd, acntDst, vmInput)
+	for _, postInterceptor := range i.postInterceptors {
+		vmOutput, err = postInterceptor.PostProcess(i.function, acntSnd, acntDst, vmInput, vmOutput, err)
+	}
+
+	return vmOutput, err
+}
+
+func (i *interceptedBuiltInFunction) processRecoveringPanic(
+	acntSnd, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (vmOutput *vmcommon.VMOutput, err error) {
+	defer func() {
+		r := recover()
+		if r != nil {
+			log.Error("built in function panicked", "function", i.function, "panic", r)
+			vmOutput = nil
+			err = fmt.Errorf("%w, function %s: %v", ErrBuiltInFunctionPanic, i.function, r)
+		}
+	}()
+
+	return i.BuiltinFunction.ProcessBuiltinFunction(acntSnd, acntDst, vmInput)
+}
+
+// SetPayableHandler forwards the payable handler if the wrapped function accepts one
+func (i *interceptedBuiltInFunction) SetPayableHandler(payableHandler vmcommon.PayableHandler) error {
+	acceptPayableHandler, ok := i.BuiltinFunction.(vmcommon.AcceptPayableHandler)
+	if !ok {
+		return nil
+	}
+
+	return acceptPayableHandler.SetPayableHandler(payableHandler)
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (i *interceptedBuiltInFunction) IsInterfaceNil() bool {
+	return i == nil
+}
+
+// applyInterceptors surrounds every built in function in the container, including all the versions of a versioned
+// function, with the configured interceptors. The functions are wrapped even without interceptors, so that a panic
+// is always recovered into an ErrBuiltInFunctionPanic error
+func (b *builtInFuncFactory) applyInterceptors() error {
+	for name := range b.builtInFunctions.Keys() {
+		functionName := name
+		err := b.builtInFunctions.wrap(functionName, func(builtInFunc vmcommon.BuiltinFunction) vmcommon.BuiltinFunction {
+			return newInterceptedBuiltInFunction(functionName, builtInFunc, b.preInterceptors, b.postInterceptors)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,236 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createRecordingInterceptors(calls *[]string, numInterceptors int) ([]vmcommon.BuiltInFunctionPreInterceptor, []vmcommon.BuiltInFunctionPostInterceptor) {
+	preInterceptors := make([]vmcommon.BuiltInFunctionPreInterceptor, 0, numInterceptors)
+	postInterceptors := make([]vmcommon.BuiltInFunctionPostInterceptor, 0, numInterceptors)
+	for index := 0; index < numInterceptors; index++ {
+		preName := fmt.Sprintf("pre%d", index)
+		postName := fmt.Sprintf("post%d", index)
+		preInterceptors = append(preInterceptors, &mock.BuiltInFunctionPreInterceptorStub{
+			PreProcessCalled: func(_ string, _, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) error {
+				*calls = append(*calls, preName)
+				return nil
+			},
+		})
+		postInterceptors = append(postInterceptors, &mock.BuiltInFunctionPostInterceptorStub{
+			PostProcessCalled: func(_ string, _, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput, err error) (*vmcommon.VMOutput, error) {
+				*calls = append(*calls, postName)
+				return vmOutput, err
+			},
+		})
+	}
+
+	return preInterceptors, postInterceptors
+}
+
+func TestNewBuiltInFunctionsFactory_NilInterceptorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createFactoryArgs(nil, &mock.EpochNotifierStub{})
+	args.PreInterceptors = []vmcommon.BuiltInFunctionPreInterceptor{&mock.BuiltInFunctionPreInterceptorStub{}, nil}
+	_, err := NewBuiltInFunctionsFactory(args)
+	assert.True(t, errors.Is(err, ErrNilInterceptor))
+
+	args = createFactoryArgs(nil, &mock.EpochNotifierStub{})
+	args.PostInterceptors = []vmcommon.BuiltInFunctionPostInterceptor{nil}
+	_, err = NewBuiltInFunctionsFactory(args)
+	assert.True(t, errors.Is(err, ErrNilInterceptor))
+}
+
+func TestInterceptedBuiltInFunction_ProcessBuiltinFunctionShouldRunChainInOrder(t *testing.T) {
+	t.Parallel()
+
+	calls := make([]string, 0)
+	preInterceptors, postInterceptors := createRecordingInterceptors(&calls, 2)
+	expectedOutput := &vmcommon.VMOutput{GasRemaining: 10}
+	builtInFunc := &mock.BuiltInFunctionStub{
+		ProcessBuiltinFunctionCalled: func(_, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			calls = append(calls, "function")
+			return expectedOutput, nil
+		},
+	}
+
+	intercepted := newInterceptedBuiltInFunction("function", builtInFunc, preInterceptors, postInterceptors)
+	vmOutput, err := intercepted.ProcessBuiltinFunction(nil, nil, &vmcommon.ContractCallInput{})
+
+	assert.Nil(t, err)
+	assert.True(t, vmOutput == expectedOutput)
+	assert.Equal(t, []string{"pre0", "pre1", "function", "post0", "post1"}, calls)
+}
+
+func TestInterceptedBuiltInFunction_PreInterceptorShouldVetoExecution(t *testing.T) {
+	t.Parallel()
+
+	calls := make([]string, 0)
+	_, postInterceptors := createRecordingInterceptors(&calls, 1)
+	expectedErr := errors.New("arguments too large")
+	preInterceptors := []vmcommon.BuiltInFunctionPreInterceptor{
+		&mock.BuiltInFunctionPreInterceptorStub{
+			PreProcessCalled: func(functionName string, _, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) error {
+				assert.Equal(t, "function", functionName)
+				return expectedErr
+			},
+		},
+		&mock.BuiltInFunctionPreInterceptorStub{
+			PreProcessCalled: func(_ string, _, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		},
+	}
+	builtInFunc := &mock.BuiltInFunctionStub{
+		ProcessBuiltinFunctionCalled: func(_, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	intercepted := newInterceptedBuiltInFunction("function", builtInFunc, preInterceptors, postInterceptors)
+	vmOutput, err := intercepted.ProcessBuiltinFunction(nil, nil, &vmcommon.ContractCallInput{})
+
+	assert.Nil(t, vmOutput)
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 0, len(calls))
+}
+
+func TestInterceptedBuiltInFunction_PostInterceptorsShouldAnnotateOutputAndSeeErrors(t *testing.T) {
+	t.Parallel()
+
+	functionErr := errors.New("function error")
+	var receivedErr error
+	postInterceptors := []vmcommon.BuiltInFunctionPostInterceptor{
+		&mock.BuiltInFunctionPostInterceptorStub{
+			PostProcessCalled: func(_ string, _, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput, err error) (*vmcommon.VMOutput, error) {
+				receivedErr = err
+				return &vmcommon.VMOutput{ReturnMessage: "annotated"}, nil
+			},
+		},
+		&mock.BuiltInFunctionPostInterceptorStub{
+			PostProcessCalled: func(_ string, _, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput, err error) (*vmcommon.VMOutput, error) {
+				vmOutput.ReturnMessage += " twice"
+				return vmOutput, err
+			},
+		},
+	}
+	builtInFunc := &mock.BuiltInFunctionStub{
+		ProcessBuiltinFunctionCalled: func(_, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return nil, functionErr
+		},
+	}
+
+	intercepted := newInterceptedBuiltInFunction("function", builtInFunc, nil, postInterceptors)
+	vmOutput, err := intercepted.ProcessBuiltinFunction(nil, nil, &vmcommon.ContractCallInput{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, functionErr, receivedErr)
+	assert.Equal(t, "annotated twice", vmOutput.ReturnMessage)
+}
+
+func TestInterceptedBuiltInFunction_PanicShouldBeRecovered(t *testing.T) {
+	t.Parallel()
+
+	var receivedErr error
+	postInterceptors := []vmcommon.BuiltInFunctionPostInterceptor{
+		&mock.BuiltInFunctionPostInterceptorStub{
+			PostProcessCalled: func(_ string, _, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput, err error) (*vmcommon.VMOutput, error) {
+				receivedErr = err
+				return vmOutput, err
+			},
+		},
+	}
+	builtInFunc := &mock.BuiltInFunctionStub{
+		ProcessBuiltinFunctionCalled: func(_, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			panic("boom")
+		},
+	}
+
+	intercepted := newInterceptedBuiltInFunction("function", builtInFunc, nil, postInterceptors)
+	vmOutput, err := intercepted.ProcessBuiltinFunction(nil, nil, &vmcommon.ContractCallInput{})
+
+	assert.Nil(t, vmOutput)
+	assert.True(t, errors.Is(err, ErrBuiltInFunctionPanic))
+	assert.Equal(t, err, receivedErr)
+}
+
+func TestBuiltInFuncFactory_CreateBuiltInFunctionContainerWithoutInterceptorsShouldRecoverPanics(t *testing.T) {
+	t.Parallel()
+
+	customBuiltInFunctions := []CustomBuiltInFunction{
+		{
+			Name: "Custom",
+			Constructor: func(_ BuiltInFunctionDependencies) (vmcommon.BuiltinFunction, error) {
+				return &mock.BuiltInFunctionStub{
+					ProcessBuiltinFunctionCalled: func(_, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+						panic("boom")
+					},
+				}, nil
+			},
+		},
+	}
+	factory, err := NewBuiltInFunctionsFactory(createFactoryArgs(customBuiltInFunctions, &mock.EpochNotifierStub{}))
+	require.Nil(t, err)
+	container, err := factory.CreateBuiltInFunctionContainer()
+	require.Nil(t, err)
+
+	builtInFunc, _ := container.Get("Custom")
+	vmOutput, err := builtInFunc.ProcessBuiltinFunction(nil, nil, &vmcommon.ContractCallInput{})
+	assert.Nil(t, vmOutput)
+	assert.True(t, errors.Is(err, ErrBuiltInFunctionPanic))
+}
+
+func TestBuiltInFuncFactory_CreateBuiltInFunctionContainerWithInterceptors(t *testing.T) {
+	t.Parallel()
+
+	calls := make([]string, 0)
+	payable := &payableBuiltInFunctionStub{}
+	customBuiltInFunctions := []CustomBuiltInFunction{
+		{
+			Name: "Custom",
+			Constructor: func(_ BuiltInFunctionDependencies) (vmcommon.BuiltinFunction, error) {
+				return payable, nil
+			},
+		},
+	}
+	args := createFactoryArgs(customBuiltInFunctions, &mock.EpochNotifierStub{})
+	args.PreInterceptors, args.PostInterceptors = createRecordingInterceptors(&calls, 1)
+	args.BuiltInFunctionsEnableEpochs = map[string]BuiltInFunctionEnableEpochs{
+		"Custom": {ActivationEpoch: 0},
+	}
+
+	factory, err := NewBuiltInFunctionsFactory(args)
+	require.Nil(t, err)
+	container, err := factory.CreateBuiltInFunctionContainer()
+	require.Nil(t, err)
+
+	for key := range container.Keys() {
+		builtInFunc, errGet := container.Get(key)
+		require.Nil(t, errGet)
+		_, ok := builtInFunc.(*interceptedBuiltInFunction)
+		assert.True(t, ok, key)
+	}
+
+	builtInFunc, _ := container.Get("Custom")
+	intercepted := builtInFunc.(*interceptedBuiltInFunction)
+	assert.Equal(t, "Custom", intercepted.function)
+	_, ok := intercepted.BuiltinFunction.(*epochGatedBuiltInFunction)
+	assert.True(t, ok)
+
+	_, _ = builtInFunc.ProcessBuiltinFunction(nil, nil, &vmcommon.ContractCallInput{})
+	assert.Equal(t, []string{"pre0", "post0"}, calls)
+
+	payableHandler := &mock.PayableHandlerStub{}
+	err = SetPayableHandler(container, payableHandler)
+	assert.Nil(t, err)
+	assert.True(t, payable.payableHandler == payableHandler)
+}
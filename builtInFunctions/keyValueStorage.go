@@ -12,6 +12,7 @@ import (
 
 type saveKeyValueStorage struct {
 	baseAlwaysActive
+	baseGasRefund
 	gasConfig    vmcommon.BaseOperationCost
 	funcGasCost  uint64
 	mutExecution sync.RWMutex
@@ -21,11 +22,22 @@ type saveKeyValueStorage struct {
 func NewSaveKeyValueStorageFunc(
 	gasConfig vmcommon.BaseOperationCost,
 	funcGasCost uint64,
+	gasRefundEnableEpoch uint32,
+	epochNotifier vmcommon.EpochNotifier,
 ) (*saveKeyValueStorage, error) {
+	if check.IfNil(epochNotifier) {
+		return nil, ErrNilEpochHandler
+	}
+
 	s := &saveKeyValueStorage{
+		baseGasRefund: baseGasRefund{
+			function:             vmcommon.BuiltInFunctionSaveKeyValue,
+			gasRefundEnableEpoch: gasRefundEnableEpoch,
+		},
 		gasConfig:   gasConfig,
 		funcGasCost: funcGasCost,
 	}
+	epochNotifier.RegisterNotifyHandler(s)
 
 	return s, nil
 }
@@ -60,18 +72,24 @@ func (k *saveKeyValueStorage) ProcessBuiltinFunction(
 		GasRefund:    big.NewInt(0),
 	}
 
+	traceGas(input, gasComponentFunction, 1, k.funcGasCost)
 	useGas := k.funcGasCost
+	releasedBytes := uint64(0)
 	for i := 0; i < len(input.Arguments); i += 2 {
 		key := input.Arguments[i]
 		value := input.Arguments[i+1]
 		length := uint64(len(value) + len(key))
+		traceGas(input, gasComponentPersistPerByte, length, k.gasConfig.PersistPerByte)
 		useGas += length * k.gasConfig.PersistPerByte
 
 		if !vmcommon.IsAllowedToSaveUnderKey(key) {
 			return nil, fmt.Errorf("%w it is not allowed to save under key %s", ErrOperationNotPermitted, key)
 		}
 
-		oldValue, _ := acntSnd.AccountDataHandler().RetrieveValue(key)
+		oldValue, err := acntSnd.AccountDataHandler().RetrieveValue(key)
+		if err != nil && k.flagGasRefund.IsSet() {
+			return nil, err
+		}
 		if bytes.Equal(oldValue, value) {
 			continue
 		}
@@ -83,6 +101,8 @@ func (k *saveKeyValueStorage) ProcessBuiltinFunction(
 			lengthChange = lengthNewValue - lengthOldValue
 		}
 
+		traceGas(input, gasComponentStorePerByte, lengthChange, k.gasConfig.StorePerByte)
+		releasedBytes += computeReleasedBytes(lengthOldValue, lengthNewValue)
 		useGas += k.gasConfig.StorePerByte * lengthChange
 		if input.GasProvided < useGas {
 			return nil, ErrNotEnoughGas
@@ -95,6 +115,9 @@ func (k *saveKeyValueStorage) ProcessBuiltinFunction(
 	}
 
 	vmOutput.GasRemaining -= useGas
+	if k.flagGasRefund.IsSet() {
+		vmOutput.GasRefund = computeGasRefund(releasedBytes, k.gasConfig.ReleasePerByte, useGas)
+	}
 
 	return vmOutput, nil
 }
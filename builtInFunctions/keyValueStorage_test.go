@@ -20,7 +20,11 @@ func TestNewSaveKeyValueStorageFunc(t *testing.T) {
 		StorePerByte: 1,
 	}
 
-	kvs, err := NewSaveKeyValueStorageFunc(gasConfig, funcGasCost)
+	kvs, err := NewSaveKeyValueStorageFunc(gasConfig, funcGasCost, 0, nil)
+	require.True(t, check.IfNil(kvs))
+	require.Equal(t, ErrNilEpochHandler, err)
+
+	kvs, err = NewSaveKeyValueStorageFunc(gasConfig, funcGasCost, 0, &mock.EpochNotifierStub{})
 	require.NoError(t, err)
 	require.False(t, check.IfNil(kvs))
 	require.Equal(t, funcGasCost, kvs.funcGasCost)
@@ -35,7 +39,7 @@ func TestSaveKeyValueStorageFunc_SetNewGasConfig(t *testing.T) {
 		StorePerByte: 1,
 	}
 
-	kvs, _ := NewSaveKeyValueStorageFunc(gasConfig, funcGasCost)
+	kvs, _ := NewSaveKeyValueStorageFunc(gasConfig, funcGasCost, 0, &mock.EpochNotifierStub{})
 	require.NotNil(t, kvs)
 
 	newGasConfig := vmcommon.BaseOperationCost{
@@ -61,7 +65,7 @@ func TestSaveKeyValue_ProcessBuiltinFunction(t *testing.T) {
 		AoTPreparePerByte: 1,
 	}
 
-	skv, _ := NewSaveKeyValueStorageFunc(gasConfig, funcGasCost)
+	skv, _ := NewSaveKeyValueStorageFunc(gasConfig, funcGasCost, 0, &mock.EpochNotifierStub{})
 
 	addr := []byte("addr")
 	acc := mock.NewUserAccount(addr)
@@ -115,7 +119,7 @@ func TestSaveKeyValue_ProcessBuiltinFunctionMultipleKeys(t *testing.T) {
 		PersistPerByte:  1,
 		CompilePerByte:  1,
 	}
-	skv, _ := NewSaveKeyValueStorageFunc(gasConfig, funcGasCost)
+	skv, _ := NewSaveKeyValueStorageFunc(gasConfig, funcGasCost, 0, &mock.EpochNotifierStub{})
 
 	addr := []byte("addr")
 	acc := mock.NewUserAccount(addr)
@@ -15,15 +15,21 @@ import (
 
 type dctNFTMultiTransfer struct {
 	*baseEnabled
-	keyPrefix        []byte
-	marshalizer      vmcommon.Marshalizer
-	pauseHandler     vmcommon.DCTPauseHandler
-	payableHandler   vmcommon.PayableHandler
-	funcGasCost      uint64
-	accounts         vmcommon.AccountsAdapter
-	shardCoordinator vmcommon.Coordinator
-	gasConfig        vmcommon.BaseOperationCost
-	mutExecution     sync.RWMutex
+	keyPrefix             []byte
+	marshalizer           vmcommon.Marshalizer
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler
+	rolesHandler          vmcommon.DCTRoleHandler
+	storageHandler        vmcommon.DCTNFTStorageHandler
+	payableHandler        vmcommon.PayableHandler
+	funcGasCost           uint64
+	accounts              vmcommon.AccountsAdapter
+	shardCoordinator      vmcommon.Coordinator
+	gasConfig             vmcommon.BaseOperationCost
+	lockedBalanceHandler  vmcommon.DCTLockedBalanceHandler
+	mutExecution          sync.RWMutex
+
+	moaTransferEnableEpoch uint32
+	flagMOATransfer        atomic.Flag
 }
 
 const argumentsPerTransfer = uint64(3)
@@ -32,18 +38,28 @@ const argumentsPerTransfer = uint64(3)
 func NewDCTNFTMultiTransferFunc(
 	funcGasCost uint64,
 	marshalizer vmcommon.Marshalizer,
-	pauseHandler vmcommon.DCTPauseHandler,
+	globalSettingsHandler vmcommon.DCTGlobalSettingsHandler,
+	rolesHandler vmcommon.DCTRoleHandler,
 	accounts vmcommon.AccountsAdapter,
 	shardCoordinator vmcommon.Coordinator,
 	gasConfig vmcommon.BaseOperationCost,
+	storageHandler vmcommon.DCTNFTStorageHandler,
+	lockedBalanceHandler vmcommon.DCTLockedBalanceHandler,
 	activationEpoch uint32,
+	moaTransferEnableEpoch uint32,
 	epochNotifier vmcommon.EpochNotifier,
 ) (*dctNFTMultiTransfer, error) {
 	if check.IfNil(marshalizer) {
 		return nil, ErrNilMarshalizer
 	}
-	if check.IfNil(pauseHandler) {
-		return nil, ErrNilPauseHandler
+	if check.IfNil(globalSettingsHandler) {
+		return nil, ErrNilGlobalSettingsHandler
+	}
+	if check.IfNil(rolesHandler) {
+		return nil, ErrNilRolesHandler
+	}
+	if check.IfNil(storageHandler) {
+		return nil, ErrNilDCTNFTStorageHandler
 	}
 	if check.IfNil(accounts) {
 		return nil, ErrNilAccountsAdapter
@@ -54,17 +70,26 @@ func NewDCTNFTMultiTransferFunc(
 	if check.IfNil(epochNotifier) {
 		return nil, ErrNilEpochHandler
 	}
+	if check.IfNil(lockedBalanceHandler) {
+		return nil, ErrNilLockedBalanceHandler
+	}
 
 	e := &dctNFTMultiTransfer{
-		keyPrefix:        []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
-		marshalizer:      marshalizer,
-		pauseHandler:     pauseHandler,
-		funcGasCost:      funcGasCost,
-		accounts:         accounts,
-		shardCoordinator: shardCoordinator,
-		gasConfig:        gasConfig,
-		mutExecution:     sync.RWMutex{},
-		payableHandler:   &disabledPayableHandler{},
+		keyPrefix:             []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier),
+		marshalizer:           marshalizer,
+		globalSettingsHandler: globalSettingsHandler,
+		rolesHandler:          rolesHandler,
+		storageHandler:        storageHandler,
+		funcGasCost:           funcGasCost,
+		accounts:              accounts,
+		shardCoordinator:      shardCoordinator,
+		gasConfig:             gasConfig,
+		mutExecution:          sync.RWMutex{},
+		payableHandler:        &disabledPayableHandler{},
+		lockedBalanceHandler:  lockedBalanceHandler,
+
+		moaTransferEnableEpoch: moaTransferEnableEpoch,
+		flagMOATransfer:        atomic.Flag{},
 	}
 
 	e.baseEnabled = &baseEnabled{
@@ -78,6 +103,13 @@ func NewDCTNFTMultiTransferFunc(
 	return e, nil
 }
 
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (e *dctNFTMultiTransfer) EpochConfirmed(epoch uint32, nonce uint64) {
+	e.baseEnabled.EpochConfirmed(epoch, nonce)
+	e.flagMOATransfer.Toggle(epoch >= e.moaTransferEnableEpoch)
+	log.Debug("dct multi transfer of native MOA", "enabled", e.flagMOATransfer.IsSet())
+}
+
 // SetPayableHandler will set the payable handler to the function
 func (e *dctNFTMultiTransfer) SetPayableHandler(payableHandler vmcommon.PayableHandler) error {
 	if check.IfNil(payableHandler) {
@@ -104,7 +136,7 @@ func (e *dctNFTMultiTransfer) SetNewGasConfig(gasCost *vmcommon.GasCost) {
 // Requires the following arguments:
 // arg0 - destination address
 // arg1 - number of tokens to transfer
-// list of (tokenID - nonce - quantity) - in case of DCT nonce == 0
+// list of (tokenID - nonce - quantity) - in case of DCT nonce == 0, in case of MOA tokenID == MOA-000000 and nonce == 0
 // function and list of arguments for SC Call
 // if cross-shard, the rest of arguments will be filled inside the SCR
 // arg0 - number of tokens to transfer
@@ -156,7 +188,17 @@ func (e *dctNFTMultiTransfer) ProcessBuiltinFunction(
 
 		dctTokenKey := append(e.keyPrefix, tokenID...)
 
-		if nonce > 0 {
+		if e.isMOATransfer(tokenID) {
+			err = e.addMOAToDestination(
+				vmInput.RecipientAddr,
+				acntDst,
+				nonce,
+				big.NewInt(0).SetBytes(vmInput.Arguments[tokenStartIndex+2]),
+				mustVerifyPayable(vmInput, int(minNumOfArguments)))
+			if err != nil {
+				return nil, err
+			}
+		} else if nonce > 0 {
 			marshaledNFTTransfer := vmInput.Arguments[tokenStartIndex+2]
 			dctTransferData := &dct.DCToken{}
 			err = e.marshalizer.Unmarshal(dctTransferData, marshaledNFTTransfer)
@@ -168,6 +210,7 @@ func (e *dctNFTMultiTransfer) ProcessBuiltinFunction(
 				vmInput.RecipientAddr,
 				acntDst,
 				dctTransferData,
+				tokenID,
 				dctTokenKey,
 				mustVerifyPayable(vmInput, int(minNumOfArguments)),
 				vmInput.ReturnCallAfterError)
@@ -175,7 +218,12 @@ func (e *dctNFTMultiTransfer) ProcessBuiltinFunction(
 				return nil, err
 			}
 		} else {
-			err = addToDCTBalance(acntDst, dctTokenKey, big.NewInt(0).SetBytes(vmInput.Arguments[tokenStartIndex+2]), e.marshalizer, e.pauseHandler, vmInput.ReturnCallAfterError)
+			err = checkLimitedTransfer(acntDst, tokenID, dctTokenKey, e.globalSettingsHandler, e.rolesHandler, vmInput.ReturnCallAfterError)
+			if err != nil {
+				return nil, err
+			}
+
+			err = addToDCTBalance(acntDst, dctTokenKey, big.NewInt(0).SetBytes(vmInput.Arguments[tokenStartIndex+2]), e.marshalizer, e.globalSettingsHandler, e.lockedBalanceHandler, vmInput.ReturnCallAfterError)
 			if err != nil {
 				return nil, err
 			}
@@ -229,6 +277,7 @@ func (e *dctNFTMultiTransfer) processDCTNFTMultiTransferOnSenderShard(
 		return nil, fmt.Errorf("%w, invalid number of arguments", ErrInvalidArguments)
 	}
 
+	traceGas(vmInput, gasComponentFunction, numOfTransfers, e.funcGasCost)
 	multiTransferCost := numOfTransfers * e.funcGasCost
 	if vmInput.GasProvided < multiTransferCost {
 		return nil, ErrNotEnoughGas
@@ -287,6 +336,12 @@ func (e *dctNFTMultiTransfer) processDCTNFTMultiTransferOnSenderShard(
 	return vmOutput, nil
 }
 
+// isMOATransfer returns true if the token identifier stands for the native MOA value. Before the enable epoch the
+// reserved identifier is handled as any other token
+func (e *dctNFTMultiTransfer) isMOATransfer(tokenID []byte) bool {
+	return e.flagMOATransfer.IsSet() && vmcommon.IsMOATokenIdentifier(tokenID)
+}
+
 func (e *dctNFTMultiTransfer) transferOneTokenOnSenderShard(
 	acntSnd vmcommon.UserAccountHandler,
 	acntDst vmcommon.UserAccountHandler,
@@ -300,9 +355,12 @@ func (e *dctNFTMultiTransfer) transferOneTokenOnSenderShard(
 	if quantityToTransfer.Cmp(zero) <= 0 {
 		return nil, ErrInvalidNFTQuantity
 	}
+	if e.isMOATransfer(tokenID) {
+		return e.transferMOAOnSenderShard(acntSnd, acntDst, dstAddress, nonce, quantityToTransfer, verifyPayable)
+	}
 
 	dctTokenKey := append(e.keyPrefix, tokenID...)
-	dctData, err := getDCTNFTTokenOnSender(acntSnd, dctTokenKey, nonce, e.marshalizer)
+	dctData, err := e.storageHandler.GetDCTNFTTokenOnSender(acntSnd, dctTokenKey, nonce)
 	if err != nil {
 		return nil, err
 	}
@@ -310,17 +368,35 @@ func (e *dctNFTMultiTransfer) transferOneTokenOnSenderShard(
 	if dctData.Value.Cmp(quantityToTransfer) < 0 {
 		return nil, ErrInvalidNFTQuantity
 	}
+	err = checkLimitedTransfer(acntSnd, tokenID, dctTokenKey, e.globalSettingsHandler, e.rolesHandler, isReturnCallWithError)
+	if err != nil {
+		return nil, err
+	}
+	err = checkQuantityForTokenType(e.globalSettingsHandler, dctTokenKey, quantityToTransfer)
+	if err != nil {
+		return nil, err
+	}
 	dctData.Value.Sub(dctData.Value, quantityToTransfer)
+	if nonce == 0 {
+		err = checkLockedBalance(dctData, e.lockedBalanceHandler)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	_, err = saveDCTNFTToken(acntSnd, dctTokenKey, dctData, e.marshalizer, e.pauseHandler, isReturnCallWithError)
+	_, err = e.storageHandler.SaveDCTNFTToken(acntSnd, dctTokenKey, nonce, dctData, isReturnCallWithError)
 	if err != nil {
 		return nil, err
 	}
 
 	dctData.Value.Set(quantityToTransfer)
+	if nonce == 0 {
+		// the locked amounts are kept by the sender
+		dctData.Reserved = nil
+	}
 
 	if !check.IfNil(acntDst) {
-		err = e.addNFTToDestination(dstAddress, acntDst, dctData, dctTokenKey, verifyPayable, isReturnCallWithError)
+		err = e.addNFTToDestination(dstAddress, acntDst, dctData, tokenID, dctTokenKey, verifyPayable, isReturnCallWithError)
 		if err != nil {
 			return nil, err
 		}
@@ -329,6 +405,62 @@ func (e *dctNFTMultiTransfer) transferOneTokenOnSenderShard(
 	return dctData, nil
 }
 
+// transferMOAOnSenderShard moves the native value carried by the multi transfer. The returned token data holds only
+// the value, so it is forwarded cross-shard like a fungible token
+func (e *dctNFTMultiTransfer) transferMOAOnSenderShard(
+	acntSnd vmcommon.UserAccountHandler,
+	acntDst vmcommon.UserAccountHandler,
+	dstAddress []byte,
+	nonce uint64,
+	value *big.Int,
+	verifyPayable bool,
+) (*dct.DCToken, error) {
+	if nonce != 0 {
+		return nil, fmt.Errorf("%w, MOA transfer with nonce", ErrInvalidArguments)
+	}
+	if acntSnd.GetBalance().Cmp(value) < 0 {
+		return nil, ErrInsufficientFunds
+	}
+
+	err := acntSnd.AddToBalance(big.NewInt(0).Neg(value))
+	if err != nil {
+		return nil, err
+	}
+
+	if !check.IfNil(acntDst) {
+		err = e.addMOAToDestination(dstAddress, acntDst, nonce, value, verifyPayable)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &dct.DCToken{Type: uint32(vmcommon.Fungible), Value: big.NewInt(0).Set(value)}, nil
+}
+
+func (e *dctNFTMultiTransfer) addMOAToDestination(
+	dstAddress []byte,
+	userAccount vmcommon.UserAccountHandler,
+	nonce uint64,
+	value *big.Int,
+	mustVerifyPayable bool,
+) error {
+	if nonce != 0 {
+		return fmt.Errorf("%w, MOA transfer with nonce", ErrInvalidArguments)
+	}
+
+	if mustVerifyPayable {
+		isPayable, errIsPayable := e.payableHandler.IsPayable(dstAddress)
+		if errIsPayable != nil {
+			return errIsPayable
+		}
+		if !isPayable {
+			return ErrAccountNotPayable
+		}
+	}
+
+	return userAccount.AddToBalance(value)
+}
+
 func (e *dctNFTMultiTransfer) loadAccountIfInShard(dstAddress []byte) (vmcommon.UserAccountHandler, error) {
 	if e.shardCoordinator.SelfId() != e.shardCoordinator.ComputeId(dstAddress) {
 		return nil, nil
@@ -365,6 +497,7 @@ func (e *dctNFTMultiTransfer) createDCTNFTOutputTransfers(
 				return err
 			}
 
+			traceGas(vmInput, gasComponentDataCopyPerByte, uint64(len(marshaledNFTTransfer)), e.gasConfig.DataCopyPerByte)
 			gasForTransfer := uint64(len(marshaledNFTTransfer)) * e.gasConfig.DataCopyPerByte
 			if gasForTransfer > vmOutput.GasRemaining {
 				return ErrNotEnoughGas
@@ -429,10 +562,16 @@ func (e *dctNFTMultiTransfer) addNFTToDestination(
 	dstAddress []byte,
 	userAccount vmcommon.UserAccountHandler,
 	dctDataToTransfer *dct.DCToken,
+	tokenID []byte,
 	dctTokenKey []byte,
 	mustVerifyPayable bool,
 	isReturnCallWithError bool,
 ) error {
+	err := checkLimitedTransfer(userAccount, tokenID, dctTokenKey, e.globalSettingsHandler, e.rolesHandler, isReturnCallWithError)
+	if err != nil {
+		return err
+	}
+
 	if mustVerifyPayable {
 		isPayable, errIsPayable := e.payableHandler.IsPayable(dstAddress)
 		if errIsPayable != nil {
@@ -448,23 +587,24 @@ func (e *dctNFTMultiTransfer) addNFTToDestination(
 		nonce = dctDataToTransfer.TokenMetaData.Nonce
 	}
 
-	currentDCTData, _, err := getDCTNFTTokenOnDestination(userAccount, dctTokenKey, nonce, e.marshalizer)
+	currentDCTData, _, err := e.storageHandler.GetDCTNFTTokenOnDestination(userAccount, dctTokenKey, nonce)
 	if err != nil && !errors.Is(err, ErrNFTTokenDoesNotExist) {
 		return err
 	}
-	err = checkFrozeAndPause(dstAddress, dctTokenKey, currentDCTData, e.pauseHandler, isReturnCallWithError)
+	err = checkFrozeAndPause(dstAddress, dctTokenKey, currentDCTData, e.globalSettingsHandler, isReturnCallWithError)
 	if err != nil {
 		return err
 	}
 
 	if currentDCTData.TokenMetaData != nil {
-		if !bytes.Equal(currentDCTData.TokenMetaData.Hash, dctDataToTransfer.TokenMetaData.Hash) {
-			return ErrWrongNFTOnDestination
+		err = e.storageHandler.CheckDCTNFTTokenOnDestination(currentDCTData, dctDataToTransfer)
+		if err != nil {
+			return err
 		}
 		dctDataToTransfer.Value.Add(dctDataToTransfer.Value, currentDCTData.Value)
 	}
 
-	_, err = saveDCTNFTToken(userAccount, dctTokenKey, dctDataToTransfer, e.marshalizer, e.pauseHandler, isReturnCallWithError)
+	_, err = e.storageHandler.SaveDCTNFTToken(userAccount, dctTokenKey, nonce, dctDataToTransfer, isReturnCallWithError)
 	if err != nil {
 		return err
 	}
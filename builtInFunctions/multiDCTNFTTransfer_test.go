@@ -3,6 +3,7 @@ package builtInFunctions
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
 	"math/big"
 	"testing"
 
@@ -18,10 +19,14 @@ func createDCTNFTMultiTransferWithStubArguments() *dctNFTMultiTransfer {
 	multiTransfer, _ := NewDCTNFTMultiTransferFunc(
 		0,
 		&mock.MarshalizerMock{},
-		&mock.PauseHandlerStub{},
+		&mock.GlobalSettingsHandlerStub{},
+		&mock.DCTRoleHandlerStub{},
 		&mock.AccountsStub{},
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
+		createNewDCTDataStorageHandler(),
+		&mock.LockedBalanceHandlerStub{},
+		0,
 		0,
 		&mock.EpochNotifierStub{},
 	)
@@ -29,7 +34,7 @@ func createDCTNFTMultiTransferWithStubArguments() *dctNFTMultiTransfer {
 	return multiTransfer
 }
 
-func createDCTNFTMultiTransferWithMockArguments(selfShard uint32, numShards uint32, pauseHandler vmcommon.DCTPauseHandler) *dctNFTMultiTransfer {
+func createDCTNFTMultiTransferWithMockArguments(selfShard uint32, numShards uint32, globalSettingsHandler vmcommon.DCTGlobalSettingsHandler) *dctNFTMultiTransfer {
 	marshalizer := &mock.MarshalizerMock{}
 	shardCoordinator := mock.NewMultiShardsCoordinatorMock(numShards)
 	shardCoordinator.CurrentShard = selfShard
@@ -58,10 +63,14 @@ func createDCTNFTMultiTransferWithMockArguments(selfShard uint32, numShards uint
 	multiTransfer, _ := NewDCTNFTMultiTransferFunc(
 		1,
 		marshalizer,
-		pauseHandler,
+		globalSettingsHandler,
+		&mock.DCTRoleHandlerStub{},
 		accounts,
 		shardCoordinator,
 		vmcommon.BaseOperationCost{},
+		createNewDCTDataStorageHandlerWithArgs(globalSettingsHandler, createAccountsWithSystemAccount(), 1),
+		&mock.LockedBalanceHandlerStub{},
+		0,
 		0,
 		&mock.EpochNotifierStub{},
 	)
@@ -75,10 +84,14 @@ func TestNewDCTNFTMultiTransferFunc_NilArgumentsShouldErr(t *testing.T) {
 	multiTransfer, err := NewDCTNFTMultiTransferFunc(
 		0,
 		nil,
-		&mock.PauseHandlerStub{},
+		&mock.GlobalSettingsHandlerStub{},
+		&mock.DCTRoleHandlerStub{},
 		&mock.AccountsStub{},
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
+		createNewDCTDataStorageHandler(),
+		&mock.LockedBalanceHandlerStub{},
+		0,
 		0,
 		&mock.EpochNotifierStub{},
 	)
@@ -89,22 +102,47 @@ func TestNewDCTNFTMultiTransferFunc_NilArgumentsShouldErr(t *testing.T) {
 		0,
 		&mock.MarshalizerMock{},
 		nil,
+		&mock.DCTRoleHandlerStub{},
+		&mock.AccountsStub{},
+		&mock.ShardCoordinatorStub{},
+		vmcommon.BaseOperationCost{},
+		createNewDCTDataStorageHandler(),
+		&mock.LockedBalanceHandlerStub{},
+		0,
+		0,
+		&mock.EpochNotifierStub{},
+	)
+	assert.True(t, check.IfNil(multiTransfer))
+	assert.Equal(t, ErrNilGlobalSettingsHandler, err)
+
+	multiTransfer, err = NewDCTNFTMultiTransferFunc(
+		0,
+		&mock.MarshalizerMock{},
+		&mock.GlobalSettingsHandlerStub{},
+		nil,
 		&mock.AccountsStub{},
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
+		createNewDCTDataStorageHandler(),
+		&mock.LockedBalanceHandlerStub{},
+		0,
 		0,
 		&mock.EpochNotifierStub{},
 	)
 	assert.True(t, check.IfNil(multiTransfer))
-	assert.Equal(t, ErrNilPauseHandler, err)
+	assert.Equal(t, ErrNilRolesHandler, err)
 
 	multiTransfer, err = NewDCTNFTMultiTransferFunc(
 		0,
 		&mock.MarshalizerMock{},
-		&mock.PauseHandlerStub{},
+		&mock.GlobalSettingsHandlerStub{},
+		&mock.DCTRoleHandlerStub{},
 		nil,
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
+		createNewDCTDataStorageHandler(),
+		&mock.LockedBalanceHandlerStub{},
+		0,
 		0,
 		&mock.EpochNotifierStub{},
 	)
@@ -114,10 +152,14 @@ func TestNewDCTNFTMultiTransferFunc_NilArgumentsShouldErr(t *testing.T) {
 	multiTransfer, err = NewDCTNFTMultiTransferFunc(
 		0,
 		&mock.MarshalizerMock{},
-		&mock.PauseHandlerStub{},
+		&mock.GlobalSettingsHandlerStub{},
+		&mock.DCTRoleHandlerStub{},
 		&mock.AccountsStub{},
 		nil,
 		vmcommon.BaseOperationCost{},
+		createNewDCTDataStorageHandler(),
+		&mock.LockedBalanceHandlerStub{},
+		0,
 		0,
 		&mock.EpochNotifierStub{},
 	)
@@ -127,10 +169,14 @@ func TestNewDCTNFTMultiTransferFunc_NilArgumentsShouldErr(t *testing.T) {
 	multiTransfer, err = NewDCTNFTMultiTransferFunc(
 		0,
 		&mock.MarshalizerMock{},
-		&mock.PauseHandlerStub{},
+		&mock.GlobalSettingsHandlerStub{},
+		&mock.DCTRoleHandlerStub{},
 		&mock.AccountsStub{},
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
+		createNewDCTDataStorageHandler(),
+		&mock.LockedBalanceHandlerStub{},
+		0,
 		0,
 		nil,
 	)
@@ -144,10 +190,14 @@ func TestNewDCTNFTMultiTransferFunc(t *testing.T) {
 	multiTransfer, err := NewDCTNFTMultiTransferFunc(
 		0,
 		&mock.MarshalizerMock{},
-		&mock.PauseHandlerStub{},
+		&mock.GlobalSettingsHandlerStub{},
+		&mock.DCTRoleHandlerStub{},
 		&mock.AccountsStub{},
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
+		createNewDCTDataStorageHandler(),
+		&mock.LockedBalanceHandlerStub{},
+		0,
 		0,
 		&mock.EpochNotifierStub{},
 	)
@@ -223,7 +273,7 @@ func TestDCTNFTMultiTransfer_ProcessBuiltinFunctionInvalidArgumentsShouldErr(t *
 func TestDCTNFTMultiTransfer_ProcessBuiltinFunctionOnSameShardWithScCall(t *testing.T) {
 	t.Parallel()
 
-	multiTransfer := createDCTNFTMultiTransferWithMockArguments(0, 1, &mock.PauseHandlerStub{})
+	multiTransfer := createDCTNFTMultiTransferWithMockArguments(0, 1, &mock.GlobalSettingsHandlerStub{})
 	_ = multiTransfer.SetPayableHandler(
 		&mock.PayableHandlerStub{
 			IsPayableCalled: func(address []byte) (bool, error) {
@@ -303,10 +353,10 @@ func TestDCTNFTMultiTransfer_ProcessBuiltinFunctionOnCrossShardsDestinationDoesN
 		},
 	}
 
-	multiTransferSenderShard := createDCTNFTMultiTransferWithMockArguments(1, 2, &mock.PauseHandlerStub{})
+	multiTransferSenderShard := createDCTNFTMultiTransferWithMockArguments(1, 2, &mock.GlobalSettingsHandlerStub{})
 	_ = multiTransferSenderShard.SetPayableHandler(payableHandler)
 
-	multiTransferDestinationShard := createDCTNFTMultiTransferWithMockArguments(0, 2, &mock.PauseHandlerStub{})
+	multiTransferDestinationShard := createDCTNFTMultiTransferWithMockArguments(0, 2, &mock.GlobalSettingsHandlerStub{})
 	_ = multiTransferDestinationShard.SetPayableHandler(payableHandler)
 
 	senderAddress := bytes.Repeat([]byte{1}, 32)
@@ -398,10 +448,10 @@ func TestDCTNFTMultiTransfer_ProcessBuiltinFunctionOnCrossShardsDestinationHolds
 		},
 	}
 
-	multiTransferSenderShard := createDCTNFTMultiTransferWithMockArguments(0, 2, &mock.PauseHandlerStub{})
+	multiTransferSenderShard := createDCTNFTMultiTransferWithMockArguments(0, 2, &mock.GlobalSettingsHandlerStub{})
 	_ = multiTransferSenderShard.SetPayableHandler(payableHandler)
 
-	multiTransferDestinationShard := createDCTNFTMultiTransferWithMockArguments(1, 2, &mock.PauseHandlerStub{})
+	multiTransferDestinationShard := createDCTNFTMultiTransferWithMockArguments(1, 2, &mock.GlobalSettingsHandlerStub{})
 	_ = multiTransferDestinationShard.SetPayableHandler(payableHandler)
 
 	senderAddress := bytes.Repeat([]byte{2}, 32) // sender is in the same shard
@@ -486,7 +536,7 @@ func TestDCTNFTMultiTransfer_ProcessBuiltinFunctionOnCrossShardsDestinationHolds
 func TestDCTNFTMultiTransfer_SndDstFrozen(t *testing.T) {
 	t.Parallel()
 
-	transferFunc := createDCTNFTMultiTransferWithMockArguments(0, 1, &mock.PauseHandlerStub{})
+	transferFunc := createDCTNFTMultiTransferWithMockArguments(0, 1, &mock.GlobalSettingsHandlerStub{})
 	_ = transferFunc.SetPayableHandler(&mock.PayableHandlerStub{})
 
 	senderAddress := bytes.Repeat([]byte{2}, 32) // sender is in the same shard
@@ -542,7 +592,7 @@ func TestDCTNFTMultiTransfer_SndDstFrozen(t *testing.T) {
 func TestDCTNFTMultiTransfer_NotEnoughGas(t *testing.T) {
 	t.Parallel()
 
-	transferFunc := createDCTNFTMultiTransferWithMockArguments(0, 1, &mock.PauseHandlerStub{})
+	transferFunc := createDCTNFTMultiTransferWithMockArguments(0, 1, &mock.GlobalSettingsHandlerStub{})
 	_ = transferFunc.SetPayableHandler(&mock.PayableHandlerStub{})
 
 	senderAddress := bytes.Repeat([]byte{2}, 32) // sender is in the same shard
@@ -578,3 +628,141 @@ func TestDCTNFTMultiTransfer_NotEnoughGas(t *testing.T) {
 	_, err = transferFunc.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), sender.(vmcommon.UserAccountHandler), vmInput)
 	assert.Equal(t, err, ErrNotEnoughGas)
 }
+
+func TestDCTNFTMultiTransfer_ProcessBuiltinFunctionWithMOAOnSameShard(t *testing.T) {
+	t.Parallel()
+
+	multiTransfer := createDCTNFTMultiTransferWithMockArguments(0, 1, &mock.GlobalSettingsHandlerStub{})
+	_ = multiTransfer.SetPayableHandler(&mock.PayableHandlerStub{})
+	senderAddress := bytes.Repeat([]byte{0}, 32)
+	destinationAddress := bytes.Repeat([]byte{0}, 32)
+	destinationAddress[25] = 1
+	sender, _ := multiTransfer.accounts.LoadAccount(senderAddress)
+	_ = sender.(vmcommon.UserAccountHandler).AddToBalance(big.NewInt(100))
+
+	token := []byte("token")
+	createDCTNFTToken(token, vmcommon.Fungible, 0, big.NewInt(3), multiTransfer.marshalizer, sender.(vmcommon.UserAccountHandler))
+
+	moa := []byte(vmcommon.MOATokenIdentifier)
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			CallerAddr:  senderAddress,
+			Arguments:   [][]byte{destinationAddress, big.NewInt(2).Bytes(), moa, big.NewInt(0).Bytes(), big.NewInt(40).Bytes(), token, big.NewInt(0).Bytes(), big.NewInt(1).Bytes()},
+			GasProvided: 100000,
+		},
+		RecipientAddr: senderAddress,
+	}
+
+	vmOutput, err := multiTransfer.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), nil, vmInput)
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	require.Equal(t, 2, len(vmOutput.Logs))
+	assert.Equal(t, moa, vmOutput.Logs[0].Topics[0])
+
+	destination, _ := multiTransfer.accounts.LoadAccount(destinationAddress)
+	assert.Equal(t, big.NewInt(60), sender.(vmcommon.UserAccountHandler).GetBalance())
+	assert.Equal(t, big.NewInt(40), destination.(vmcommon.UserAccountHandler).GetBalance())
+	testNFTTokenShouldExist(t, multiTransfer.marshalizer, sender, token, 0, big.NewInt(2))
+	testNFTTokenShouldExist(t, multiTransfer.marshalizer, destination, token, 0, big.NewInt(1))
+
+	vmInput.Arguments[4] = big.NewInt(61).Bytes()
+	_, err = multiTransfer.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), nil, vmInput)
+	assert.Equal(t, ErrInsufficientFunds, err)
+
+	vmInput.Arguments[3] = big.NewInt(1).Bytes()
+	vmInput.Arguments[4] = big.NewInt(1).Bytes()
+	_, err = multiTransfer.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), nil, vmInput)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+}
+
+func TestDCTNFTMultiTransfer_ProcessBuiltinFunctionWithMOABeforeEnableEpoch(t *testing.T) {
+	t.Parallel()
+
+	multiTransfer := createDCTNFTMultiTransferWithMockArguments(0, 1, &mock.GlobalSettingsHandlerStub{})
+	_ = multiTransfer.SetPayableHandler(&mock.PayableHandlerStub{})
+	multiTransfer.moaTransferEnableEpoch = 1
+	multiTransfer.EpochConfirmed(0, 0)
+	assert.True(t, multiTransfer.IsActive())
+
+	senderAddress := bytes.Repeat([]byte{0}, 32)
+	destinationAddress := bytes.Repeat([]byte{0}, 32)
+	destinationAddress[25] = 1
+	sender, _ := multiTransfer.accounts.LoadAccount(senderAddress)
+	_ = sender.(vmcommon.UserAccountHandler).AddToBalance(big.NewInt(100))
+
+	moa := []byte(vmcommon.MOATokenIdentifier)
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			CallerAddr:  senderAddress,
+			Arguments:   [][]byte{destinationAddress, big.NewInt(1).Bytes(), moa, big.NewInt(0).Bytes(), big.NewInt(40).Bytes()},
+			GasProvided: 100000,
+		},
+		RecipientAddr: senderAddress,
+	}
+
+	// the reserved identifier is handled as a token the sender does not hold
+	_, err := multiTransfer.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), nil, vmInput)
+	assert.NotNil(t, err)
+	assert.Equal(t, big.NewInt(100), sender.(vmcommon.UserAccountHandler).GetBalance())
+
+	multiTransfer.EpochConfirmed(1, 0)
+	_, err = multiTransfer.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), nil, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(60), sender.(vmcommon.UserAccountHandler).GetBalance())
+}
+
+func TestDCTNFTMultiTransfer_ProcessBuiltinFunctionWithMOAOnCrossShard(t *testing.T) {
+	t.Parallel()
+
+	multiTransferSenderShard := createDCTNFTMultiTransferWithMockArguments(0, 2, &mock.GlobalSettingsHandlerStub{})
+	_ = multiTransferSenderShard.SetPayableHandler(&mock.PayableHandlerStub{})
+	multiTransferDestinationShard := createDCTNFTMultiTransferWithMockArguments(1, 2, &mock.GlobalSettingsHandlerStub{})
+	_ = multiTransferDestinationShard.SetPayableHandler(&mock.PayableHandlerStub{})
+
+	senderAddress := bytes.Repeat([]byte{0}, 32)
+	destinationAddress := bytes.Repeat([]byte{1}, 32)
+	sender, _ := multiTransferSenderShard.accounts.LoadAccount(senderAddress)
+	_ = sender.(vmcommon.UserAccountHandler).AddToBalance(big.NewInt(100))
+
+	token := []byte("token")
+	tokenNonce := uint64(1)
+	createDCTNFTToken(token, vmcommon.NonFungible, tokenNonce, big.NewInt(1), multiTransferSenderShard.marshalizer, sender.(vmcommon.UserAccountHandler))
+
+	moa := []byte(vmcommon.MOATokenIdentifier)
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			CallerAddr:  senderAddress,
+			Arguments:   [][]byte{destinationAddress, big.NewInt(2).Bytes(), token, big.NewInt(int64(tokenNonce)).Bytes(), big.NewInt(1).Bytes(), moa, big.NewInt(0).Bytes(), big.NewInt(25).Bytes()},
+			GasProvided: 100000,
+		},
+		RecipientAddr: senderAddress,
+	}
+
+	vmOutput, err := multiTransferSenderShard.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), nil, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(75), sender.(vmcommon.UserAccountHandler).GetBalance())
+
+	_, args := extractScResultsFromVmOutput(t, vmOutput)
+	require.Equal(t, 7, len(args))
+	assert.Equal(t, moa, args[4])
+	assert.Equal(t, big.NewInt(25).Bytes(), args[6])
+
+	destination, _ := multiTransferDestinationShard.accounts.LoadAccount(destinationAddress)
+	vmInput = &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: senderAddress,
+			Arguments:  args,
+		},
+		RecipientAddr: destinationAddress,
+	}
+
+	vmOutput, err = multiTransferDestinationShard.ProcessBuiltinFunction(nil, destination.(vmcommon.UserAccountHandler), vmInput)
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	assert.Equal(t, big.NewInt(25), destination.(vmcommon.UserAccountHandler).GetBalance())
+	testNFTTokenShouldExist(t, multiTransferDestinationShard.marshalizer, destination, token, tokenNonce, big.NewInt(1))
+}
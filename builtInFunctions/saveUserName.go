@@ -64,6 +64,7 @@ func (s *saveUserName) ProcessBuiltinFunction(
 	if vmInput.CallValue.Cmp(zero) != 0 {
 		return nil, ErrBuiltInFunctionCalledWithValue
 	}
+	traceGas(vmInput, gasComponentFunction, 1, s.gasCost)
 	if vmInput.GasProvided < s.gasCost {
 		return nil, ErrNotEnoughGas
 	}
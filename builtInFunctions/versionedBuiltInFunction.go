@@ -0,0 +1,133 @@
+package builtInFunctions
+
+import (
+	"sync"
+	"sync/atomic"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+)
+
+type builtInFunctionVersion struct {
+	function          vmcommon.BuiltinFunction
+	activationEpoch   uint32
+	deactivationEpoch uint32
+}
+
+func (v *builtInFunctionVersion) isValidInEpoch(epoch uint32) bool {
+	if epoch < v.activationEpoch {
+		return false
+	}
+
+	return v.deactivationEpoch == 0 || epoch < v.deactivationEpoch
+}
+
+func (v *builtInFunctionVersion) overlaps(other *builtInFunctionVersion) bool {
+	startsBeforeOtherEnds := other.deactivationEpoch == 0 || v.activationEpoch < other.deactivationEpoch
+	otherStartsBeforeEnd := v.deactivationEpoch == 0 || other.activationEpoch < v.deactivationEpoch
+
+	return startsBeforeOtherEnds && otherStartsBeforeEnd
+}
+
+// versionedBuiltInFunction holds several implementations of the same built in function, each one valid for a range
+// of epochs, and follows the current epoch so that the valid implementation can be selected
+type versionedBuiltInFunction struct {
+	mutVersions  sync.RWMutex
+	versions     []*builtInFunctionVersion
+	currentEpoch uint32
+}
+
+func newVersionedBuiltInFunction(epochNotifier vmcommon.EpochNotifier) *versionedBuiltInFunction {
+	v := &versionedBuiltInFunction{
+		versions: make([]*builtInFunctionVersion, 0),
+	}
+	epochNotifier.RegisterNotifyHandler(v)
+
+	return v
+}
+
+func (v *versionedBuiltInFunction) addVersion(function vmcommon.BuiltinFunction, activationEpoch uint32, deactivationEpoch uint32) error {
+	if deactivationEpoch > 0 && deactivationEpoch <= activationEpoch {
+		return ErrInvalidEnableEpochs
+	}
+
+	newVersion := &builtInFunctionVersion{
+		function:          function,
+		activationEpoch:   activationEpoch,
+		deactivationEpoch: deactivationEpoch,
+	}
+
+	v.mutVersions.Lock()
+	defer v.mutVersions.Unlock()
+
+	for _, version := range v.versions {
+		if version.overlaps(newVersion) {
+			return ErrOverlappingVersions
+		}
+	}
+	v.versions = append(v.versions, newVersion)
+
+	return nil
+}
+
+// currentVersion returns the version valid in the current epoch or, if there is none, a disabled built in function
+// so that the callers see an inactive function instead of a missing one
+func (v *versionedBuiltInFunction) currentVersion(key string) vmcommon.BuiltinFunction {
+	epoch := atomic.LoadUint32(&v.currentEpoch)
+
+	v.mutVersions.RLock()
+	defer v.mutVersions.RUnlock()
+
+	for _, version := range v.versions {
+		if version.isValidInEpoch(epoch) {
+			return version.function
+		}
+	}
+
+	return &disabledBuiltInFunction{function: key}
+}
+
+func (v *versionedBuiltInFunction) allVersions() []vmcommon.BuiltinFunction {
+	v.mutVersions.RLock()
+	defer v.mutVersions.RUnlock()
+
+	functions := make([]vmcommon.BuiltinFunction, 0, len(v.versions))
+	for _, version := range v.versions {
+		functions = append(functions, version.function)
+	}
+
+	return functions
+}
+
+func (v *versionedBuiltInFunction) wrapVersions(wrap func(function vmcommon.BuiltinFunction) vmcommon.BuiltinFunction) {
+	v.mutVersions.Lock()
+	for _, version := range v.versions {
+		version.function = wrap(version.function)
+	}
+	v.mutVersions.Unlock()
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (v *versionedBuiltInFunction) EpochConfirmed(epoch uint32, _ uint64) {
+	atomic.StoreUint32(&v.currentEpoch, epoch)
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (v *versionedBuiltInFunction) IsInterfaceNil() bool {
+	return v == nil
+}
+
+// getAllBuiltInFunctionVersions returns all the versions of the function stored at a certain key, if the container
+// supports versioning, or the single function stored at that key otherwise
+func getAllBuiltInFunctionVersions(container vmcommon.BuiltInFunctionContainer, key string) ([]vmcommon.BuiltinFunction, error) {
+	versionedContainer, ok := container.(vmcommon.VersionedBuiltInFunctionContainer)
+	if ok {
+		return versionedContainer.GetAllVersions(key)
+	}
+
+	builtInFunc, err := container.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return []vmcommon.BuiltinFunction{builtInFunc}, nil
+}
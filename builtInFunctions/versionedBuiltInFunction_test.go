@@ -0,0 +1,241 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"testing"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createEpochNotifierWithHandlers() (*mock.EpochNotifierStub, func(epoch uint32)) {
+	handlers := make([]vmcommon.EpochSubscriberHandler, 0)
+	epochNotifier := &mock.EpochNotifierStub{
+		RegisterNotifyHandlerCalled: func(handler vmcommon.EpochSubscriberHandler) {
+			handlers = append(handlers, handler)
+			handler.EpochConfirmed(0, 0)
+		},
+	}
+	confirmEpoch := func(epoch uint32) {
+		for _, handler := range handlers {
+			handler.EpochConfirmed(epoch, 0)
+		}
+	}
+
+	return epochNotifier, confirmEpoch
+}
+
+func TestNewVersionedBuiltInFunctionContainer_NilEpochNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewVersionedBuiltInFunctionContainer(nil)
+
+	assert.Nil(t, c)
+	assert.Equal(t, ErrNilEpochHandler, err)
+}
+
+func TestVersionedBuiltInFunctionContainer_AddVersionErrors(t *testing.T) {
+	t.Parallel()
+
+	c, _ := NewVersionedBuiltInFunctionContainer(&mock.EpochNotifierStub{})
+
+	err := c.AddVersion("key", nil, 0, 0)
+	assert.Equal(t, ErrNilContainerElement, err)
+
+	err = c.AddVersion("", &mock.BuiltInFunctionStub{}, 0, 0)
+	assert.Equal(t, ErrEmptyFunctionName, err)
+
+	err = c.AddVersion("key", &mock.BuiltInFunctionStub{}, 5, 5)
+	assert.Equal(t, ErrInvalidEnableEpochs, err)
+
+	_ = c.Add("plain", &mock.BuiltInFunctionStub{})
+	err = c.AddVersion("plain", &mock.BuiltInFunctionStub{}, 0, 0)
+	assert.Equal(t, ErrContainerKeyAlreadyExists, err)
+
+	err = c.AddVersion("key", &mock.BuiltInFunctionStub{}, 0, 10)
+	require.Nil(t, err)
+	err = c.AddVersion("key", &mock.BuiltInFunctionStub{}, 9, 0)
+	assert.Equal(t, ErrOverlappingVersions, err)
+	err = c.AddVersion("key", &mock.BuiltInFunctionStub{}, 10, 0)
+	assert.Nil(t, err)
+	err = c.AddVersion("key", &mock.BuiltInFunctionStub{}, 20, 30)
+	assert.Equal(t, ErrOverlappingVersions, err)
+
+	err = c.Add("key", &mock.BuiltInFunctionStub{})
+	assert.Equal(t, ErrContainerKeyAlreadyExists, err)
+
+	notVersioned := NewBuiltInFunctionContainer()
+	err = notVersioned.AddVersion("key", &mock.BuiltInFunctionStub{}, 0, 0)
+	assert.Equal(t, ErrNilEpochHandler, err)
+}
+
+func TestVersionedBuiltInFunctionContainer_GetShouldSelectVersionByEpoch(t *testing.T) {
+	t.Parallel()
+
+	epochNotifier, confirmEpoch := createEpochNotifierWithHandlers()
+	c, _ := NewVersionedBuiltInFunctionContainer(epochNotifier)
+
+	v1 := &mock.BuiltInFunctionStub{}
+	v2 := &mock.BuiltInFunctionStub{}
+	require.Nil(t, c.AddVersion("key", v2, 10, 0))
+	require.Nil(t, c.AddVersion("key", v1, 2, 10))
+
+	function, err := c.Get("key")
+	require.Nil(t, err)
+	assert.False(t, function.IsActive())
+	_, err = function.ProcessBuiltinFunction(nil, nil, &vmcommon.ContractCallInput{})
+	assert.True(t, errors.Is(err, ErrNoVersionForEpoch))
+
+	confirmEpoch(2)
+	function, err = c.Get("key")
+	assert.Nil(t, err)
+	assert.True(t, function == v1)
+
+	confirmEpoch(9)
+	function, _ = c.Get("key")
+	assert.True(t, function == v1)
+
+	confirmEpoch(10)
+	function, _ = c.Get("key")
+	assert.True(t, function == v2)
+
+	confirmEpoch(3)
+	function, _ = c.Get("key")
+	assert.True(t, function == v1)
+
+	versions, err := c.GetAllVersions("key")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(versions))
+	assert.Equal(t, 1, c.Len())
+}
+
+func TestSetPayableHandler_VersionedTransferFunctionWithoutVersionForEpochShouldWork(t *testing.T) {
+	t.Parallel()
+
+	epochNotifier, confirmEpoch := createEpochNotifierWithHandlers()
+	c, _ := NewVersionedBuiltInFunctionContainer(epochNotifier)
+	_ = c.Add(vmcommon.BuiltInFunctionMultiDCTNFTTransfer, &payableBuiltInFunctionStub{})
+	_ = c.Add(vmcommon.BuiltInFunctionDCTNFTTransfer, &payableBuiltInFunctionStub{})
+	_ = c.Add(vmcommon.BuiltInFunctionDCTTransferFrom, &payableBuiltInFunctionStub{})
+	_ = c.Add(vmcommon.BuiltInFunctionDCTLockedTransfer, &payableBuiltInFunctionStub{})
+	_ = c.Add(vmcommon.BuiltInFunctionDCTMultiDistribute, &payableBuiltInFunctionStub{})
+	transfer := &payableBuiltInFunctionStub{}
+	require.Nil(t, c.AddVersion(vmcommon.BuiltInFunctionDCTTransfer, transfer, 5, 0))
+
+	function, err := c.Get(vmcommon.BuiltInFunctionDCTTransfer)
+	require.Nil(t, err)
+	assert.False(t, function.IsActive())
+
+	payableHandler := &mock.PayableHandlerStub{}
+	err = SetPayableHandler(c, payableHandler)
+	assert.Nil(t, err)
+	assert.True(t, transfer.payableHandler == payableHandler)
+
+	confirmEpoch(5)
+	function, _ = c.Get(vmcommon.BuiltInFunctionDCTTransfer)
+	assert.True(t, function == transfer)
+}
+
+func TestVersionedBuiltInFunctionContainer_GetAllVersionsOfPlainFunction(t *testing.T) {
+	t.Parallel()
+
+	c, _ := NewVersionedBuiltInFunctionContainer(&mock.EpochNotifierStub{})
+	function := &mock.BuiltInFunctionStub{}
+	_ = c.Add("key", function)
+
+	versions, err := c.GetAllVersions("key")
+	assert.Nil(t, err)
+	require.Equal(t, 1, len(versions))
+	assert.True(t, versions[0] == function)
+
+	_, err = c.GetAllVersions("missing")
+	assert.True(t, errors.Is(err, ErrInvalidContainerKey))
+}
+
+func TestBuiltInFuncFactory_VersionedCustomBuiltInFunctions(t *testing.T) {
+	t.Parallel()
+
+	epochNotifier, confirmEpoch := createEpochNotifierWithHandlers()
+	numGasConfigUpdates := 0
+	newVersion := func() *payableBuiltInFunctionStub {
+		return &payableBuiltInFunctionStub{
+			BuiltInFunctionStub: mock.BuiltInFunctionStub{
+				SetNewGasConfigCalled: func(_ *vmcommon.GasCost) {
+					numGasConfigUpdates++
+				},
+			},
+		}
+	}
+	v1 := newVersion()
+	v2 := newVersion()
+	customBuiltInFunctions := []CustomBuiltInFunction{
+		{
+			Name: "Custom",
+			Constructor: func(_ BuiltInFunctionDependencies) (vmcommon.BuiltinFunction, error) {
+				return v1, nil
+			},
+			DeactivationEpoch: 5,
+		},
+		{
+			Name: "Custom",
+			Constructor: func(_ BuiltInFunctionDependencies) (vmcommon.BuiltinFunction, error) {
+				return v2, nil
+			},
+			ActivationEpoch: 5,
+		},
+	}
+	args := createFactoryArgs(customBuiltInFunctions, epochNotifier)
+	args.BuiltInFunctionsEnableEpochs = map[string]BuiltInFunctionEnableEpochs{
+		"Custom": {DeactivationEpoch: 7},
+	}
+
+	factory, err := NewBuiltInFunctionsFactory(args)
+	require.Nil(t, err)
+	container, err := factory.CreateBuiltInFunctionContainer()
+	require.Nil(t, err)
+
+	function, err := container.Get("Custom")
+	require.Nil(t, err)
+	assert.True(t, function.(*interceptedBuiltInFunction).BuiltinFunction.(*epochGatedBuiltInFunction).BuiltinFunction == v1)
+	assert.True(t, function.IsActive())
+
+	confirmEpoch(6)
+	function, err = container.Get("Custom")
+	require.Nil(t, err)
+	assert.True(t, function.(*interceptedBuiltInFunction).BuiltinFunction.(*epochGatedBuiltInFunction).BuiltinFunction == v2)
+	assert.True(t, function.IsActive())
+
+	confirmEpoch(7)
+	function, _ = container.Get("Custom")
+	assert.False(t, function.IsActive())
+
+	err = factory.SetGasSchedule(createGasScheduleMap(2))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, numGasConfigUpdates)
+
+	payableHandler := &mock.PayableHandlerStub{}
+	err = SetPayableHandler(container, payableHandler)
+	assert.Nil(t, err)
+	assert.True(t, v1.payableHandler == payableHandler)
+	assert.True(t, v2.payableHandler == payableHandler)
+}
+
+func TestBuiltInFuncFactory_OverlappingCustomBuiltInFunctionVersionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	constructor := func(_ BuiltInFunctionDependencies) (vmcommon.BuiltinFunction, error) {
+		return &mock.BuiltInFunctionStub{}, nil
+	}
+	customBuiltInFunctions := []CustomBuiltInFunction{
+		{Name: "Custom", Constructor: constructor, DeactivationEpoch: 5},
+		{Name: "Custom", Constructor: constructor, ActivationEpoch: 4},
+	}
+
+	factory, _ := NewBuiltInFunctionsFactory(createFactoryArgs(customBuiltInFunctions, &mock.EpochNotifierStub{}))
+	container, err := factory.CreateBuiltInFunctionContainer()
+
+	assert.Nil(t, container)
+	assert.True(t, errors.Is(err, ErrOverlappingVersions))
+}
@@ -15,9 +15,25 @@ const DCTKeyIdentifier = "dct"
 // DCTRoleIdentifier is the key prefix for dct role identifier
 const DCTRoleIdentifier = "role"
 
+// DCTDecimalsIdentifier is the key prefix for dct number of decimals identifier
+const DCTDecimalsIdentifier = "decimals"
+
 // DCTNFTLatestNonceIdentifier is the key prefix for dct latest nonce identifier
 const DCTNFTLatestNonceIdentifier = "nonce"
 
+// DCTSupplyIdentifier is the key prefix for dct supply identifier
+const DCTSupplyIdentifier = "supply"
+
+// DCTMaxSupplyIdentifier is the key prefix for dct max supply identifier
+const DCTMaxSupplyIdentifier = "maxsupply"
+
+// DCTMintAllowanceIdentifier is the key prefix for dct mint allowance identifier, the part of the max supply which
+// the current shard is still allowed to mint
+const DCTMintAllowanceIdentifier = "mintallowance"
+
+// DCTAllowanceIdentifier is the key prefix for dct allowance identifier
+const DCTAllowanceIdentifier = "allowance"
+
 // BuiltInFunctionSetUserName is the key for the set user name built-in function
 const BuiltInFunctionSetUserName = "SetUserName"
 
@@ -33,12 +49,37 @@ const DCTRoleLocalBurn = "DCTRoleLocalBurn"
 // BuiltInFunctionDCTTransfer is the key for the Dharitri Core Token (DCT) transfer built-in function
 const BuiltInFunctionDCTTransfer = "DCTTransfer"
 
+// BuiltInFunctionDCTMintTransfer is the key for the Dharitri Core Token (DCT) mint transfer built-in function, through
+// which the DCT system smart contract credits newly issued or minted tokens
+const BuiltInFunctionDCTMintTransfer = "DCTMintTransfer"
+
+// BuiltInFunctionDCTApprove is the key for the Dharitri Core Token (DCT) approve built-in function
+const BuiltInFunctionDCTApprove = "DCTApprove"
+
+// BuiltInFunctionDCTTransferFrom is the key for the Dharitri Core Token (DCT) transfer from built-in function
+const BuiltInFunctionDCTTransferFrom = "DCTTransferFrom"
+
+// BuiltInFunctionDCTLockedTransfer is the key for the Dharitri Core Token (DCT) locked transfer built-in function
+const BuiltInFunctionDCTLockedTransfer = "DCTLockedTransfer"
+
+// BuiltInFunctionDCTNFTCreateBatch is the key for the Dharitri Core Token (DCT) NFT create batch built-in function
+const BuiltInFunctionDCTNFTCreateBatch = "DCTNFTCreateBatch"
+
+// BuiltInFunctionDCTMultiDistribute is the key for the Dharitri Core Token (DCT) multi distribute built-in function
+const BuiltInFunctionDCTMultiDistribute = "DCTMultiDistribute"
+
+// BuiltInFunctionDCTClaimUnlocked is the key for the Dharitri Core Token (DCT) claim unlocked built-in function
+const BuiltInFunctionDCTClaimUnlocked = "DCTClaimUnlocked"
+
 // BuiltInFunctionDCTNFTTransfer is the key for the Dharitri Core Token (DCT) NFT transfer built-in function
 const BuiltInFunctionDCTNFTTransfer = "DCTNFTTransfer"
 
 // MinLenArgumentsDCTTransfer defines the min length of arguments for the DCT transfer
 const MinLenArgumentsDCTTransfer = 2
 
+// MinLenArgumentsDCTTransferFrom defines the minimum length of arguments for the DCT transfer from
+const MinLenArgumentsDCTTransferFrom = 4
+
 // MinLenArgumentsDCTNFTTransfer defines the minimum length for dct nft transfer
 const MinLenArgumentsDCTNFTTransfer = 4
 
@@ -60,9 +101,21 @@ const DCTRoleNFTBurn = "DCTRoleNFTBurn"
 // DCTRoleNFTAddURI is the constant string for the local role of adding a URI for DCT NFT tokens
 const DCTRoleNFTAddURI = "DCTRoleNFTAddURI"
 
+// DCTRoleTransfer is the constant string for the role of sending and receiving DCT tokens with limited transfer
+const DCTRoleTransfer = "DCTRoleTransfer"
+
 // DCTRoleNFTUpdateAttributes is the constant string for the local role of updating attributes for DCT NFT tokens
 const DCTRoleNFTUpdateAttributes = "DCTRoleNFTUpdateAttributes"
 
+// DCTRoleModifyRoyalties is the constant string for the local role of modifying the royalties of DCT NFT tokens
+const DCTRoleModifyRoyalties = "DCTRoleModifyRoyalties"
+
+// DCTRoleSetNewURI is the constant string for the local role of replacing the URIs of DCT NFT tokens
+const DCTRoleSetNewURI = "DCTRoleSetNewURI"
+
+// DCTRoleNFTRecreate is the constant string for the local role of recreating the metadata of DCT NFT tokens
+const DCTRoleNFTRecreate = "DCTRoleNFTRecreate"
+
 // BuiltInFunctionDCTNFTCreate is the key for the Dharitri Core Token (DCT) NFT create built-in function
 const BuiltInFunctionDCTNFTCreate = "DCTNFTCreate"
 
@@ -75,6 +128,18 @@ const BuiltInFunctionDCTNFTAddURI = "DCTNFTAddURI"
 // BuiltInFunctionDCTNFTUpdateAttributes is the key for the Dharitri Core Token (DCT) NFT update attributes built-in function
 const BuiltInFunctionDCTNFTUpdateAttributes = "DCTNFTUpdateAttributes"
 
+// BuiltInFunctionDCTModifyRoyalties is the key for the Dharitri Core Token (DCT) NFT modify royalties built-in function
+const BuiltInFunctionDCTModifyRoyalties = "DCTModifyRoyalties"
+
+// BuiltInFunctionDCTSetNewURIs is the key for the Dharitri Core Token (DCT) NFT set new URIs built-in function
+const BuiltInFunctionDCTSetNewURIs = "DCTSetNewURIs"
+
+// BuiltInFunctionDCTNFTRecreate is the key for the Dharitri Core Token (DCT) NFT recreate built-in function
+const BuiltInFunctionDCTNFTRecreate = "DCTNFTRecreate"
+
+// BuiltInFunctionDCTNFTSettleSale is the key for the Dharitri Core Token (DCT) NFT sale settlement built-in function
+const BuiltInFunctionDCTNFTSettleSale = "DCTNFTSettleSale"
+
 // BuiltInFunctionMultiDCTNFTTransfer is the key for the Dharitri Core Token (DCT) multi transfer built-in function
 const BuiltInFunctionMultiDCTNFTTransfer = "MultiDCTNFTTransfer"
 
@@ -96,9 +161,33 @@ const BuiltInFunctionDCTUnFreeze = "DCTUnFreeze"
 // BuiltInFunctionDCTWipe is the key for the Dharitri Core Token (DCT) wipe built-in function
 const BuiltInFunctionDCTWipe = "DCTWipe"
 
+// BuiltInFunctionDCTFreezeSingleNFT is the key for the Dharitri Core Token (DCT) freeze single NFT built-in function
+const BuiltInFunctionDCTFreezeSingleNFT = "DCTFreezeSingleNFT"
+
+// BuiltInFunctionDCTUnFreezeSingleNFT is the key for the Dharitri Core Token (DCT) unfreeze single NFT built-in function
+const BuiltInFunctionDCTUnFreezeSingleNFT = "DCTUnFreezeSingleNFT"
+
+// BuiltInFunctionDCTWipeSingleNFT is the key for the Dharitri Core Token (DCT) wipe single NFT built-in function
+const BuiltInFunctionDCTWipeSingleNFT = "DCTWipeSingleNFT"
+
 // BuiltInFunctionDCTPause is the key for the Dharitri Core Token (DCT) pause built-in function
 const BuiltInFunctionDCTPause = "DCTPause"
 
+// BuiltInFunctionDCTSetLimitedTransfer is the key for the Dharitri Core Token (DCT) set limited transfer built-in function
+const BuiltInFunctionDCTSetLimitedTransfer = "DCTSetLimitedTransfer"
+
+// BuiltInFunctionDCTUnSetLimitedTransfer is the key for the Dharitri Core Token (DCT) unset limited transfer built-in function
+const BuiltInFunctionDCTUnSetLimitedTransfer = "DCTUnSetLimitedTransfer"
+
+// BuiltInFunctionDCTSetTokenType is the key for the Dharitri Core Token (DCT) set token type built-in function
+const BuiltInFunctionDCTSetTokenType = "DCTSetTokenType"
+
+// BuiltInFunctionDCTSetMaxSupply is the key for the Dharitri Core Token (DCT) set max supply built-in function
+const BuiltInFunctionDCTSetMaxSupply = "DCTSetMaxSupply"
+
+// BuiltInFunctionDCTAddMintAllowance is the key for the Dharitri Core Token (DCT) add mint allowance built-in function
+const BuiltInFunctionDCTAddMintAllowance = "DCTAddMintAllowance"
+
 // BuiltInFunctionDCTUnPause is the key for the Dharitri Core Token (DCT) unpause built-in function
 const BuiltInFunctionDCTUnPause = "DCTUnPause"
 
@@ -131,6 +220,12 @@ const (
 	Fungible DCTType = iota
 	// NonFungible defines the token type for DCT non fungible tokens
 	NonFungible
+	// SemiFungible defines the token type for DCT semi fungible tokens
+	SemiFungible
+	// MetaFungible defines the token type for DCT meta fungible tokens
+	MetaFungible
+	// NativeMOA defines the token type for the native MOA value carried by a multi transfer
+	NativeMOA
 )
 
 // FungibleDCT defines the string for the token type of fungible DCT
@@ -142,6 +237,15 @@ const NonFungibleDCT = "NonFungibleDCT"
 // SemiFungibleDCT defines the string for the token type of semi fungible DCT
 const SemiFungibleDCT = "SemiFungibleDCT"
 
+// MetaDCT defines the string for the token type of meta DCT
+const MetaDCT = "MetaDCT"
+
+// NativeMOADCT defines the string for the token type of the native MOA value carried by a multi transfer
+const NativeMOADCT = "NativeMOA"
+
+// MOATokenIdentifier is the reserved token identifier which represents the native MOA value inside a multi transfer
+const MOATokenIdentifier = "MOA-000000"
+
 // MaxRoyalty defines 100% as uint32
 const MaxRoyalty = uint32(10000)
 
@@ -149,6 +149,7 @@ type MetaData struct {
 	Hash       []byte   `protobuf:"bytes,5,opt,name=Hash,proto3" json:"Hash"`
 	URIs       [][]byte `protobuf:"bytes,6,rep,name=URIs,proto3" json:"URIs"`
 	Attributes []byte   `protobuf:"bytes,7,opt,name=Attributes,proto3" json:"Attributes"`
+	Decimals   uint32   `protobuf:"varint,8,opt,name=Decimals,proto3" json:"Decimals"`
 }
 
 func (m *MetaData) Reset()      { *m = MetaData{} }
@@ -228,48 +229,212 @@ func (m *MetaData) GetAttributes() []byte {
 	return nil
 }
 
+func (m *MetaData) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+// DCTSupply holds the supply information for a DCT token or for a nonce of a DCT NFT/SFT token
+type DCTSupply struct {
+	Supply *math_big.Int `protobuf:"bytes,1,opt,name=Supply,proto3,casttypewith=math/big.Int;github.com/Dharitri-org/me-vm-common/data.BigIntCaster" json:"Supply"`
+	Minted *math_big.Int `protobuf:"bytes,2,opt,name=Minted,proto3,casttypewith=math/big.Int;github.com/Dharitri-org/me-vm-common/data.BigIntCaster" json:"Minted"`
+	Burned *math_big.Int `protobuf:"bytes,3,opt,name=Burned,proto3,casttypewith=math/big.Int;github.com/Dharitri-org/me-vm-common/data.BigIntCaster" json:"Burned"`
+}
+
+func (m *DCTSupply) Reset()      { *m = DCTSupply{} }
+func (*DCTSupply) ProtoMessage() {}
+func (*DCTSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1cf62b86c79b684, []int{3}
+}
+func (m *DCTSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DCTSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DCTSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DCTSupply.Merge(m, src)
+}
+func (m *DCTSupply) XXX_Size() int {
+	return m.Size()
+}
+func (m *DCTSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_DCTSupply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DCTSupply proto.InternalMessageInfo
+
+func (m *DCTSupply) GetSupply() *math_big.Int {
+	if m != nil {
+		return m.Supply
+	}
+	return nil
+}
+
+func (m *DCTSupply) GetMinted() *math_big.Int {
+	if m != nil {
+		return m.Minted
+	}
+	return nil
+}
+
+func (m *DCTSupply) GetBurned() *math_big.Int {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+// DCTLockedAmount holds an amount of a DCT token which can not be spent before the unlock epoch
+type DCTLockedAmount struct {
+	Value       *math_big.Int `protobuf:"bytes,1,opt,name=Value,proto3,casttypewith=math/big.Int;github.com/Dharitri-org/me-vm-common/data.BigIntCaster" json:"Value"`
+	UnlockEpoch uint32        `protobuf:"varint,2,opt,name=UnlockEpoch,proto3" json:"UnlockEpoch"`
+}
+
+func (m *DCTLockedAmount) Reset()      { *m = DCTLockedAmount{} }
+func (*DCTLockedAmount) ProtoMessage() {}
+func (*DCTLockedAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1cf62b86c79b684, []int{4}
+}
+func (m *DCTLockedAmount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DCTLockedAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DCTLockedAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DCTLockedAmount.Merge(m, src)
+}
+func (m *DCTLockedAmount) XXX_Size() int {
+	return m.Size()
+}
+func (m *DCTLockedAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_DCTLockedAmount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DCTLockedAmount proto.InternalMessageInfo
+
+func (m *DCTLockedAmount) GetValue() *math_big.Int {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *DCTLockedAmount) GetUnlockEpoch() uint32 {
+	if m != nil {
+		return m.UnlockEpoch
+	}
+	return 0
+}
+
+// DCTLockedBalance holds the locked amounts of a DCT token, saved in the reserved field of the token data
+type DCTLockedBalance struct {
+	LockedAmounts []*DCTLockedAmount `protobuf:"bytes,1,rep,name=LockedAmounts,proto3" json:"LockedAmounts"`
+}
+
+func (m *DCTLockedBalance) Reset()      { *m = DCTLockedBalance{} }
+func (*DCTLockedBalance) ProtoMessage() {}
+func (*DCTLockedBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1cf62b86c79b684, []int{5}
+}
+func (m *DCTLockedBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DCTLockedBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DCTLockedBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DCTLockedBalance.Merge(m, src)
+}
+func (m *DCTLockedBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *DCTLockedBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_DCTLockedBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DCTLockedBalance proto.InternalMessageInfo
+
+func (m *DCTLockedBalance) GetLockedAmounts() []*DCTLockedAmount {
+	if m != nil {
+		return m.LockedAmounts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DCToken)(nil), "protoBuiltInFunctions.DCToken")
 	proto.RegisterType((*DCTRoles)(nil), "protoBuiltInFunctions.DCTRoles")
 	proto.RegisterType((*MetaData)(nil), "protoBuiltInFunctions.MetaData")
+	proto.RegisterType((*DCTSupply)(nil), "protoBuiltInFunctions.DCTSupply")
+	proto.RegisterType((*DCTLockedAmount)(nil), "protoBuiltInFunctions.DCTLockedAmount")
+	proto.RegisterType((*DCTLockedBalance)(nil), "protoBuiltInFunctions.DCTLockedBalance")
 }
 
 func init() { proto.RegisterFile("dct.proto", fileDescriptor_c1cf62b86c79b684) }
 
 var fileDescriptor_c1cf62b86c79b684 = []byte{
-	// 505 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x51, 0xbd, 0x8a, 0xdb, 0x4c,
-	0x14, 0xd5, 0xf8, 0x67, 0x6d, 0xcf, 0xda, 0x5f, 0x21, 0xf8, 0x40, 0x84, 0x30, 0x32, 0x86, 0x80,
-	0x61, 0xb1, 0x0c, 0x49, 0x19, 0x52, 0x44, 0x32, 0x21, 0x2e, 0xb2, 0x2c, 0x13, 0x27, 0x45, 0xba,
-	0x91, 0x3c, 0x2b, 0x8b, 0x58, 0x1a, 0x33, 0x1a, 0x2d, 0x6c, 0x97, 0x36, 0x5d, 0x1e, 0x23, 0xe4,
-	0x21, 0x52, 0xa7, 0x74, 0xe9, 0x4a, 0x89, 0xe5, 0x26, 0xa8, 0xda, 0x47, 0x08, 0x73, 0xb5, 0x5a,
-	0x3b, 0x90, 0xe6, 0xce, 0x39, 0x67, 0x2e, 0x73, 0xef, 0x9c, 0x83, 0x7b, 0xcb, 0x40, 0x39, 0x1b,
-	0x29, 0x94, 0x30, 0xff, 0x87, 0xc3, 0xcd, 0xa2, 0xb5, 0x9a, 0x27, 0xaf, 0xb2, 0x24, 0x50, 0x91,
-	0x48, 0xd2, 0x47, 0x93, 0x30, 0x52, 0xab, 0xcc, 0x77, 0x02, 0x11, 0x4f, 0x43, 0x11, 0x8a, 0x29,
-	0xb4, 0xf9, 0xd9, 0x35, 0x30, 0x20, 0x80, 0xaa, 0x57, 0x46, 0xdf, 0x1b, 0xb8, 0x33, 0xf3, 0x16,
-	0xe2, 0x23, 0x4f, 0xcc, 0xc7, 0xb8, 0xb5, 0xb8, 0xdd, 0x70, 0x0b, 0x0d, 0xd1, 0x78, 0xe0, 0x76,
-	0xcb, 0xdc, 0x06, 0x4e, 0xa1, 0x9a, 0xd7, 0xb8, 0xfd, 0x9e, 0xad, 0x33, 0x6e, 0x35, 0x86, 0x68,
-	0xdc, 0x77, 0xaf, 0xca, 0xdc, 0xae, 0x84, 0x6f, 0x3f, 0x6d, 0x2f, 0x66, 0x6a, 0x35, 0xf5, 0xa3,
-	0xd0, 0x99, 0x27, 0xea, 0xf9, 0xc9, 0x06, 0xb3, 0x15, 0x93, 0x91, 0x92, 0xd1, 0x44, 0xc8, 0x70,
-	0x1a, 0xf3, 0xc9, 0x4d, 0x3c, 0x09, 0x44, 0x1c, 0x8b, 0x64, 0xba, 0x64, 0x8a, 0x39, 0x6e, 0x14,
-	0xce, 0x13, 0xe5, 0xb1, 0x54, 0x71, 0x49, 0xab, 0xd7, 0x4c, 0x07, 0xe3, 0x2b, 0x29, 0x36, 0x5c,
-	0xaa, 0x88, 0xa7, 0x56, 0x13, 0x86, 0xfd, 0x57, 0xe6, 0xf6, 0x89, 0x4a, 0x4f, 0xb0, 0xf9, 0x16,
-	0x0f, 0x60, 0xfd, 0x37, 0x5c, 0xb1, 0x19, 0x53, 0xcc, 0x6a, 0x0d, 0xd1, 0xf8, 0xfc, 0xa9, 0xed,
-	0xfc, 0xd3, 0x1f, 0xa7, 0x6e, 0x73, 0xfb, 0x65, 0x6e, 0x77, 0x6b, 0x46, 0xff, 0x7e, 0xc3, 0x1c,
-	0xe3, 0x2e, 0xe5, 0x29, 0x97, 0x37, 0x7c, 0x69, 0xb5, 0x61, 0x05, 0x68, 0xaf, 0x35, 0xfa, 0x80,
-	0x46, 0x17, 0xb8, 0x3b, 0xf3, 0x16, 0x54, 0xac, 0x79, 0x6a, 0xda, 0xb8, 0x0d, 0xc0, 0x42, 0xc3,
-	0xe6, 0xb8, 0xef, 0xf6, 0xb4, 0x45, 0x52, 0x0b, 0xb4, 0xd2, 0x47, 0x9f, 0x1b, 0xf8, 0x61, 0xa4,
-	0xee, 0xbe, 0x14, 0x49, 0x50, 0xf9, 0xdd, 0xaa, 0xba, 0x41, 0xa0, 0xd5, 0xa1, 0xf3, 0xb8, 0x64,
-	0x71, 0x6d, 0x38, 0xe4, 0xa1, 0x39, 0x85, 0x6a, 0x3e, 0xc1, 0x1d, 0x4f, 0x72, 0xa6, 0x84, 0xbc,
-	0x37, 0xe9, 0xbc, 0xcc, 0xed, 0x5a, 0xa2, 0x35, 0x30, 0x2f, 0x70, 0x8f, 0x8a, 0x5b, 0xb6, 0x06,
-	0x37, 0x5b, 0x90, 0xec, 0xa0, 0xcc, 0xed, 0xa3, 0x48, 0x8f, 0x50, 0x4f, 0x7c, 0xcd, 0xd2, 0xd5,
-	0xfd, 0x97, 0x61, 0xa2, 0xe6, 0x14, 0xaa, 0xbe, 0x7d, 0x47, 0xe7, 0xa9, 0x75, 0x06, 0xbf, 0x83,
-	0x5b, 0xcd, 0x29, 0x54, 0x9d, 0xdb, 0x4b, 0xa5, 0x64, 0xe4, 0x67, 0x8a, 0xa7, 0x56, 0xe7, 0x98,
-	0xdb, 0x51, 0xa5, 0x27, 0xd8, 0x7d, 0xb1, 0xdd, 0x13, 0x63, 0xb7, 0x27, 0xc6, 0xdd, 0x9e, 0xa0,
-	0x4f, 0x05, 0x41, 0x5f, 0x0b, 0x82, 0x7e, 0x14, 0x04, 0x6d, 0x0b, 0x82, 0x76, 0x05, 0x41, 0xbf,
-	0x0a, 0x82, 0x7e, 0x17, 0xc4, 0xb8, 0x2b, 0x08, 0xfa, 0x72, 0x20, 0xc6, 0xf6, 0x40, 0x8c, 0xdd,
-	0x81, 0x18, 0x1f, 0x9a, 0xcb, 0x40, 0xf9, 0x67, 0x10, 0xef, 0xb3, 0x3f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xb6, 0xab, 0xfc, 0x30, 0x12, 0x03, 0x00, 0x00,
+	// 668 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0xce, 0xe5, 0x5f, 0x93, 0x4b, 0xf2, 0xeb, 0x0f, 0x4b, 0x48, 0x16, 0x42, 0xe7, 0x28, 0x12,
+	0x28, 0x52, 0x95, 0x44, 0x94, 0x11, 0x31, 0xd4, 0x36, 0x88, 0x48, 0xb4, 0x2a, 0xd7, 0x94, 0x81,
+	0x05, 0x5d, 0xec, 0x6b, 0x62, 0xd5, 0xf6, 0x45, 0xf6, 0xb9, 0x52, 0x37, 0x3e, 0x02, 0x1f, 0x03,
+	0x21, 0x36, 0x76, 0x66, 0x06, 0x86, 0x8e, 0x9d, 0x0c, 0x75, 0x17, 0xe4, 0xa9, 0x1f, 0x01, 0xf9,
+	0xae, 0x8e, 0x5d, 0x04, 0x5b, 0x59, 0xce, 0xcf, 0xf3, 0xdc, 0xe9, 0x7d, 0xde, 0x3c, 0x6f, 0x5e,
+	0xd8, 0xb6, 0x2d, 0x3e, 0x5e, 0x05, 0x8c, 0x33, 0xe5, 0xae, 0xf8, 0xe8, 0x91, 0xe3, 0xf2, 0xa9,
+	0xff, 0x3c, 0xf2, 0x2d, 0xee, 0x30, 0x3f, 0xbc, 0x37, 0x5a, 0x38, 0x7c, 0x19, 0xcd, 0xc7, 0x16,
+	0xf3, 0x26, 0x0b, 0xb6, 0x60, 0x13, 0xf1, 0x6c, 0x1e, 0x1d, 0x09, 0x26, 0x88, 0x40, 0xb2, 0xca,
+	0xe0, 0x4b, 0x15, 0x6e, 0x98, 0xc6, 0x8c, 0x1d, 0x53, 0x5f, 0xb9, 0x0f, 0xeb, 0xb3, 0xd3, 0x15,
+	0x55, 0x41, 0x1f, 0x0c, 0x7b, 0x7a, 0x2b, 0x8d, 0x35, 0xc1, 0xb1, 0x38, 0x95, 0x23, 0xd8, 0x78,
+	0x4d, 0xdc, 0x88, 0xaa, 0xd5, 0x3e, 0x18, 0x76, 0xf5, 0xfd, 0x34, 0xd6, 0xa4, 0xf0, 0xf1, 0xbb,
+	0x66, 0x78, 0x84, 0x2f, 0x27, 0x73, 0x67, 0x31, 0x9e, 0xfa, 0xfc, 0x49, 0xa9, 0x03, 0x73, 0x49,
+	0x02, 0x87, 0x07, 0xce, 0x88, 0x05, 0x8b, 0x89, 0x47, 0x47, 0x27, 0xde, 0xc8, 0x62, 0x9e, 0xc7,
+	0xfc, 0x89, 0x4d, 0x38, 0x19, 0xeb, 0xce, 0x62, 0xea, 0x73, 0x83, 0x84, 0x9c, 0x06, 0x58, 0x56,
+	0x53, 0xc6, 0x10, 0xee, 0x07, 0x6c, 0x45, 0x03, 0xee, 0xd0, 0x50, 0xad, 0x09, 0xb3, 0xff, 0xd2,
+	0x58, 0x2b, 0xa9, 0xb8, 0x84, 0x95, 0x03, 0xd8, 0x13, 0xed, 0xef, 0x52, 0x4e, 0x4c, 0xc2, 0x89,
+	0x5a, 0xef, 0x83, 0x61, 0x67, 0x5b, 0x1b, 0xff, 0x31, 0x9f, 0x71, 0xfe, 0x4c, 0xef, 0xa6, 0xb1,
+	0xd6, 0xca, 0x19, 0xbe, 0x59, 0x43, 0x19, 0xc2, 0x16, 0xa6, 0x21, 0x0d, 0x4e, 0xa8, 0xad, 0x36,
+	0x44, 0x0b, 0xe2, 0x79, 0xae, 0xe1, 0x35, 0x1a, 0x6c, 0xc1, 0x96, 0x69, 0xcc, 0x30, 0x73, 0x69,
+	0xa8, 0x68, 0xb0, 0x21, 0x80, 0x0a, 0xfa, 0xb5, 0x61, 0x57, 0x6f, 0x67, 0x11, 0x05, 0x99, 0x80,
+	0xa5, 0x3e, 0xf8, 0x5c, 0x85, 0x6b, 0xcb, 0xec, 0xf5, 0x1e, 0xf3, 0x2d, 0x99, 0x77, 0x5d, 0xbe,
+	0x16, 0x02, 0x96, 0x9f, 0x6c, 0x1e, 0x7b, 0xc4, 0xcb, 0x03, 0x17, 0xf3, 0xc8, 0x38, 0x16, 0xa7,
+	0xf2, 0x00, 0x6e, 0x18, 0x01, 0x25, 0x9c, 0x05, 0xd7, 0x21, 0x75, 0xd2, 0x58, 0xcb, 0x25, 0x9c,
+	0x03, 0x65, 0x0b, 0xb6, 0x31, 0x3b, 0x25, 0xae, 0x48, 0xb3, 0x2e, 0x26, 0xdb, 0x4b, 0x63, 0xad,
+	0x10, 0x71, 0x01, 0x33, 0xc7, 0x17, 0x24, 0x5c, 0xaa, 0x8d, 0xc2, 0x31, 0xe3, 0x58, 0x9c, 0xd9,
+	0xed, 0x21, 0x9e, 0x86, 0x6a, 0xb3, 0x5f, 0xcb, 0x6f, 0x33, 0x8e, 0xc5, 0x99, 0xcd, 0x6d, 0x87,
+	0xf3, 0xc0, 0x99, 0x47, 0x9c, 0x86, 0xea, 0x46, 0x31, 0xb7, 0x42, 0xc5, 0x25, 0x9c, 0x45, 0x6c,
+	0x52, 0xcb, 0xf1, 0x88, 0x1b, 0xaa, 0x2d, 0xd1, 0x97, 0x88, 0x38, 0xd7, 0xf0, 0x1a, 0x0d, 0xbe,
+	0x55, 0x61, 0xdb, 0x34, 0x66, 0x07, 0xd1, 0x6a, 0xe5, 0x9e, 0x2a, 0x0e, 0x6c, 0x4a, 0x24, 0x72,
+	0xeb, 0xea, 0xaf, 0xd2, 0x58, 0xbb, 0x56, 0x6e, 0xeb, 0x9f, 0xd8, 0x2c, 0xac, 0x76, 0x1d, 0x9f,
+	0x53, 0x5b, 0xad, 0x16, 0x56, 0x52, 0xb9, 0x35, 0x2b, 0x59, 0x2e, 0xb3, 0xd2, 0xa3, 0xc0, 0xa7,
+	0xb6, 0x5a, 0x2b, 0xac, 0xa4, 0x72, 0x6b, 0x56, 0xb2, 0xdc, 0xe0, 0x13, 0x80, 0x9b, 0xa6, 0x31,
+	0x7b, 0xc9, 0xac, 0x63, 0x6a, 0xef, 0x78, 0x2c, 0xf2, 0x79, 0xb1, 0xdc, 0xe0, 0xdf, 0x2e, 0xf7,
+	0x23, 0xd8, 0x39, 0xf4, 0x5d, 0x66, 0x1d, 0x3f, 0x5b, 0x31, 0x6b, 0x29, 0x62, 0xed, 0xe9, 0x9b,
+	0x69, 0xac, 0x95, 0x65, 0x5c, 0x26, 0x83, 0x10, 0xfe, 0xbf, 0xee, 0x56, 0x27, 0x2e, 0xc9, 0x36,
+	0xe3, 0x2d, 0xec, 0x95, 0xdb, 0x97, 0x0b, 0xd7, 0xd9, 0x7e, 0xf8, 0x97, 0x9d, 0xff, 0xed, 0xd7,
+	0xea, 0x77, 0xd2, 0x58, 0xbb, 0x59, 0x00, 0xdf, 0xa4, 0xfa, 0xd3, 0xb3, 0x0b, 0x54, 0x39, 0xbf,
+	0x40, 0x95, 0xab, 0x0b, 0x04, 0xde, 0x25, 0x08, 0x7c, 0x48, 0x10, 0xf8, 0x9a, 0x20, 0x70, 0x96,
+	0x20, 0x70, 0x9e, 0x20, 0xf0, 0x23, 0x41, 0xe0, 0x67, 0x82, 0x2a, 0x57, 0x09, 0x02, 0xef, 0x2f,
+	0x51, 0xe5, 0xec, 0x12, 0x55, 0xce, 0x2f, 0x51, 0xe5, 0x4d, 0xcd, 0xb6, 0xf8, 0xbc, 0x29, 0xfa,
+	0x78, 0xfc, 0x6b, 0x00, 0x8d, 0x21, 0xdd, 0x2d, 0xaf, 0x05, 0x00, 0x00,
 }
 
 func (this *DCToken) Equal(that interface{}) bool {
@@ -385,6 +550,107 @@ func (this *MetaData) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Attributes, that1.Attributes) {
 		return false
 	}
+	if this.Decimals != that1.Decimals {
+		return false
+	}
+	return true
+}
+func (this *DCTSupply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DCTSupply)
+	if !ok {
+		that2, ok := that.(DCTSupply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	{
+		__caster := &github_com_Dharitri_org_me_vm_common_data.BigIntCaster{}
+		if !__caster.Equal(this.Supply, that1.Supply) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_Dharitri_org_me_vm_common_data.BigIntCaster{}
+		if !__caster.Equal(this.Minted, that1.Minted) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_Dharitri_org_me_vm_common_data.BigIntCaster{}
+		if !__caster.Equal(this.Burned, that1.Burned) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DCTLockedAmount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DCTLockedAmount)
+	if !ok {
+		that2, ok := that.(DCTLockedAmount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	{
+		__caster := &github_com_Dharitri_org_me_vm_common_data.BigIntCaster{}
+		if !__caster.Equal(this.Value, that1.Value) {
+			return false
+		}
+	}
+	if this.UnlockEpoch != that1.UnlockEpoch {
+		return false
+	}
+	return true
+}
+func (this *DCTLockedBalance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DCTLockedBalance)
+	if !ok {
+		that2, ok := that.(DCTLockedBalance)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.LockedAmounts) != len(that1.LockedAmounts) {
+		return false
+	}
+	for i := range this.LockedAmounts {
+		if !this.LockedAmounts[i].Equal(that1.LockedAmounts[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *DCToken) GoString() string {
@@ -417,7 +683,7 @@ func (this *MetaData) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 12)
 	s = append(s, "&dct.MetaData{")
 	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
 	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
@@ -426,6 +692,42 @@ func (this *MetaData) GoString() string {
 	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
 	s = append(s, "URIs: "+fmt.Sprintf("%#v", this.URIs)+",\n")
 	s = append(s, "Attributes: "+fmt.Sprintf("%#v", this.Attributes)+",\n")
+	s = append(s, "Decimals: "+fmt.Sprintf("%#v", this.Decimals)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DCTSupply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&dct.DCTSupply{")
+	s = append(s, "Supply: "+fmt.Sprintf("%#v", this.Supply)+",\n")
+	s = append(s, "Minted: "+fmt.Sprintf("%#v", this.Minted)+",\n")
+	s = append(s, "Burned: "+fmt.Sprintf("%#v", this.Burned)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DCTLockedAmount) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&dct.DCTLockedAmount{")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "UnlockEpoch: "+fmt.Sprintf("%#v", this.UnlockEpoch)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DCTLockedBalance) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&dct.DCTLockedBalance{")
+	if this.LockedAmounts != nil {
+		s = append(s, "LockedAmounts: "+fmt.Sprintf("%#v", this.LockedAmounts)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -554,6 +856,11 @@ func (m *MetaData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintDct(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.Attributes) > 0 {
 		i -= len(m.Attributes)
 		copy(dAtA[i:], m.Attributes)
@@ -604,42 +911,174 @@ func (m *MetaData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDct(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDct(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DCTSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *DCToken) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *DCTSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DCTSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovDct(uint64(m.Type))
-	}
 	{
 		__caster := &github_com_Dharitri_org_me_vm_common_data.BigIntCaster{}
-		l = __caster.Size(m.Value)
-		n += 1 + l + sovDct(uint64(l))
+		size := __caster.Size(m.Burned)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Burned, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDct(dAtA, i, uint64(size))
 	}
-	l = len(m.Properties)
-	if l > 0 {
-		n += 1 + l + sovDct(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		__caster := &github_com_Dharitri_org_me_vm_common_data.BigIntCaster{}
+		size := __caster.Size(m.Minted)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Minted, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDct(dAtA, i, uint64(size))
 	}
-	if m.TokenMetaData != nil {
-		l = m.TokenMetaData.Size()
-		n += 1 + l + sovDct(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		__caster := &github_com_Dharitri_org_me_vm_common_data.BigIntCaster{}
+		size := __caster.Size(m.Supply)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Supply, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDct(dAtA, i, uint64(size))
 	}
-	l = len(m.Reserved)
-	if l > 0 {
-		n += 1 + l + sovDct(uint64(l))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DCTLockedAmount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DCTLockedAmount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DCTLockedAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnlockEpoch != 0 {
+		i = encodeVarintDct(dAtA, i, uint64(m.UnlockEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		__caster := &github_com_Dharitri_org_me_vm_common_data.BigIntCaster{}
+		size := __caster.Size(m.Value)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Value, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDct(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DCTLockedBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DCTLockedBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DCTLockedBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockedAmounts) > 0 {
+		for iNdEx := len(m.LockedAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDct(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDct(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDct(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DCToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovDct(uint64(m.Type))
+	}
+	{
+		__caster := &github_com_Dharitri_org_me_vm_common_data.BigIntCaster{}
+		l = __caster.Size(m.Value)
+		n += 1 + l + sovDct(uint64(l))
+	}
+	l = len(m.Properties)
+	if l > 0 {
+		n += 1 + l + sovDct(uint64(l))
+	}
+	if m.TokenMetaData != nil {
+		l = m.TokenMetaData.Size()
+		n += 1 + l + sovDct(uint64(l))
+	}
+	l = len(m.Reserved)
+	if l > 0 {
+		n += 1 + l + sovDct(uint64(l))
 	}
 	return n
 }
@@ -693,6 +1132,65 @@ func (m *MetaData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDct(uint64(l))
 	}
+	if m.Decimals != 0 {
+		n += 1 + sovDct(uint64(m.Decimals))
+	}
+	return n
+}
+
+func (m *DCTSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	{
+		__caster := &github_com_Dharitri_org_me_vm_common_data.BigIntCaster{}
+		l = __caster.Size(m.Supply)
+		n += 1 + l + sovDct(uint64(l))
+	}
+	{
+		__caster := &github_com_Dharitri_org_me_vm_common_data.BigIntCaster{}
+		l = __caster.Size(m.Minted)
+		n += 1 + l + sovDct(uint64(l))
+	}
+	{
+		__caster := &github_com_Dharitri_org_me_vm_common_data.BigIntCaster{}
+		l = __caster.Size(m.Burned)
+		n += 1 + l + sovDct(uint64(l))
+	}
+	return n
+}
+
+func (m *DCTLockedAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	{
+		__caster := &github_com_Dharitri_org_me_vm_common_data.BigIntCaster{}
+		l = __caster.Size(m.Value)
+		n += 1 + l + sovDct(uint64(l))
+	}
+	if m.UnlockEpoch != 0 {
+		n += 1 + sovDct(uint64(m.UnlockEpoch))
+	}
+	return n
+}
+
+func (m *DCTLockedBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LockedAmounts) > 0 {
+		for _, e := range m.LockedAmounts {
+			l = e.Size()
+			n += 1 + l + sovDct(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -738,6 +1236,45 @@ func (this *MetaData) String() string {
 		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
 		`URIs:` + fmt.Sprintf("%v", this.URIs) + `,`,
 		`Attributes:` + fmt.Sprintf("%v", this.Attributes) + `,`,
+		`Decimals:` + fmt.Sprintf("%v", this.Decimals) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DCTSupply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DCTSupply{`,
+		`Supply:` + fmt.Sprintf("%v", this.Supply) + `,`,
+		`Minted:` + fmt.Sprintf("%v", this.Minted) + `,`,
+		`Burned:` + fmt.Sprintf("%v", this.Burned) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DCTLockedAmount) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DCTLockedAmount{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`UnlockEpoch:` + fmt.Sprintf("%v", this.UnlockEpoch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DCTLockedBalance) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForLockedAmounts := "[]*DCTLockedAmount{"
+	for _, f := range this.LockedAmounts {
+		repeatedStringForLockedAmounts += strings.Replace(f.String(), "DCTLockedAmount", "DCTLockedAmount", 1) + ","
+	}
+	repeatedStringForLockedAmounts += "}"
+	s := strings.Join([]string{`&DCTLockedBalance{`,
+		`LockedAmounts:` + repeatedStringForLockedAmounts + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1278,6 +1815,380 @@ func (m *MetaData) Unmarshal(dAtA []byte) error {
 				m.Attributes = []byte{}
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DCTSupply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DCTSupply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DCTSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDct
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_Dharitri_org_me_vm_common_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Supply = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDct
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_Dharitri_org_me_vm_common_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Minted = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDct
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_Dharitri_org_me_vm_common_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Burned = tmp
+				}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DCTLockedAmount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DCTLockedAmount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DCTLockedAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDct
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_Dharitri_org_me_vm_common_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Value = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockEpoch", wireType)
+			}
+			m.UnlockEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DCTLockedBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DCTLockedBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DCTLockedBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDct
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedAmounts = append(m.LockedAmounts, &DCTLockedAmount{})
+			if err := m.LockedAmounts[len(m.LockedAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDct(dAtA[iNdEx:])
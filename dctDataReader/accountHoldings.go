@@ -0,0 +1,188 @@
+package dctDataReader
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+)
+
+// tokenRandomSuffixLength is the length of the random part which follows the dash inside a token identifier
+const tokenRandomSuffixLength = 6
+
+// ignoredKeyPrefixes are the protected keys which are known, but do not describe the holdings of an account
+var ignoredKeyPrefixes = [][]byte{
+	[]byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTDecimalsIdentifier + vmcommon.DCTKeyIdentifier),
+	[]byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTSupplyIdentifier + vmcommon.DCTKeyIdentifier),
+	[]byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTMaxSupplyIdentifier + vmcommon.DCTKeyIdentifier),
+	[]byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTMintAllowanceIdentifier + vmcommon.DCTKeyIdentifier),
+	[]byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTAllowanceIdentifier + vmcommon.DCTKeyIdentifier),
+}
+
+// FungibleHolding is a fungible token held by an account
+type FungibleHolding struct {
+	TokenID string
+	Token   *dct.DCToken
+}
+
+// NFTHolding is an NFT, SFT or meta token held by an account, together with its metadata
+type NFTHolding struct {
+	TokenID string
+	Nonce   uint64
+	Token   *dct.DCToken
+}
+
+// AccountHoldings is the typed view over the dct data saved in the storage of an account
+type AccountHoldings struct {
+	Fungible      []*FungibleHolding
+	NFTs          []*NFTHolding
+	Roles         map[string][][]byte
+	LatestNonces  map[string]uint64
+	UndecodedKeys [][]byte
+}
+
+// GetAccountHoldings decodes the full state of an account, as it is returned by the GetAllState function, into the
+// tokens, roles and nonce counters held by the account. The NFTs which do not keep their metadata on the account are
+// completed with the metadata saved on the system account. Protected keys which can not be decoded are returned
+// inside UndecodedKeys, while the non-protected keys are ignored
+func (r *dctDataReader) GetAccountHoldings(accountState map[string][]byte) (*AccountHoldings, error) {
+	holdings := &AccountHoldings{
+		Fungible:      make([]*FungibleHolding, 0),
+		NFTs:          make([]*NFTHolding, 0),
+		Roles:         make(map[string][][]byte),
+		LatestNonces:  make(map[string]uint64),
+		UndecodedKeys: make([][]byte, 0),
+	}
+
+	for key, value := range accountState {
+		keyBytes := []byte(key)
+		if !bytes.HasPrefix(keyBytes, []byte(vmcommon.DharitriProtectedKeyPrefix)) || len(value) == 0 {
+			continue
+		}
+
+		decoded, err := r.decodeProtectedKey(holdings, keyBytes, value)
+		if err != nil {
+			return nil, err
+		}
+		if !decoded {
+			holdings.UndecodedKeys = append(holdings.UndecodedKeys, keyBytes)
+		}
+	}
+
+	sortHoldings(holdings)
+
+	return holdings, nil
+}
+
+func (r *dctDataReader) decodeProtectedKey(holdings *AccountHoldings, key []byte, value []byte) (bool, error) {
+	switch {
+	case bytes.HasPrefix(key, tokenKeyPrefix):
+		return r.decodeToken(holdings, key[len(tokenKeyPrefix):], value)
+	case bytes.HasPrefix(key, roleKeyPrefix):
+		return r.decodeRoles(holdings, key[len(roleKeyPrefix):], value), nil
+	case bytes.HasPrefix(key, noncePrefix):
+		holdings.LatestNonces[string(key[len(noncePrefix):])] = big.NewInt(0).SetBytes(value).Uint64()
+		return true, nil
+	}
+
+	for _, prefix := range ignoredKeyPrefixes {
+		if bytes.HasPrefix(key, prefix) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (r *dctDataReader) decodeToken(holdings *AccountHoldings, tokenKey []byte, value []byte) (bool, error) {
+	tokenID, nonce, ok := splitTokenKey(tokenKey)
+	if !ok {
+		return false, nil
+	}
+
+	dctData := &dct.DCToken{}
+	err := r.marshalizer.Unmarshal(dctData, value)
+	if err != nil {
+		return false, nil
+	}
+	if dctData.Value == nil {
+		dctData.Value = big.NewInt(0)
+	}
+
+	if nonce == 0 {
+		holdings.Fungible = append(holdings.Fungible, &FungibleHolding{
+			TokenID: string(tokenID),
+			Token:   dctData,
+		})
+		return true, nil
+	}
+
+	if dctData.TokenMetaData == nil {
+		dctDataOnSystemAcc, found, errGet := r.getTokenData(vmcommon.SystemAccountAddress, computeTokenKey(tokenID, nonce))
+		if errGet != nil {
+			return false, errGet
+		}
+		if found {
+			dctData.TokenMetaData = dctDataOnSystemAcc.TokenMetaData
+		}
+	}
+
+	holdings.NFTs = append(holdings.NFTs, &NFTHolding{
+		TokenID: string(tokenID),
+		Nonce:   nonce,
+		Token:   dctData,
+	})
+
+	return true, nil
+}
+
+func (r *dctDataReader) decodeRoles(holdings *AccountHoldings, tokenID []byte, value []byte) bool {
+	roles := &dct.DCTRoles{}
+	err := r.marshalizer.Unmarshal(roles, value)
+	if err != nil {
+		return false
+	}
+	if len(roles.Roles) > 0 {
+		holdings.Roles[string(tokenID)] = roles.Roles
+	}
+
+	return true
+}
+
+// splitTokenKey separates the token identifier from the nonce bytes which are appended to it for NFTs. A token
+// identifier is made of a ticker, a dash and a random suffix of fixed length
+func splitTokenKey(tokenKey []byte) ([]byte, uint64, bool) {
+	dashIndex := bytes.IndexByte(tokenKey, '-')
+	tokenIDLength := dashIndex + 1 + tokenRandomSuffixLength
+	if dashIndex <= 0 || len(tokenKey) < tokenIDLength {
+		return nil, 0, false
+	}
+
+	nonceBytes := tokenKey[tokenIDLength:]
+	if len(nonceBytes) > 0 && nonceBytes[0] == 0 {
+		return nil, 0, false
+	}
+	nonce := big.NewInt(0).SetBytes(nonceBytes)
+	if !nonce.IsUint64() {
+		return nil, 0, false
+	}
+
+	return tokenKey[:tokenIDLength], nonce.Uint64(), true
+}
+
+func sortHoldings(holdings *AccountHoldings) {
+	sort.Slice(holdings.Fungible, func(i, j int) bool {
+		return holdings.Fungible[i].TokenID < holdings.Fungible[j].TokenID
+	})
+	sort.Slice(holdings.NFTs, func(i, j int) bool {
+		if holdings.NFTs[i].TokenID != holdings.NFTs[j].TokenID {
+			return holdings.NFTs[i].TokenID < holdings.NFTs[j].TokenID
+		}
+		return holdings.NFTs[i].Nonce < holdings.NFTs[j].Nonce
+	})
+	sort.Slice(holdings.UndecodedKeys, func(i, j int) bool {
+		return bytes.Compare(holdings.UndecodedKeys[i], holdings.UndecodedKeys[j]) < 0
+	})
+}
@@ -0,0 +1,81 @@
+package dctDataReader
+
+import (
+	"math/big"
+	"testing"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDctDataReader_GetAccountHoldings(t *testing.T) {
+	t.Parallel()
+
+	holder := mock.NewUserAccount([]byte("holder"))
+	systemAccount := mock.NewUserAccount(vmcommon.SystemAccountAddress)
+	metaData := &dct.MetaData{Nonce: 256, Name: []byte("name")}
+	saveToken(t, holder, computeTokenKey([]byte("TKN-abcdef"), 0), &dct.DCToken{Value: big.NewInt(50)})
+	saveToken(t, holder, computeTokenKey([]byte("FNG-123456"), 0), &dct.DCToken{Value: big.NewInt(5)})
+	saveToken(t, holder, computeTokenKey([]byte("NFT-abcdef"), 2), &dct.DCToken{Value: big.NewInt(1), TokenMetaData: &dct.MetaData{Nonce: 2}})
+	saveToken(t, holder, computeTokenKey([]byte("NFT-abcdef"), 256), &dct.DCToken{Value: big.NewInt(3)})
+	saveToken(t, systemAccount, computeTokenKey([]byte("NFT-abcdef"), 256), &dct.DCToken{TokenMetaData: metaData})
+	marshaledRoles, _ := (&mock.MarshalizerMock{}).Marshal(&dct.DCTRoles{Roles: [][]byte{[]byte(vmcommon.DCTRoleNFTCreate)}})
+	_ = holder.SaveKeyValue(append(append([]byte{}, roleKeyPrefix...), "NFT-abcdef"...), marshaledRoles)
+	_ = holder.SaveKeyValue(append(append([]byte{}, noncePrefix...), "NFT-abcdef"...), big.NewInt(256).Bytes())
+	_ = holder.SaveKeyValue([]byte(vmcommon.DharitriProtectedKeyPrefix+vmcommon.DCTAllowanceIdentifier+vmcommon.DCTKeyIdentifier+"TKN-abcdefspender"), []byte{1})
+	_ = holder.SaveKeyValue([]byte(vmcommon.DharitriProtectedKeyPrefix+"unknown"), []byte("value"))
+	_ = holder.SaveKeyValue(computeTokenKey([]byte("BAD-abcdef"), 0), []byte("not a token"))
+	_ = holder.SaveKeyValue([]byte("user key"), []byte("user value"))
+	reader := createReader(t, map[string]*mock.Account{
+		"holder":                              holder,
+		string(vmcommon.SystemAccountAddress): systemAccount,
+	})
+
+	holdings, err := reader.GetAccountHoldings(holder.Storage)
+	require.Nil(t, err)
+
+	require.Equal(t, 2, len(holdings.Fungible))
+	assert.Equal(t, "FNG-123456", holdings.Fungible[0].TokenID)
+	assert.Equal(t, big.NewInt(5), holdings.Fungible[0].Token.Value)
+	assert.Equal(t, "TKN-abcdef", holdings.Fungible[1].TokenID)
+	assert.Equal(t, big.NewInt(50), holdings.Fungible[1].Token.Value)
+
+	require.Equal(t, 2, len(holdings.NFTs))
+	assert.Equal(t, "NFT-abcdef", holdings.NFTs[0].TokenID)
+	assert.Equal(t, uint64(2), holdings.NFTs[0].Nonce)
+	assert.Equal(t, uint64(2), holdings.NFTs[0].Token.TokenMetaData.Nonce)
+	assert.Equal(t, uint64(256), holdings.NFTs[1].Nonce)
+	assert.Equal(t, big.NewInt(3), holdings.NFTs[1].Token.Value)
+	assert.Equal(t, metaData, holdings.NFTs[1].Token.TokenMetaData)
+
+	assert.Equal(t, map[string][][]byte{"NFT-abcdef": {[]byte(vmcommon.DCTRoleNFTCreate)}}, holdings.Roles)
+	assert.Equal(t, map[string]uint64{"NFT-abcdef": 256}, holdings.LatestNonces)
+	assert.Equal(t, [][]byte{
+		computeTokenKey([]byte("BAD-abcdef"), 0),
+		[]byte(vmcommon.DharitriProtectedKeyPrefix + "unknown"),
+	}, holdings.UndecodedKeys)
+}
+
+func TestSplitTokenKey(t *testing.T) {
+	t.Parallel()
+
+	tokenID, nonce, ok := splitTokenKey([]byte("TKN-abcdef"))
+	assert.True(t, ok)
+	assert.Equal(t, []byte("TKN-abcdef"), tokenID)
+	assert.Equal(t, uint64(0), nonce)
+
+	tokenID, nonce, ok = splitTokenKey(append([]byte("TKN-abcdef"), 1, 0))
+	assert.True(t, ok)
+	assert.Equal(t, []byte("TKN-abcdef"), tokenID)
+	assert.Equal(t, uint64(256), nonce)
+
+	_, _, ok = splitTokenKey([]byte("TKN"))
+	assert.False(t, ok)
+	_, _, ok = splitTokenKey([]byte("TKN-abc"))
+	assert.False(t, ok)
+	_, _, ok = splitTokenKey(append([]byte("TKN-abcdef"), 0, 1))
+	assert.False(t, ok)
+}
@@ -0,0 +1,196 @@
+package dctDataReader
+
+import (
+	"errors"
+	"math/big"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/builtInFunctions"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+)
+
+var (
+	tokenKeyPrefix = []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTKeyIdentifier)
+	roleKeyPrefix  = []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTRoleIdentifier + vmcommon.DCTKeyIdentifier)
+	noncePrefix    = []byte(vmcommon.DharitriProtectedKeyPrefix + vmcommon.DCTNFTLatestNonceIdentifier)
+)
+
+// ArgsDCTDataReader defines the arguments needed for the dct data reader
+type ArgsDCTDataReader struct {
+	Accounts    vmcommon.AccountsAdapter
+	Marshalizer vmcommon.Marshalizer
+}
+
+type dctDataReader struct {
+	accounts    vmcommon.AccountsAdapter
+	marshalizer vmcommon.Marshalizer
+}
+
+// NewDCTDataReader returns the component which answers queries over the dct data held by the accounts. The
+// accounts are only fetched with GetExistingAccount and nothing is ever saved, so the component does not keep any
+// state and can be used concurrently
+func NewDCTDataReader(args ArgsDCTDataReader) (*dctDataReader, error) {
+	if check.IfNil(args.Accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+
+	return &dctDataReader{
+		accounts:    args.Accounts,
+		marshalizer: args.Marshalizer,
+	}, nil
+}
+
+// GetFungibleBalance returns the balance of the fungible token held by the address
+func (r *dctDataReader) GetFungibleBalance(address []byte, tokenID []byte) (*big.Int, error) {
+	dctData, _, err := r.getTokenData(address, computeTokenKey(tokenID, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	return dctData.Value, nil
+}
+
+// GetNFTData returns the NFT held by the address, together with its metadata. The metadata is read from the system
+// account if the holder does not keep it
+func (r *dctDataReader) GetNFTData(address []byte, tokenID []byte, nonce uint64) (*dct.DCToken, error) {
+	tokenKey := computeTokenKey(tokenID, nonce)
+	dctData, found, err := r.getTokenData(address, tokenKey)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrNFTDoesNotExist
+	}
+	if nonce == 0 || dctData.TokenMetaData != nil {
+		return dctData, nil
+	}
+
+	dctDataOnSystemAcc, found, err := r.getTokenData(vmcommon.SystemAccountAddress, tokenKey)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		dctData.TokenMetaData = dctDataOnSystemAcc.TokenMetaData
+	}
+
+	return dctData, nil
+}
+
+// IsFrozen returns true if the token is frozen for the address. Nonce 0 checks the freeze of the whole token,
+// while a nonce checks the freeze of that single NFT
+func (r *dctDataReader) IsFrozen(address []byte, tokenID []byte, nonce uint64) (bool, error) {
+	dctData, _, err := r.getTokenData(address, computeTokenKey(tokenID, nonce))
+	if err != nil {
+		return false, err
+	}
+
+	return builtInFunctions.DCTUserMetadataFromBytes(dctData.Properties).Frozen, nil
+}
+
+// IsPaused returns true if the token is paused
+func (r *dctDataReader) IsPaused(tokenID []byte) (bool, error) {
+	globalMetadata, err := r.getGlobalMetadata(tokenID)
+	if err != nil {
+		return false, err
+	}
+
+	return globalMetadata.Paused, nil
+}
+
+// GetRoles returns the roles the address holds for the token
+func (r *dctDataReader) GetRoles(address []byte, tokenID []byte) ([][]byte, error) {
+	marshaledData, err := r.retrieveValue(address, append(append([]byte{}, roleKeyPrefix...), tokenID...))
+	if err != nil {
+		return nil, err
+	}
+
+	roles := &dct.DCTRoles{Roles: make([][]byte, 0)}
+	if len(marshaledData) == 0 {
+		return roles.Roles, nil
+	}
+
+	err = r.marshalizer.Unmarshal(roles, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return roles.Roles, nil
+}
+
+// GetLatestNonce returns the nonce of the last NFT created by the address for the token
+func (r *dctDataReader) GetLatestNonce(address []byte, tokenID []byte) (uint64, error) {
+	nonceBytes, err := r.retrieveValue(address, append(append([]byte{}, noncePrefix...), tokenID...))
+	if err != nil {
+		return 0, err
+	}
+
+	return big.NewInt(0).SetBytes(nonceBytes).Uint64(), nil
+}
+
+func (r *dctDataReader) getGlobalMetadata(tokenID []byte) (builtInFunctions.DCTGlobalMetadata, error) {
+	val, err := r.retrieveValue(vmcommon.SystemAccountAddress, computeTokenKey(tokenID, 0))
+	if err != nil {
+		return builtInFunctions.DCTGlobalMetadata{}, err
+	}
+
+	return builtInFunctions.DCTGlobalMetadataFromBytes(val), nil
+}
+
+// getTokenData returns the token saved under the key. The returned bool is false if there is no such token
+func (r *dctDataReader) getTokenData(address []byte, tokenKey []byte) (*dct.DCToken, bool, error) {
+	dctData := &dct.DCToken{Value: big.NewInt(0), Type: uint32(vmcommon.Fungible)}
+	marshaledData, err := r.retrieveValue(address, tokenKey)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(marshaledData) == 0 {
+		return dctData, false, nil
+	}
+
+	err = r.marshalizer.Unmarshal(dctData, marshaledData)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return dctData, true, nil
+}
+
+// retrieveValue returns the value saved under the key, empty if the account or the key does not exist. Only the
+// account not found error of the accounts adapter means a missing account, any other error is returned
+func (r *dctDataReader) retrieveValue(address []byte, key []byte) ([]byte, error) {
+	account, err := r.accounts.GetExistingAccount(address)
+	if errors.Is(err, vmcommon.ErrAccountNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(account) {
+		return nil, nil
+	}
+
+	userAccount, ok := account.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	return userAccount.AccountDataHandler().RetrieveValue(key)
+}
+
+func computeTokenKey(tokenID []byte, nonce uint64) []byte {
+	tokenKey := append(append([]byte{}, tokenKeyPrefix...), tokenID...)
+	if nonce == 0 {
+		return tokenKey
+	}
+
+	return append(tokenKey, big.NewInt(0).SetUint64(nonce).Bytes()...)
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (r *dctDataReader) IsInterfaceNil() bool {
+	return r == nil
+}
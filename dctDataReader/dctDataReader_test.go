@@ -0,0 +1,197 @@
+package dctDataReader
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/builtInFunctions"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createReadOnlyAccountsStub(t *testing.T, accounts map[string]*mock.Account) *mock.AccountsStub {
+	return &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			account, ok := accounts[string(address)]
+			if !ok {
+				return nil, fmt.Errorf("%w, %s", vmcommon.ErrAccountNotFound, address)
+			}
+			return account, nil
+		},
+		LoadAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+			assert.Fail(t, "should not have loaded an account")
+			return nil, nil
+		},
+		SaveAccountCalled: func(_ vmcommon.AccountHandler) error {
+			assert.Fail(t, "should not have saved an account")
+			return nil
+		},
+	}
+}
+
+func saveToken(t *testing.T, account *mock.Account, tokenKey []byte, token *dct.DCToken) {
+	marshaledData, err := (&mock.MarshalizerMock{}).Marshal(token)
+	require.Nil(t, err)
+	_ = account.SaveKeyValue(tokenKey, marshaledData)
+}
+
+func createReader(t *testing.T, accounts map[string]*mock.Account) *dctDataReader {
+	reader, err := NewDCTDataReader(ArgsDCTDataReader{
+		Accounts:    createReadOnlyAccountsStub(t, accounts),
+		Marshalizer: &mock.MarshalizerMock{},
+	})
+	require.Nil(t, err)
+
+	return reader
+}
+
+func TestNewDCTDataReader(t *testing.T) {
+	t.Parallel()
+
+	reader, err := NewDCTDataReader(ArgsDCTDataReader{Marshalizer: &mock.MarshalizerMock{}})
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+	assert.True(t, check.IfNil(reader))
+
+	reader, err = NewDCTDataReader(ArgsDCTDataReader{Accounts: &mock.AccountsStub{}})
+	assert.Equal(t, ErrNilMarshalizer, err)
+	assert.True(t, check.IfNil(reader))
+
+	var dataReader vmcommon.DCTDataReader
+	dataReader, err = NewDCTDataReader(ArgsDCTDataReader{Accounts: &mock.AccountsStub{}, Marshalizer: &mock.MarshalizerMock{}})
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(dataReader))
+}
+
+func TestDctDataReader_GetFungibleBalance(t *testing.T) {
+	t.Parallel()
+
+	tokenID := []byte("TOKEN-abcdef")
+	holder := mock.NewUserAccount([]byte("holder"))
+	saveToken(t, holder, computeTokenKey(tokenID, 0), &dct.DCToken{Value: big.NewInt(100)})
+	reader := createReader(t, map[string]*mock.Account{"holder": holder})
+
+	balance, err := reader.GetFungibleBalance([]byte("holder"), tokenID)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(100), balance)
+
+	balance, err = reader.GetFungibleBalance([]byte("holder"), []byte("OTHER-abcdef"))
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(0), balance)
+
+	balance, err = reader.GetFungibleBalance([]byte("missing"), tokenID)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(0), balance)
+}
+
+func TestDctDataReader_GetFungibleBalanceAccountsErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("trie error")
+	reader, _ := NewDCTDataReader(ArgsDCTDataReader{
+		Accounts: &mock.AccountsStub{
+			GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				return nil, expectedErr
+			},
+		},
+		Marshalizer: &mock.MarshalizerMock{},
+	})
+
+	balance, err := reader.GetFungibleBalance([]byte("holder"), []byte("TKN-abcdef"))
+	assert.Nil(t, balance)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestDctDataReader_GetNFTData(t *testing.T) {
+	t.Parallel()
+
+	tokenID := []byte("NFT-abcdef")
+	metaData := &dct.MetaData{Nonce: 2, Name: []byte("name"), Creator: []byte("creator")}
+	holder := mock.NewUserAccount([]byte("holder"))
+	systemAccount := mock.NewUserAccount(vmcommon.SystemAccountAddress)
+	saveToken(t, holder, computeTokenKey(tokenID, 1), &dct.DCToken{Value: big.NewInt(1), TokenMetaData: &dct.MetaData{Nonce: 1}})
+	saveToken(t, holder, computeTokenKey(tokenID, 2), &dct.DCToken{Value: big.NewInt(3)})
+	saveToken(t, systemAccount, computeTokenKey(tokenID, 2), &dct.DCToken{TokenMetaData: metaData})
+	reader := createReader(t, map[string]*mock.Account{
+		"holder":                              holder,
+		string(vmcommon.SystemAccountAddress): systemAccount,
+	})
+
+	nft, err := reader.GetNFTData([]byte("holder"), tokenID, 1)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1), nft.TokenMetaData.Nonce)
+
+	nft, err = reader.GetNFTData([]byte("holder"), tokenID, 2)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(3), nft.Value)
+	assert.Equal(t, metaData, nft.TokenMetaData)
+
+	nft, err = reader.GetNFTData([]byte("holder"), tokenID, 3)
+	assert.Equal(t, ErrNFTDoesNotExist, err)
+	assert.Nil(t, nft)
+}
+
+func TestDctDataReader_IsFrozenAndIsPaused(t *testing.T) {
+	t.Parallel()
+
+	tokenID := []byte("TOKEN-abcdef")
+	holder := mock.NewUserAccount([]byte("holder"))
+	systemAccount := mock.NewUserAccount(vmcommon.SystemAccountAddress)
+	frozen := builtInFunctions.DCTUserMetadata{Frozen: true}
+	saveToken(t, holder, computeTokenKey(tokenID, 0), &dct.DCToken{Value: big.NewInt(10), Properties: frozen.ToBytes()})
+	paused := builtInFunctions.DCTGlobalMetadata{Paused: true}
+	_ = systemAccount.SaveKeyValue(computeTokenKey(tokenID, 0), paused.ToBytes())
+	reader := createReader(t, map[string]*mock.Account{
+		"holder":                              holder,
+		string(vmcommon.SystemAccountAddress): systemAccount,
+	})
+
+	isFrozen, err := reader.IsFrozen([]byte("holder"), tokenID, 0)
+	require.Nil(t, err)
+	assert.True(t, isFrozen)
+
+	isFrozen, err = reader.IsFrozen([]byte("other"), tokenID, 0)
+	require.Nil(t, err)
+	assert.False(t, isFrozen)
+
+	isPaused, err := reader.IsPaused(tokenID)
+	require.Nil(t, err)
+	assert.True(t, isPaused)
+
+	isPaused, err = reader.IsPaused([]byte("OTHER-abcdef"))
+	require.Nil(t, err)
+	assert.False(t, isPaused)
+}
+
+func TestDctDataReader_GetRolesAndLatestNonce(t *testing.T) {
+	t.Parallel()
+
+	tokenID := []byte("NFT-abcdef")
+	holder := mock.NewUserAccount([]byte("holder"))
+	roles := &dct.DCTRoles{Roles: [][]byte{[]byte(vmcommon.DCTRoleNFTCreate), []byte(vmcommon.DCTRoleNFTBurn)}}
+	marshaledRoles, _ := (&mock.MarshalizerMock{}).Marshal(roles)
+	_ = holder.SaveKeyValue(append(append([]byte{}, roleKeyPrefix...), tokenID...), marshaledRoles)
+	_ = holder.SaveKeyValue(append(append([]byte{}, noncePrefix...), tokenID...), big.NewInt(7).Bytes())
+	reader := createReader(t, map[string]*mock.Account{"holder": holder})
+
+	readRoles, err := reader.GetRoles([]byte("holder"), tokenID)
+	require.Nil(t, err)
+	assert.Equal(t, roles.Roles, readRoles)
+
+	readRoles, err = reader.GetRoles([]byte("missing"), tokenID)
+	require.Nil(t, err)
+	assert.Empty(t, readRoles)
+
+	nonce, err := reader.GetLatestNonce([]byte("holder"), tokenID)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(7), nonce)
+
+	nonce, err = reader.GetLatestNonce([]byte("holder"), []byte("OTHER-abcdef"))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(0), nonce)
+}
@@ -0,0 +1,15 @@
+package dctDataReader
+
+import "errors"
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter has been provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrWrongTypeAssertion signals that the account is not a user account
+var ErrWrongTypeAssertion = errors.New("wrong type assertion")
+
+// ErrNFTDoesNotExist signals that the account does not hold the requested NFT
+var ErrNFTDoesNotExist = errors.New("NFT does not exist")
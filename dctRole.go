@@ -0,0 +1,34 @@
+package vmcommon
+
+import "sort"
+
+var dctRoles = map[string]struct{}{
+	DCTRoleLocalBurn:           {},
+	DCTRoleLocalMint:           {},
+	DCTRoleNFTCreate:           {},
+	DCTRoleNFTAddQuantity:      {},
+	DCTRoleNFTBurn:             {},
+	DCTRoleNFTAddURI:           {},
+	DCTRoleTransfer:            {},
+	DCTRoleNFTUpdateAttributes: {},
+	DCTRoleModifyRoyalties:     {},
+	DCTRoleSetNewURI:           {},
+	DCTRoleNFTRecreate:         {},
+}
+
+// IsValidDCTRole returns true if the role is one of the known dct roles
+func IsValidDCTRole(role string) bool {
+	_, ok := dctRoles[role]
+	return ok
+}
+
+// GetAllDCTRoles returns all the known dct roles, sorted
+func GetAllDCTRoles() []string {
+	roles := make([]string, 0, len(dctRoles))
+	for role := range dctRoles {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+
+	return roles
+}
@@ -0,0 +1,24 @@
+package vmcommon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsValidDCTRole(t *testing.T) {
+	for _, role := range GetAllDCTRoles() {
+		require.True(t, IsValidDCTRole(role))
+	}
+
+	require.True(t, IsValidDCTRole(DCTRoleLocalMint))
+	require.False(t, IsValidDCTRole(""))
+	require.False(t, IsValidDCTRole("DCTRoleInvalid"))
+}
+
+func TestGetAllDCTRoles(t *testing.T) {
+	roles := GetAllDCTRoles()
+	require.Equal(t, len(dctRoles), len(roles))
+	require.Contains(t, roles, DCTRoleNFTCreate)
+	require.Contains(t, roles, DCTRoleTransfer)
+}
@@ -0,0 +1,47 @@
+package vmcommon
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// String returns the string representation of the dct token type
+func (t DCTType) String() string {
+	switch t {
+	case Fungible:
+		return FungibleDCT
+	case NonFungible:
+		return NonFungibleDCT
+	case SemiFungible:
+		return SemiFungibleDCT
+	case MetaFungible:
+		return MetaDCT
+	case NativeMOA:
+		return NativeMOADCT
+	default:
+		return fmt.Sprintf("unknown dct type: %d", uint32(t))
+	}
+}
+
+// ConvertDCTTypeToUint32 converts the string representation of a dct token type to its numerical value
+func ConvertDCTTypeToUint32(dctType string) (uint32, error) {
+	switch dctType {
+	case FungibleDCT:
+		return uint32(Fungible), nil
+	case NonFungibleDCT:
+		return uint32(NonFungible), nil
+	case SemiFungibleDCT:
+		return uint32(SemiFungible), nil
+	case MetaDCT:
+		return uint32(MetaFungible), nil
+	case NativeMOADCT:
+		return uint32(NativeMOA), nil
+	default:
+		return 0, ErrInvalidDCTType
+	}
+}
+
+// IsMOATokenIdentifier returns true if the token identifier is the reserved one which represents the native MOA value
+func IsMOATokenIdentifier(tokenID []byte) bool {
+	return bytes.Equal(tokenID, []byte(MOATokenIdentifier))
+}
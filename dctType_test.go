@@ -0,0 +1,34 @@
+package vmcommon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDCTType_String(t *testing.T) {
+	require.Equal(t, FungibleDCT, Fungible.String())
+	require.Equal(t, NonFungibleDCT, NonFungible.String())
+	require.Equal(t, SemiFungibleDCT, SemiFungible.String())
+	require.Equal(t, MetaDCT, MetaFungible.String())
+	require.Equal(t, NativeMOADCT, NativeMOA.String())
+	require.Equal(t, "unknown dct type: 10", DCTType(10).String())
+}
+
+func TestConvertDCTTypeToUint32(t *testing.T) {
+	for _, dctType := range []DCTType{Fungible, NonFungible, SemiFungible, MetaFungible, NativeMOA} {
+		value, err := ConvertDCTTypeToUint32(dctType.String())
+		require.Nil(t, err)
+		require.Equal(t, uint32(dctType), value)
+	}
+
+	value, err := ConvertDCTTypeToUint32("invalid")
+	require.Equal(t, ErrInvalidDCTType, err)
+	require.Equal(t, uint32(0), value)
+}
+
+func TestIsMOATokenIdentifier(t *testing.T) {
+	require.True(t, IsMOATokenIdentifier([]byte(MOATokenIdentifier)))
+	require.False(t, IsMOATokenIdentifier([]byte("MOA")))
+	require.False(t, IsMOATokenIdentifier([]byte("TOKEN-000000")))
+}
@@ -4,3 +4,16 @@ import "errors"
 
 // ErrSubtractionOverflow signals that uint64 subtraction overflowed
 var ErrSubtractionOverflow = errors.New("uint64 subtraction overflowed")
+
+// ErrAccountNotFound signals that the account does not exist. GetExistingAccount of the accounts adapter returns it,
+// possibly wrapped, for a missing account
+var ErrAccountNotFound = errors.New("account was not found")
+
+// ErrInvalidDCTType signals that an invalid dct token type was provided
+var ErrInvalidDCTType = errors.New("invalid dct type")
+
+// ErrInvalidSalePrice signals that a nil or negative sale price was provided
+var ErrInvalidSalePrice = errors.New("invalid sale price")
+
+// ErrInvalidRoyalties signals that the royalties are higher than the maximum royalty
+var ErrInvalidRoyalties = errors.New("invalid royalties")
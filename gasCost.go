@@ -28,6 +28,16 @@ type BuiltInCost struct {
 	DCTNFTMultiTransfer     uint64
 	DCTNFTAddURI            uint64
 	DCTNFTUpdateAttributes  uint64
+	DCTNFTSettleSale        uint64
+	DCTModifyRoyalties      uint64
+	DCTSetNewURIs           uint64
+	DCTNFTRecreate          uint64
+	DCTApprove              uint64
+	DCTTransferFrom         uint64
+	DCTLockedTransfer       uint64
+	DCTClaimUnlocked        uint64
+	DCTNFTCreateBatch       uint64
+	DCTMultiDistribute      uint64
 }
 
 // GasCost holds all the needed gas costs for system smart contracts
@@ -36,6 +46,15 @@ type GasCost struct {
 	BuiltInCost       BuiltInCost
 }
 
+// GasTraceEntry is one component of the gas charged by a built in function, computed as units multiplied by rate
+type GasTraceEntry struct {
+	Function  string
+	Component string
+	Units     uint64
+	Rate      uint64
+	Total     uint64
+}
+
 // SafeSubUint64 performs subtraction on uint64 and returns an error if it overflows
 func SafeSubUint64(a, b uint64) (uint64, error) {
 	if a < b {
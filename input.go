@@ -83,6 +83,10 @@ type VMInput struct {
 
 	// ReturnCallAfterError
 	ReturnCallAfterError bool
+
+	// GasTracer is optional. When set, the built in functions report to it every component of the gas they charge,
+	// including the ones which made the call run out of gas
+	GasTracer GasTracer
 }
 
 // DCTTransfer defines the structure for and DCT / NFT transfer
@@ -96,7 +100,7 @@ type DCTTransfer struct {
 	// DCTTokenName is the name of the token which was transferred by the transaction to the SC
 	DCTTokenName []byte
 
-	// DCTTokenType is the type of the transferred token
+	// DCTTokenType is the type of the transferred token, NativeMOA if the transfer carries the native value
 	DCTTokenType uint32
 
 	// DCTTokenNonce is the nonce for the given NFT token
@@ -188,6 +188,42 @@ type DCTPauseHandler interface {
 	IsInterfaceNil() bool
 }
 
+// DCTGlobalSettingsHandler provides global settings functions for an DCT token
+type DCTGlobalSettingsHandler interface {
+	IsPaused(token []byte) bool
+	IsLimitedTransfer(token []byte) bool
+	GetTokenType(token []byte) uint32
+	GetNumDecimals(token []byte) uint32
+	IsInterfaceNil() bool
+}
+
+// DCTSupplyHandler keeps the minted, burned and current supply of an DCT token, as contributed by the current shard.
+// The supply of the token is the sum of the contributions of all the shards. UseMintAllowance enforces the max supply
+// of the token by consuming the mint allowance the dct system smart contract granted to the current shard
+type DCTSupplyHandler interface {
+	UpdateSupply(tokenID []byte, nonce uint64, value *big.Int) error
+	UseMintAllowance(tokenID []byte, value *big.Int) error
+	IsInterfaceNil() bool
+}
+
+// DCTLockedBalanceHandler keeps the DCT amounts which can not be spent before a given epoch
+type DCTLockedBalanceHandler interface {
+	AddLockedBalance(dctData *dct.DCToken, value *big.Int, unlockEpoch uint32) error
+	GetLockedBalance(dctData *dct.DCToken) (*big.Int, error)
+	ClaimUnlocked(dctData *dct.DCToken) (*big.Int, error)
+	GetSpendableAndLockedBalance(acnt UserAccountHandler, tokenID []byte) (*big.Int, *big.Int, error)
+	IsInterfaceNil() bool
+}
+
+// DCTNFTStorageHandler handles the storage of the NFT/SFT tokens held by an account and of their metadata
+type DCTNFTStorageHandler interface {
+	SaveDCTNFTToken(acnt UserAccountHandler, dctTokenKey []byte, nonce uint64, dctData *dct.DCToken, isReturnWithError bool) ([]byte, error)
+	GetDCTNFTTokenOnSender(acnt UserAccountHandler, dctTokenKey []byte, nonce uint64) (*dct.DCToken, error)
+	GetDCTNFTTokenOnDestination(acnt UserAccountHandler, dctTokenKey []byte, nonce uint64) (*dct.DCToken, bool, error)
+	CheckDCTNFTTokenOnDestination(currentDCTData *dct.DCToken, transferredDCTData *dct.DCToken) error
+	IsInterfaceNil() bool
+}
+
 // DCTRoleHandler provides IsAllowedToExecute function for an DCT
 type DCTRoleHandler interface {
 	CheckAllowedToExecute(account UserAccountHandler, tokenID []byte, action []byte) error
@@ -211,7 +247,7 @@ type Coordinator interface {
 }
 
 // AccountsAdapter is used for the structure that manages the accounts on top of a trie.PatriciaMerkleTrie
-// implementation
+// implementation. GetExistingAccount returns an error wrapping ErrAccountNotFound if the account does not exist
 type AccountsAdapter interface {
 	GetExistingAccount(address []byte) (AccountHandler, error)
 	LoadAccount(address []byte) (AccountHandler, error)
@@ -236,6 +272,20 @@ type BuiltinFunction interface {
 	IsInterfaceNil() bool
 }
 
+// BuiltInFunctionPreInterceptor is called before a built in function is executed. Returning an error vetoes the
+// execution of the function
+type BuiltInFunctionPreInterceptor interface {
+	PreProcess(functionName string, acntSnd, acntDst UserAccountHandler, vmInput *ContractCallInput) error
+	IsInterfaceNil() bool
+}
+
+// BuiltInFunctionPostInterceptor is called after a built in function was executed, with the output and the error of
+// the function as returned by the previous post interceptor, and returns the output and the error to be used further
+type BuiltInFunctionPostInterceptor interface {
+	PostProcess(functionName string, acntSnd, acntDst UserAccountHandler, vmInput *ContractCallInput, vmOutput *VMOutput, err error) (*VMOutput, error)
+	IsInterfaceNil() bool
+}
+
 // BuiltInFunctionContainer defines the methods for the built-in protocol container
 type BuiltInFunctionContainer interface {
 	Get(key string) (BuiltinFunction, error)
@@ -270,3 +320,28 @@ type DCTTransferParser interface {
 	ParseDCTTransfers(sndAddr []byte, rcvAddr []byte, function string, args [][]byte) (*ParsedDCTTransfers, error)
 	IsInterfaceNil() bool
 }
+
+// DCTDataReader answers read-only queries over the DCT data held by the accounts
+type DCTDataReader interface {
+	GetFungibleBalance(address []byte, tokenID []byte) (*big.Int, error)
+	GetNFTData(address []byte, tokenID []byte, nonce uint64) (*dct.DCToken, error)
+	IsFrozen(address []byte, tokenID []byte, nonce uint64) (bool, error)
+	IsPaused(tokenID []byte) (bool, error)
+	GetRoles(address []byte, tokenID []byte) ([][]byte, error)
+	GetLatestNonce(address []byte, tokenID []byte) (uint64, error)
+	IsInterfaceNil() bool
+}
+
+// GasTracer receives the components of the gas charged by the built in functions
+type GasTracer interface {
+	TraceGas(entry *GasTraceEntry)
+	IsInterfaceNil() bool
+}
+
+// VersionedBuiltInFunctionContainer is a built-in protocol container which can hold several versions of a function,
+// each one valid for a range of epochs
+type VersionedBuiltInFunctionContainer interface {
+	BuiltInFunctionContainer
+	AddVersion(key string, function BuiltinFunction, activationEpoch uint32, deactivationEpoch uint32) error
+	GetAllVersions(key string) ([]BuiltinFunction, error)
+}
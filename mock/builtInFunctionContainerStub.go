@@ -0,0 +1,67 @@
+package mock
+
+import (
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+)
+
+// BuiltInFunctionContainerStub -
+type BuiltInFunctionContainerStub struct {
+	GetCalled     func(key string) (vmcommon.BuiltinFunction, error)
+	AddCalled     func(key string, function vmcommon.BuiltinFunction) error
+	ReplaceCalled func(key string, function vmcommon.BuiltinFunction) error
+	RemoveCalled  func(key string)
+	LenCalled     func() int
+	KeysCalled    func() map[string]struct{}
+}
+
+// Get -
+func (b *BuiltInFunctionContainerStub) Get(key string) (vmcommon.BuiltinFunction, error) {
+	if b.GetCalled != nil {
+		return b.GetCalled(key)
+	}
+	return nil, nil
+}
+
+// Add -
+func (b *BuiltInFunctionContainerStub) Add(key string, function vmcommon.BuiltinFunction) error {
+	if b.AddCalled != nil {
+		return b.AddCalled(key, function)
+	}
+	return nil
+}
+
+// Replace -
+func (b *BuiltInFunctionContainerStub) Replace(key string, function vmcommon.BuiltinFunction) error {
+	if b.ReplaceCalled != nil {
+		return b.ReplaceCalled(key, function)
+	}
+	return nil
+}
+
+// Remove -
+func (b *BuiltInFunctionContainerStub) Remove(key string) {
+	if b.RemoveCalled != nil {
+		b.RemoveCalled(key)
+	}
+}
+
+// Len -
+func (b *BuiltInFunctionContainerStub) Len() int {
+	if b.LenCalled != nil {
+		return b.LenCalled()
+	}
+	return 0
+}
+
+// Keys -
+func (b *BuiltInFunctionContainerStub) Keys() map[string]struct{} {
+	if b.KeysCalled != nil {
+		return b.KeysCalled()
+	}
+	return make(map[string]struct{})
+}
+
+// IsInterfaceNil -
+func (b *BuiltInFunctionContainerStub) IsInterfaceNil() bool {
+	return b == nil
+}
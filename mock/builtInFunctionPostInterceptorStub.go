@@ -0,0 +1,29 @@
+package mock
+
+import (
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+)
+
+// BuiltInFunctionPostInterceptorStub -
+type BuiltInFunctionPostInterceptorStub struct {
+	PostProcessCalled func(functionName string, acntSnd, acntDst vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput, err error) (*vmcommon.VMOutput, error)
+}
+
+// PostProcess -
+func (b *BuiltInFunctionPostInterceptorStub) PostProcess(
+	functionName string,
+	acntSnd, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+	vmOutput *vmcommon.VMOutput,
+	err error,
+) (*vmcommon.VMOutput, error) {
+	if b.PostProcessCalled != nil {
+		return b.PostProcessCalled(functionName, acntSnd, acntDst, vmInput, vmOutput, err)
+	}
+	return vmOutput, err
+}
+
+// IsInterfaceNil -
+func (b *BuiltInFunctionPostInterceptorStub) IsInterfaceNil() bool {
+	return b == nil
+}
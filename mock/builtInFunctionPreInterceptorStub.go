@@ -0,0 +1,23 @@
+package mock
+
+import (
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+)
+
+// BuiltInFunctionPreInterceptorStub -
+type BuiltInFunctionPreInterceptorStub struct {
+	PreProcessCalled func(functionName string, acntSnd, acntDst vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) error
+}
+
+// PreProcess -
+func (b *BuiltInFunctionPreInterceptorStub) PreProcess(functionName string, acntSnd, acntDst vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) error {
+	if b.PreProcessCalled != nil {
+		return b.PreProcessCalled(functionName, acntSnd, acntDst, vmInput)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (b *BuiltInFunctionPreInterceptorStub) IsInterfaceNil() bool {
+	return b == nil
+}
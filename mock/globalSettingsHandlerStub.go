@@ -0,0 +1,46 @@
+package mock
+
+// GlobalSettingsHandlerStub -
+type GlobalSettingsHandlerStub struct {
+	IsPausedCalled          func(token []byte) bool
+	IsLimitedTransferCalled func(token []byte) bool
+	GetTokenTypeCalled      func(token []byte) uint32
+	GetNumDecimalsCalled    func(token []byte) uint32
+}
+
+// IsPaused -
+func (g *GlobalSettingsHandlerStub) IsPaused(token []byte) bool {
+	if g.IsPausedCalled != nil {
+		return g.IsPausedCalled(token)
+	}
+	return false
+}
+
+// IsLimitedTransfer -
+func (g *GlobalSettingsHandlerStub) IsLimitedTransfer(token []byte) bool {
+	if g.IsLimitedTransferCalled != nil {
+		return g.IsLimitedTransferCalled(token)
+	}
+	return false
+}
+
+// GetTokenType -
+func (g *GlobalSettingsHandlerStub) GetTokenType(token []byte) uint32 {
+	if g.GetTokenTypeCalled != nil {
+		return g.GetTokenTypeCalled(token)
+	}
+	return 0
+}
+
+// GetNumDecimals -
+func (g *GlobalSettingsHandlerStub) GetNumDecimals(token []byte) uint32 {
+	if g.GetNumDecimalsCalled != nil {
+		return g.GetNumDecimalsCalled(token)
+	}
+	return 0
+}
+
+// IsInterfaceNil -
+func (g *GlobalSettingsHandlerStub) IsInterfaceNil() bool {
+	return g == nil
+}
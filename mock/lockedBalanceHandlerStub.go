@@ -0,0 +1,53 @@
+package mock
+
+import (
+	"math/big"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/data/dct"
+)
+
+// LockedBalanceHandlerStub -
+type LockedBalanceHandlerStub struct {
+	AddLockedBalanceCalled             func(dctData *dct.DCToken, value *big.Int, unlockEpoch uint32) error
+	GetLockedBalanceCalled             func(dctData *dct.DCToken) (*big.Int, error)
+	ClaimUnlockedCalled                func(dctData *dct.DCToken) (*big.Int, error)
+	GetSpendableAndLockedBalanceCalled func(acnt vmcommon.UserAccountHandler, tokenID []byte) (*big.Int, *big.Int, error)
+}
+
+// AddLockedBalance -
+func (l *LockedBalanceHandlerStub) AddLockedBalance(dctData *dct.DCToken, value *big.Int, unlockEpoch uint32) error {
+	if l.AddLockedBalanceCalled != nil {
+		return l.AddLockedBalanceCalled(dctData, value, unlockEpoch)
+	}
+	return nil
+}
+
+// GetLockedBalance -
+func (l *LockedBalanceHandlerStub) GetLockedBalance(dctData *dct.DCToken) (*big.Int, error) {
+	if l.GetLockedBalanceCalled != nil {
+		return l.GetLockedBalanceCalled(dctData)
+	}
+	return big.NewInt(0), nil
+}
+
+// ClaimUnlocked -
+func (l *LockedBalanceHandlerStub) ClaimUnlocked(dctData *dct.DCToken) (*big.Int, error) {
+	if l.ClaimUnlockedCalled != nil {
+		return l.ClaimUnlockedCalled(dctData)
+	}
+	return big.NewInt(0), nil
+}
+
+// GetSpendableAndLockedBalance -
+func (l *LockedBalanceHandlerStub) GetSpendableAndLockedBalance(acnt vmcommon.UserAccountHandler, tokenID []byte) (*big.Int, *big.Int, error) {
+	if l.GetSpendableAndLockedBalanceCalled != nil {
+		return l.GetSpendableAndLockedBalanceCalled(acnt, tokenID)
+	}
+	return big.NewInt(0), big.NewInt(0), nil
+}
+
+// IsInterfaceNil -
+func (l *LockedBalanceHandlerStub) IsInterfaceNil() bool {
+	return l == nil
+}
@@ -0,0 +1,30 @@
+package mock
+
+import "math/big"
+
+// SupplyHandlerStub -
+type SupplyHandlerStub struct {
+	UpdateSupplyCalled     func(tokenID []byte, nonce uint64, value *big.Int) error
+	UseMintAllowanceCalled func(tokenID []byte, value *big.Int) error
+}
+
+// UpdateSupply -
+func (s *SupplyHandlerStub) UpdateSupply(tokenID []byte, nonce uint64, value *big.Int) error {
+	if s.UpdateSupplyCalled != nil {
+		return s.UpdateSupplyCalled(tokenID, nonce, value)
+	}
+	return nil
+}
+
+// UseMintAllowance -
+func (s *SupplyHandlerStub) UseMintAllowance(tokenID []byte, value *big.Int) error {
+	if s.UseMintAllowanceCalled != nil {
+		return s.UseMintAllowanceCalled(tokenID, value)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (s *SupplyHandlerStub) IsInterfaceNil() bool {
+	return s == nil
+}
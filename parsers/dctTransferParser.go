@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/atomic"
 	"github.com/Dharitri-org/me-vm-common/check"
 	"github.com/Dharitri-org/me-vm-common/data/dct"
 )
@@ -22,18 +23,38 @@ const MinArgsForMultiDCTNFTTransfer = 4
 const ArgsPerTransfer = 3
 
 type dctTransferParser struct {
-	marshalizer vmcommon.Marshalizer
+	marshalizer            vmcommon.Marshalizer
+	moaTransferEnableEpoch uint32
+	flagMOATransfer        atomic.Flag
 }
 
-// NewDCTTransferParser creates a new dct transfer parser
+// NewDCTTransferParser creates a new dct transfer parser. The native MOA entries of a multi transfer are parsed as
+// such only from the MOA transfer enable epoch, as the multi transfer built-in function does
 func NewDCTTransferParser(
 	marshalizer vmcommon.Marshalizer,
+	moaTransferEnableEpoch uint32,
+	epochNotifier vmcommon.EpochNotifier,
 ) (*dctTransferParser, error) {
 	if check.IfNil(marshalizer) {
 		return nil, ErrNilMarshalizer
 	}
+	if check.IfNil(epochNotifier) {
+		return nil, ErrNilEpochNotifier
+	}
+
+	e := &dctTransferParser{
+		marshalizer:            marshalizer,
+		moaTransferEnableEpoch: moaTransferEnableEpoch,
+		flagMOATransfer:        atomic.Flag{},
+	}
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
 
-	return &dctTransferParser{marshalizer: marshalizer}, nil
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (e *dctTransferParser) EpochConfirmed(epoch uint32, _ uint64) {
+	e.flagMOATransfer.Toggle(epoch >= e.moaTransferEnableEpoch)
 }
 
 // ParseDCTTransfers returns the list of dct transfers, the callFunction and callArgs from the given arguments
@@ -44,7 +65,7 @@ func (e *dctTransferParser) ParseDCTTransfers(
 	args [][]byte,
 ) (*vmcommon.ParsedDCTTransfers, error) {
 	switch function {
-	case vmcommon.BuiltInFunctionDCTTransfer:
+	case vmcommon.BuiltInFunctionDCTTransfer, vmcommon.BuiltInFunctionDCTMintTransfer:
 		return e.parseSingleDCTTransfer(rcvAddr, args)
 	case vmcommon.BuiltInFunctionDCTNFTTransfer:
 		return e.parseSingleDCTNFTTransfer(sndAddr, rcvAddr, args)
@@ -161,6 +182,10 @@ func (e *dctTransferParser) createNewDCTTransfer(
 	args [][]byte,
 	isTxAtSender bool,
 ) (*vmcommon.DCTTransfer, error) {
+	if e.flagMOATransfer.IsSet() && vmcommon.IsMOATokenIdentifier(args[tokenStartIndex]) {
+		return createMOATransfer(tokenStartIndex, args)
+	}
+
 	dctTransfer := &vmcommon.DCTTransfer{
 		DCTValue:      big.NewInt(0).SetBytes(args[tokenStartIndex+2]),
 		DCTTokenName:  args[tokenStartIndex],
@@ -176,12 +201,30 @@ func (e *dctTransferParser) createNewDCTTransfer(
 				return nil, err
 			}
 			dctTransfer.DCTValue.Set(transferDCTData.Value)
+			if transferDCTData.Type != uint32(vmcommon.Fungible) {
+				dctTransfer.DCTTokenType = transferDCTData.Type
+			}
 		}
 	}
 
 	return dctTransfer, nil
 }
 
+// createMOATransfer returns the entry for the native MOA value, which is passed as a plain value on both shards
+func createMOATransfer(tokenStartIndex uint64, args [][]byte) (*vmcommon.DCTTransfer, error) {
+	nonce := big.NewInt(0).SetBytes(args[tokenStartIndex+1])
+	if nonce.Sign() != 0 {
+		return nil, ErrInvalidMOATransfer
+	}
+
+	return &vmcommon.DCTTransfer{
+		DCTValue:      big.NewInt(0).SetBytes(args[tokenStartIndex+2]),
+		DCTTokenName:  args[tokenStartIndex],
+		DCTTokenType:  uint32(vmcommon.NativeMOA),
+		DCTTokenNonce: 0,
+	}, nil
+}
+
 // IsInterfaceNil returns true if underlying object is nil
 func (e *dctTransferParser) IsInterfaceNil() bool {
 	return e == nil
@@ -13,11 +13,15 @@ import (
 func TestNewDCTTransferParser(t *testing.T) {
 	t.Parallel()
 
-	dctParser, err := NewDCTTransferParser(nil)
+	dctParser, err := NewDCTTransferParser(nil, 0, &mock.EpochNotifierStub{})
 	assert.Nil(t, dctParser)
 	assert.Equal(t, err, ErrNilMarshalizer)
 
-	dctParser, err = NewDCTTransferParser(&mock.MarshalizerMock{})
+	dctParser, err = NewDCTTransferParser(&mock.MarshalizerMock{}, 0, nil)
+	assert.Nil(t, dctParser)
+	assert.Equal(t, err, ErrNilEpochNotifier)
+
+	dctParser, err = NewDCTTransferParser(&mock.MarshalizerMock{}, 0, &mock.EpochNotifierStub{})
 	assert.Nil(t, err)
 	assert.False(t, dctParser.IsInterfaceNil())
 }
@@ -25,7 +29,7 @@ func TestNewDCTTransferParser(t *testing.T) {
 func TestDctTransferParser_ParseDCTTransfersWrongFunction(t *testing.T) {
 	t.Parallel()
 
-	dctParser, _ := NewDCTTransferParser(&mock.MarshalizerMock{})
+	dctParser, _ := NewDCTTransferParser(&mock.MarshalizerMock{}, 0, &mock.EpochNotifierStub{})
 	parsedData, err := dctParser.ParseDCTTransfers(nil, nil, "some", nil)
 	assert.Equal(t, err, ErrNotDCTTransferInput)
 	assert.Nil(t, parsedData)
@@ -34,7 +38,7 @@ func TestDctTransferParser_ParseDCTTransfersWrongFunction(t *testing.T) {
 func TestDctTransferParser_ParseSingleDCTFunction(t *testing.T) {
 	t.Parallel()
 
-	dctParser, _ := NewDCTTransferParser(&mock.MarshalizerMock{})
+	dctParser, _ := NewDCTTransferParser(&mock.MarshalizerMock{}, 0, &mock.EpochNotifierStub{})
 	parsedData, err := dctParser.ParseDCTTransfers(
 		nil,
 		[]byte("address"),
@@ -66,12 +70,22 @@ func TestDctTransferParser_ParseSingleDCTFunction(t *testing.T) {
 	assert.Equal(t, len(parsedData.DCTTransfers), 1)
 	assert.Equal(t, len(parsedData.CallArgs), 1)
 	assert.Equal(t, parsedData.CallFunction, "function")
+
+	parsedData, err = dctParser.ParseDCTTransfers(
+		nil,
+		[]byte("address"),
+		vmcommon.BuiltInFunctionDCTMintTransfer,
+		[][]byte{[]byte("one"), big.NewInt(10).Bytes()},
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, len(parsedData.DCTTransfers), 1)
+	assert.Equal(t, parsedData.DCTTransfers[0].DCTValue.Uint64(), big.NewInt(10).Uint64())
 }
 
 func TestDctTransferParser_ParseSingleNFTTransfer(t *testing.T) {
 	t.Parallel()
 
-	dctParser, _ := NewDCTTransferParser(&mock.MarshalizerMock{})
+	dctParser, _ := NewDCTTransferParser(&mock.MarshalizerMock{}, 0, &mock.EpochNotifierStub{})
 	parsedData, err := dctParser.ParseDCTTransfers(
 		nil,
 		[]byte("address"),
@@ -120,7 +134,7 @@ func TestDctTransferParser_ParseSingleNFTTransfer(t *testing.T) {
 func TestDctTransferParser_ParseMultiNFTTransferTransferOne(t *testing.T) {
 	t.Parallel()
 
-	dctParser, _ := NewDCTTransferParser(&mock.MarshalizerMock{})
+	dctParser, _ := NewDCTTransferParser(&mock.MarshalizerMock{}, 0, &mock.EpochNotifierStub{})
 	parsedData, err := dctParser.ParseDCTTransfers(
 		nil,
 		[]byte("address"),
@@ -181,7 +195,7 @@ func TestDctTransferParser_ParseMultiNFTTransferTransferOne(t *testing.T) {
 func TestDctTransferParser_ParseMultiNFTTransferTransferMore(t *testing.T) {
 	t.Parallel()
 
-	dctParser, _ := NewDCTTransferParser(&mock.MarshalizerMock{})
+	dctParser, _ := NewDCTTransferParser(&mock.MarshalizerMock{}, 0, &mock.EpochNotifierStub{})
 	parsedData, err := dctParser.ParseDCTTransfers(
 		[]byte("address"),
 		[]byte("address"),
@@ -247,3 +261,96 @@ func TestDctTransferParser_ParseMultiNFTTransferTransferMore(t *testing.T) {
 	assert.Equal(t, len(parsedData.CallArgs), 1)
 	assert.Equal(t, parsedData.CallFunction, "function")
 }
+
+func TestDctTransferParser_ParseMultiNFTTransferShouldSetTypeFromTransferData(t *testing.T) {
+	t.Parallel()
+
+	dctParser, _ := NewDCTTransferParser(&mock.MarshalizerMock{}, 0, &mock.EpochNotifierStub{})
+	dctData := &dct.DCToken{Value: big.NewInt(20), Type: uint32(vmcommon.MetaFungible)}
+	marshaled, _ := dctParser.marshalizer.Marshal(dctData)
+	parsedData, err := dctParser.ParseDCTTransfers(
+		[]byte("snd"),
+		[]byte("address"),
+		vmcommon.BuiltInFunctionMultiDCTNFTTransfer,
+		[][]byte{big.NewInt(1).Bytes(), []byte("tokenID"), big.NewInt(10).Bytes(), marshaled},
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, len(parsedData.DCTTransfers), 1)
+	assert.Equal(t, parsedData.DCTTransfers[0].DCTTokenType, uint32(vmcommon.MetaFungible))
+	assert.Equal(t, parsedData.DCTTransfers[0].DCTValue.Uint64(), uint64(20))
+
+	dctData.Type = uint32(vmcommon.Fungible)
+	marshaled, _ = dctParser.marshalizer.Marshal(dctData)
+	parsedData, err = dctParser.ParseDCTTransfers(
+		[]byte("snd"),
+		[]byte("address"),
+		vmcommon.BuiltInFunctionMultiDCTNFTTransfer,
+		[][]byte{big.NewInt(1).Bytes(), []byte("tokenID"), big.NewInt(10).Bytes(), marshaled},
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, parsedData.DCTTransfers[0].DCTTokenType, uint32(vmcommon.NonFungible))
+}
+
+func TestDctTransferParser_ParseMultiNFTTransferWithMOA(t *testing.T) {
+	t.Parallel()
+
+	dctParser, _ := NewDCTTransferParser(&mock.MarshalizerMock{}, 0, &mock.EpochNotifierStub{})
+	moa := []byte(vmcommon.MOATokenIdentifier)
+	parsedData, err := dctParser.ParseDCTTransfers(
+		[]byte("address"),
+		[]byte("address"),
+		vmcommon.BuiltInFunctionMultiDCTNFTTransfer,
+		[][]byte{[]byte("dest"), big.NewInt(2).Bytes(), moa, big.NewInt(0).Bytes(), big.NewInt(50).Bytes(), []byte("tokenID"), big.NewInt(0).Bytes(), big.NewInt(20).Bytes()},
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(parsedData.DCTTransfers))
+	assert.Equal(t, moa, parsedData.DCTTransfers[0].DCTTokenName)
+	assert.Equal(t, uint32(vmcommon.NativeMOA), parsedData.DCTTransfers[0].DCTTokenType)
+	assert.Equal(t, uint64(50), parsedData.DCTTransfers[0].DCTValue.Uint64())
+	assert.Equal(t, uint32(vmcommon.Fungible), parsedData.DCTTransfers[1].DCTTokenType)
+
+	// on the destination shard the MOA value is not marshaled token data
+	parsedData, err = dctParser.ParseDCTTransfers(
+		[]byte("snd"),
+		[]byte("address"),
+		vmcommon.BuiltInFunctionMultiDCTNFTTransfer,
+		[][]byte{big.NewInt(1).Bytes(), moa, big.NewInt(0).Bytes(), big.NewInt(50).Bytes()},
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(vmcommon.NativeMOA), parsedData.DCTTransfers[0].DCTTokenType)
+	assert.Equal(t, uint64(50), parsedData.DCTTransfers[0].DCTValue.Uint64())
+
+	parsedData, err = dctParser.ParseDCTTransfers(
+		[]byte("snd"),
+		[]byte("address"),
+		vmcommon.BuiltInFunctionMultiDCTNFTTransfer,
+		[][]byte{big.NewInt(1).Bytes(), moa, big.NewInt(1).Bytes(), big.NewInt(50).Bytes()},
+	)
+	assert.Equal(t, ErrInvalidMOATransfer, err)
+	assert.Nil(t, parsedData)
+}
+
+func TestDctTransferParser_ParseMultiNFTTransferWithMOABeforeEnableEpoch(t *testing.T) {
+	t.Parallel()
+
+	dctParser, _ := NewDCTTransferParser(&mock.MarshalizerMock{}, 1, &mock.EpochNotifierStub{})
+	moa := []byte(vmcommon.MOATokenIdentifier)
+	parsedData, err := dctParser.ParseDCTTransfers(
+		[]byte("address"),
+		[]byte("address"),
+		vmcommon.BuiltInFunctionMultiDCTNFTTransfer,
+		[][]byte{[]byte("dest"), big.NewInt(1).Bytes(), moa, big.NewInt(0).Bytes(), big.NewInt(50).Bytes()},
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(vmcommon.Fungible), parsedData.DCTTransfers[0].DCTTokenType)
+
+	dctParser.EpochConfirmed(1, 0)
+	parsedData, err = dctParser.ParseDCTTransfers(
+		[]byte("address"),
+		[]byte("address"),
+		vmcommon.BuiltInFunctionMultiDCTNFTTransfer,
+		[][]byte{[]byte("dest"), big.NewInt(1).Bytes(), moa, big.NewInt(0).Bytes(), big.NewInt(50).Bytes()},
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(vmcommon.NativeMOA), parsedData.DCTTransfers[0].DCTTokenType)
+}
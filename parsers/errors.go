@@ -33,3 +33,9 @@ var ErrNotEnoughArguments = errors.New("not enough arguments")
 
 // ErrNilMarshalizer signals that marshalizer is nil
 var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrInvalidMOATransfer signals that the native MOA entry of a multi transfer is invalid
+var ErrInvalidMOATransfer = errors.New("invalid MOA transfer")
+
+// ErrNilEpochNotifier signals that a nil epoch notifier has been provided
+var ErrNilEpochNotifier = errors.New("nil epoch notifier")
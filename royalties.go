@@ -0,0 +1,21 @@
+package vmcommon
+
+import "math/big"
+
+// ComputeRoyalties splits the sale price into the creator royalty and the seller proceeds. The royalties are
+// expressed in basis points of MaxRoyalty. The royalty is rounded down, so the seller receives the remainder and
+// royalty + proceeds is always equal to the price
+func ComputeRoyalties(price *big.Int, royalties uint32) (*big.Int, *big.Int, error) {
+	if price == nil || price.Sign() < 0 {
+		return nil, nil, ErrInvalidSalePrice
+	}
+	if royalties > MaxRoyalty {
+		return nil, nil, ErrInvalidRoyalties
+	}
+
+	royalty := big.NewInt(0).Mul(price, big.NewInt(0).SetUint64(uint64(royalties)))
+	royalty.Quo(royalty, big.NewInt(0).SetUint64(uint64(MaxRoyalty)))
+	proceeds := big.NewInt(0).Sub(price, royalty)
+
+	return royalty, proceeds, nil
+}
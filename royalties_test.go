@@ -0,0 +1,54 @@
+package vmcommon
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeRoyalties_InvalidArguments(t *testing.T) {
+	royalty, proceeds, err := ComputeRoyalties(nil, 100)
+	require.Equal(t, ErrInvalidSalePrice, err)
+	require.Nil(t, royalty)
+	require.Nil(t, proceeds)
+
+	_, _, err = ComputeRoyalties(big.NewInt(-1), 100)
+	require.Equal(t, ErrInvalidSalePrice, err)
+
+	_, _, err = ComputeRoyalties(big.NewInt(100), MaxRoyalty+1)
+	require.Equal(t, ErrInvalidRoyalties, err)
+}
+
+func TestComputeRoyalties(t *testing.T) {
+	testCases := []struct {
+		price     int64
+		royalties uint32
+		royalty   int64
+		proceeds  int64
+	}{
+		{price: 0, royalties: 500, royalty: 0, proceeds: 0},
+		{price: 1000, royalties: 0, royalty: 0, proceeds: 1000},
+		{price: 1000, royalties: 250, royalty: 25, proceeds: 975},
+		{price: 1000, royalties: MaxRoyalty, royalty: 1000, proceeds: 0},
+		{price: 999, royalties: 1000, royalty: 99, proceeds: 900},
+		{price: 1, royalties: 9999, royalty: 0, proceeds: 1},
+	}
+
+	for _, tc := range testCases {
+		royalty, proceeds, err := ComputeRoyalties(big.NewInt(tc.price), tc.royalties)
+		require.Nil(t, err)
+		require.Equal(t, 0, big.NewInt(tc.royalty).Cmp(royalty))
+		require.Equal(t, 0, big.NewInt(tc.proceeds).Cmp(proceeds))
+	}
+}
+
+func TestComputeRoyalties_LargePriceShouldNotLoseValue(t *testing.T) {
+	price, _ := big.NewInt(0).SetString("123456789012345678901234567890", 10)
+	royalty, proceeds, err := ComputeRoyalties(price, 333)
+	require.Nil(t, err)
+
+	expectedRoyalty, _ := big.NewInt(0).SetString("4111111074111111107411111110", 10)
+	require.Equal(t, 0, expectedRoyalty.Cmp(royalty))
+	require.Equal(t, 0, price.Cmp(big.NewInt(0).Add(royalty, proceeds)))
+}
@@ -0,0 +1,176 @@
+package simulation
+
+import (
+	"sync"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+)
+
+// accountsOverlay is an accounts adapter which hands out overlay accounts and never saves anything into the wrapped
+// accounts adapter. The same overlay account is returned for an address until the overlay is reset
+type accountsOverlay struct {
+	accounts    vmcommon.AccountsAdapter
+	mutAccounts sync.Mutex
+	loaded      map[string]*overlayAccount
+	loadOrder   []*overlayAccount
+	removed     map[string]struct{}
+}
+
+// NewAccountsOverlay creates a copy on write overlay over the provided accounts adapter
+func NewAccountsOverlay(accounts vmcommon.AccountsAdapter) (*accountsOverlay, error) {
+	if check.IfNil(accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	o := &accountsOverlay{
+		accounts: accounts,
+	}
+	o.Reset()
+
+	return o, nil
+}
+
+// Reset drops all the writes recorded so far
+func (o *accountsOverlay) Reset() {
+	o.mutAccounts.Lock()
+	o.loaded = make(map[string]*overlayAccount)
+	o.loadOrder = make([]*overlayAccount, 0)
+	o.removed = make(map[string]struct{})
+	o.mutAccounts.Unlock()
+}
+
+// GetExistingAccount returns the overlay over an existing account
+func (o *accountsOverlay) GetExistingAccount(address []byte) (vmcommon.AccountHandler, error) {
+	return o.getAccount(address, o.accounts.GetExistingAccount)
+}
+
+// LoadAccount returns the overlay over an existing or a new account
+func (o *accountsOverlay) LoadAccount(address []byte) (vmcommon.AccountHandler, error) {
+	return o.getAccount(address, o.accounts.LoadAccount)
+}
+
+func (o *accountsOverlay) getAccount(
+	address []byte,
+	getHandler func(address []byte) (vmcommon.AccountHandler, error),
+) (vmcommon.AccountHandler, error) {
+	o.mutAccounts.Lock()
+	defer o.mutAccounts.Unlock()
+
+	if _, ok := o.removed[string(address)]; ok {
+		return nil, ErrAccountRemoved
+	}
+	account, ok := o.loaded[string(address)]
+	if ok {
+		return account, nil
+	}
+
+	original, err := getHandler(address)
+	if err != nil {
+		return nil, err
+	}
+	userAccount, ok := original.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	account = newOverlayAccount(userAccount)
+	o.loaded[string(address)] = account
+	o.loadOrder = append(o.loadOrder, account)
+
+	return account, nil
+}
+
+// SaveAccount accepts only the accounts handed out by the overlay. The writes are already kept inside them
+func (o *accountsOverlay) SaveAccount(account vmcommon.AccountHandler) error {
+	if check.IfNil(account) {
+		return ErrUnknownAccount
+	}
+
+	o.mutAccounts.Lock()
+	defer o.mutAccounts.Unlock()
+
+	loaded, ok := o.loaded[string(account.AddressBytes())]
+	if !ok || loaded != account {
+		return ErrUnknownAccount
+	}
+
+	return nil
+}
+
+// RemoveAccount records the removal inside the overlay
+func (o *accountsOverlay) RemoveAccount(address []byte) error {
+	o.mutAccounts.Lock()
+	o.removed[string(address)] = struct{}{}
+	delete(o.loaded, string(address))
+	o.mutAccounts.Unlock()
+
+	return nil
+}
+
+// Commit is not permitted on the overlay
+func (o *accountsOverlay) Commit() ([]byte, error) {
+	return nil, ErrOperationNotPermitted
+}
+
+// JournalLen returns the journal length of the wrapped accounts adapter
+func (o *accountsOverlay) JournalLen() int {
+	return o.accounts.JournalLen()
+}
+
+// RevertToSnapshot is not permitted on the overlay
+func (o *accountsOverlay) RevertToSnapshot(_ int) error {
+	return ErrOperationNotPermitted
+}
+
+// GetNumCheckpoints returns the number of checkpoints of the wrapped accounts adapter
+func (o *accountsOverlay) GetNumCheckpoints() uint32 {
+	return o.accounts.GetNumCheckpoints()
+}
+
+// GetCode returns the code from the wrapped accounts adapter
+func (o *accountsOverlay) GetCode(codeHash []byte) []byte {
+	return o.accounts.GetCode(codeHash)
+}
+
+// RootHash returns the root hash of the wrapped accounts adapter, which is not changed by the overlay
+func (o *accountsOverlay) RootHash() ([]byte, error) {
+	return o.accounts.RootHash()
+}
+
+// RecreateTrie is not permitted on the overlay
+func (o *accountsOverlay) RecreateTrie(_ []byte) error {
+	return ErrOperationNotPermitted
+}
+
+// changes returns all the storage and balance changes recorded on the accounts which were not removed
+func (o *accountsOverlay) changes() ([]*StorageChange, []*BalanceChange, error) {
+	o.mutAccounts.Lock()
+	defer o.mutAccounts.Unlock()
+
+	storageChanges := make([]*StorageChange, 0)
+	balanceChanges := make([]*BalanceChange, 0)
+	for _, account := range o.loadOrder {
+		if _, ok := o.removed[string(account.AddressBytes())]; ok {
+			continue
+		}
+
+		accountChanges, err := account.storageChanges()
+		if err != nil {
+			return nil, nil, err
+		}
+		storageChanges = append(storageChanges, accountChanges...)
+
+		balanceChange := account.balanceChange()
+		if balanceChange != nil {
+			balanceChanges = append(balanceChanges, balanceChange)
+		}
+	}
+
+	return storageChanges, balanceChanges, nil
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (o *accountsOverlay) IsInterfaceNil() bool {
+	return o == nil
+}
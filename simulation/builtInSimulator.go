@@ -0,0 +1,160 @@
+package simulation
+
+import (
+	"math/big"
+	"sync"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/builtInFunctions"
+	"github.com/Dharitri-org/me-vm-common/check"
+)
+
+// StorageChange is a storage write which the simulated call would do
+type StorageChange struct {
+	Address  []byte
+	Key      []byte
+	OldValue []byte
+	NewValue []byte
+}
+
+// BalanceChange is a balance change which the simulated call would do
+type BalanceChange struct {
+	Address    []byte
+	OldBalance *big.Int
+	NewBalance *big.Int
+}
+
+// SimulationResult holds the outcome of a simulated built in function call
+type SimulationResult struct {
+	VMOutput       *vmcommon.VMOutput
+	GasConsumed    uint64
+	StorageChanges []*StorageChange
+	BalanceChanges []*BalanceChange
+}
+
+// ArgsBuiltInSimulator defines the arguments needed for the built in function simulator. The container arguments hold
+// the real accounts adapter, which the simulator replaces with its overlay before creating the built in functions
+type ArgsBuiltInSimulator struct {
+	ContainerArgs builtInFunctions.ArgsCreateBuiltInFunctionContainer
+}
+
+type builtInSimulator struct {
+	mutSimulation    sync.Mutex
+	overlay          *accountsOverlay
+	shardCoordinator vmcommon.Coordinator
+	builtInFunctions vmcommon.BuiltInFunctionContainer
+}
+
+// NewBuiltInSimulator creates the component which runs built in functions over a copy on write overlay of the
+// accounts. The simulator creates its own built in functions container over the overlay, so that every account the
+// built in functions load, the system account included, is overlaid as well
+func NewBuiltInSimulator(args ArgsBuiltInSimulator) (*builtInSimulator, error) {
+	if check.IfNil(args.ContainerArgs.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+
+	overlay, err := NewAccountsOverlay(args.ContainerArgs.Accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	containerArgs := args.ContainerArgs
+	containerArgs.Accounts = overlay
+	factory, err := builtInFunctions.NewBuiltInFunctionsFactory(containerArgs)
+	if err != nil {
+		return nil, err
+	}
+	container, err := factory.CreateBuiltInFunctionContainer()
+	if err != nil {
+		return nil, err
+	}
+
+	return &builtInSimulator{
+		overlay:          overlay,
+		shardCoordinator: args.ContainerArgs.ShardCoordinator,
+		builtInFunctions: container,
+	}, nil
+}
+
+// Simulate runs the built in function named by the vm input without changing the real state. A failing call is not
+// reported as an error, but as a result with the SimulateFailed return code which consumes the gas the built in
+// function charged before failing
+func (s *builtInSimulator) Simulate(vmInput *vmcommon.ContractCallInput) (*SimulationResult, error) {
+	if vmInput == nil {
+		return nil, ErrNilVmInput
+	}
+
+	builtInFunc, err := s.builtInFunctions.Get(vmInput.Function)
+	if err != nil {
+		return nil, err
+	}
+	if !builtInFunc.IsActive() {
+		return nil, ErrInactiveBuiltInFunction
+	}
+
+	s.mutSimulation.Lock()
+	defer s.mutSimulation.Unlock()
+
+	s.overlay.Reset()
+	defer s.overlay.Reset()
+
+	acntSnd, err := s.loadAccountInShard(vmInput.CallerAddr)
+	if err != nil {
+		return nil, err
+	}
+	acntDst, err := s.loadAccountInShard(vmInput.RecipientAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	gasCounter := newGasCounter(vmInput.GasTracer)
+	simulatedInput := *vmInput
+	simulatedInput.GasTracer = gasCounter
+
+	vmOutput, err := builtInFunc.ProcessBuiltinFunction(acntSnd, acntDst, &simulatedInput)
+	if err != nil {
+		return &SimulationResult{
+			VMOutput: &vmcommon.VMOutput{
+				ReturnCode:    vmcommon.SimulateFailed,
+				ReturnMessage: err.Error(),
+			},
+			GasConsumed:    gasCounter.consumed(vmInput.GasProvided),
+			StorageChanges: make([]*StorageChange, 0),
+			BalanceChanges: make([]*BalanceChange, 0),
+		}, nil
+	}
+
+	gasConsumed, err := vmcommon.SafeSubUint64(vmInput.GasProvided, vmOutput.GasRemaining)
+	if err != nil {
+		return nil, err
+	}
+	storageChanges, balanceChanges, err := s.overlay.changes()
+	if err != nil {
+		return nil, err
+	}
+
+	return &SimulationResult{
+		VMOutput:       vmOutput,
+		GasConsumed:    gasConsumed,
+		StorageChanges: storageChanges,
+		BalanceChanges: balanceChanges,
+	}, nil
+}
+
+func (s *builtInSimulator) loadAccountInShard(address []byte) (vmcommon.UserAccountHandler, error) {
+	if len(address) == 0 || s.shardCoordinator.ComputeId(address) != s.shardCoordinator.SelfId() {
+		return nil, nil
+	}
+
+	account, err := s.overlay.LoadAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return account.(vmcommon.UserAccountHandler), nil
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (s *builtInSimulator) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,239 @@
+package simulation
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/builtInFunctions"
+	"github.com/Dharitri-org/me-vm-common/check"
+	"github.com/Dharitri-org/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createRealAccountsStub(t *testing.T, accounts map[string]*mock.Account) *mock.AccountsStub {
+	return &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			account, ok := accounts[string(address)]
+			if !ok {
+				return mock.NewUserAccount(address), nil
+			}
+			return account, nil
+		},
+		SaveAccountCalled: func(_ vmcommon.AccountHandler) error {
+			assert.Fail(t, "should not have saved into the real accounts")
+			return nil
+		},
+	}
+}
+
+func createGasScheduleMap(value uint64) map[string]map[string]uint64 {
+	createSection := func(structure interface{}) map[string]uint64 {
+		section := make(map[string]uint64)
+		structType := reflect.TypeOf(structure)
+		for i := 0; i < structType.NumField(); i++ {
+			section[structType.Field(i).Name] = value
+		}
+		return section
+	}
+
+	return map[string]map[string]uint64{
+		vmcommon.BaseOperationCostString: createSection(vmcommon.BaseOperationCost{}),
+		vmcommon.BuiltInCostString:       createSection(vmcommon.BuiltInCost{}),
+	}
+}
+
+func createSimulatorArgs(accounts vmcommon.AccountsAdapter) ArgsBuiltInSimulator {
+	gasMap := createGasScheduleMap(1)
+	gasMap[vmcommon.BuiltInCostString]["SaveKeyValue"] = 10
+	gasMap[vmcommon.BuiltInCostString]["ClaimDeveloperRewards"] = 5
+
+	return ArgsBuiltInSimulator{
+		ContainerArgs: builtInFunctions.ArgsCreateBuiltInFunctionContainer{
+			GasMap:           gasMap,
+			MapDNSAddresses:  make(map[string]struct{}),
+			Marshalizer:      &mock.MarshalizerMock{},
+			Accounts:         accounts,
+			ShardCoordinator: mock.NewMultipleShardsCoordinatorMock(),
+			EpochNotifier:    &mock.EpochNotifierStub{},
+		},
+	}
+}
+
+func createSimulator(t *testing.T, accounts map[string]*mock.Account) *builtInSimulator {
+	simulator, err := NewBuiltInSimulator(createSimulatorArgs(createRealAccountsStub(t, accounts)))
+	require.Nil(t, err)
+
+	return simulator
+}
+
+func TestNewBuiltInSimulator(t *testing.T) {
+	t.Parallel()
+
+	simulator, err := NewBuiltInSimulator(createSimulatorArgs(nil))
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+	assert.True(t, check.IfNil(simulator))
+
+	args := createSimulatorArgs(&mock.AccountsStub{})
+	args.ContainerArgs.ShardCoordinator = nil
+	simulator, err = NewBuiltInSimulator(args)
+	assert.Equal(t, ErrNilShardCoordinator, err)
+	assert.True(t, check.IfNil(simulator))
+
+	args = createSimulatorArgs(&mock.AccountsStub{})
+	args.ContainerArgs.Marshalizer = nil
+	simulator, err = NewBuiltInSimulator(args)
+	assert.Equal(t, builtInFunctions.ErrNilMarshalizer, err)
+	assert.True(t, check.IfNil(simulator))
+
+	simulator, err = NewBuiltInSimulator(createSimulatorArgs(&mock.AccountsStub{}))
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(simulator))
+}
+
+func TestBuiltInSimulator_SimulateRecordsStorageChanges(t *testing.T) {
+	t.Parallel()
+
+	addr := []byte("addr")
+	realAccount := mock.NewUserAccount(addr)
+	_ = realAccount.SaveKeyValue([]byte("key1"), []byte("old"))
+	_ = realAccount.SaveKeyValue([]byte("key2"), []byte("same"))
+	simulator := createSimulator(t, map[string]*mock.Account{"addr": realAccount})
+
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  addr,
+			GasProvided: 100,
+			CallValue:   big.NewInt(0),
+			Arguments:   [][]byte{[]byte("key1"), []byte("new value"), []byte("key2"), []byte("same")},
+		},
+		RecipientAddr: addr,
+		Function:      vmcommon.BuiltInFunctionSaveKeyValue,
+	}
+
+	result, err := simulator.Simulate(vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, result.VMOutput.ReturnCode)
+	assert.Equal(t, uint64(100)-result.VMOutput.GasRemaining, result.GasConsumed)
+	assert.Equal(t, []*StorageChange{
+		{Address: addr, Key: []byte("key1"), OldValue: []byte("old"), NewValue: []byte("new value")},
+	}, result.StorageChanges)
+	assert.Empty(t, result.BalanceChanges)
+
+	value, _ := realAccount.RetrieveValue([]byte("key1"))
+	assert.Equal(t, []byte("old"), value)
+
+	result, err = simulator.Simulate(vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, 1, len(result.StorageChanges))
+}
+
+func TestBuiltInSimulator_SimulateRecordsBalanceChangesAndFailures(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	contract := []byte("contract")
+	realContract := mock.NewUserAccount(contract)
+	realContract.OwnerAddress = owner
+	realContract.DeveloperReward = big.NewInt(50)
+	simulator := createSimulator(t, map[string]*mock.Account{"contract": realContract})
+
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  owner,
+			GasProvided: 20,
+			CallValue:   big.NewInt(0),
+		},
+		RecipientAddr: contract,
+		Function:      vmcommon.BuiltInFunctionClaimDeveloperRewards,
+	}
+
+	result, err := simulator.Simulate(vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, result.VMOutput.ReturnCode)
+	assert.Equal(t, uint64(5), result.GasConsumed)
+	require.Equal(t, 1, len(result.VMOutput.OutputAccounts[string(owner)].OutputTransfers))
+	assert.Equal(t, big.NewInt(50), realContract.DeveloperReward)
+
+	vmInput.CallerAddr = []byte("other")
+	result, err = simulator.Simulate(vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, vmcommon.SimulateFailed, result.VMOutput.ReturnCode)
+	assert.Equal(t, builtInFunctions.ErrOperationNotPermitted.Error(), result.VMOutput.ReturnMessage)
+	assert.Equal(t, uint64(0), result.GasConsumed, "failed before charging any gas")
+
+	vmInput.CallerAddr = owner
+	vmInput.GasProvided = 3
+	result, err = simulator.Simulate(vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, builtInFunctions.ErrNotEnoughGas.Error(), result.VMOutput.ReturnMessage)
+	assert.Equal(t, uint64(3), result.GasConsumed)
+
+	vmInput.Function = "unknown"
+	result, err = simulator.Simulate(vmInput)
+	assert.NotNil(t, err)
+	assert.Nil(t, result)
+}
+
+func TestBuiltInSimulator_SimulateOverlaysTheSystemAccount(t *testing.T) {
+	t.Parallel()
+
+	simulator := createSimulator(t, make(map[string]*mock.Account))
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: vmcommon.DCTSCAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  [][]byte{[]byte("token"), big.NewInt(100).Bytes()},
+		},
+		RecipientAddr: vmcommon.SystemAccountAddress,
+		Function:      vmcommon.BuiltInFunctionDCTSetMaxSupply,
+	}
+
+	result, err := simulator.Simulate(vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, result.VMOutput.ReturnCode)
+	require.Equal(t, 1, len(result.StorageChanges))
+	assert.Equal(t, vmcommon.SystemAccountAddress, result.StorageChanges[0].Address)
+	assert.Equal(t, big.NewInt(100).Bytes(), result.StorageChanges[0].NewValue)
+}
+
+func TestAccountsOverlay_NeverWritesIntoTheRealAccounts(t *testing.T) {
+	t.Parallel()
+
+	addr := []byte("addr")
+	realAccount := mock.NewUserAccount(addr)
+	realAccount.Balance = big.NewInt(10)
+	overlay, err := NewAccountsOverlay(createRealAccountsStub(t, map[string]*mock.Account{"addr": realAccount}))
+	require.Nil(t, err)
+
+	account, err := overlay.LoadAccount(addr)
+	require.Nil(t, err)
+	userAccount := account.(vmcommon.UserAccountHandler)
+	assert.Nil(t, userAccount.AddToBalance(big.NewInt(-4)))
+	assert.Equal(t, ErrInsufficientFunds, userAccount.AddToBalance(big.NewInt(-7)))
+	_ = userAccount.AccountDataHandler().SaveKeyValue([]byte("key"), []byte("value"))
+	assert.Nil(t, overlay.SaveAccount(account))
+
+	sameAccount, _ := overlay.GetExistingAccount(addr)
+	assert.True(t, account == sameAccount)
+	assert.Equal(t, ErrUnknownAccount, overlay.SaveAccount(mock.NewUserAccount(addr)))
+
+	_, balanceChanges, _ := overlay.changes()
+	assert.Equal(t, []*BalanceChange{{Address: addr, OldBalance: big.NewInt(10), NewBalance: big.NewInt(6)}}, balanceChanges)
+	assert.Equal(t, big.NewInt(10), realAccount.Balance)
+	assert.Empty(t, realAccount.Storage)
+
+	_, err = overlay.Commit()
+	assert.Equal(t, ErrOperationNotPermitted, err)
+	assert.Equal(t, ErrOperationNotPermitted, overlay.RecreateTrie(nil))
+
+	assert.Nil(t, overlay.RemoveAccount(addr))
+	_, err = overlay.LoadAccount(addr)
+	assert.Equal(t, ErrAccountRemoved, err)
+
+	overlay.Reset()
+	account, _ = overlay.LoadAccount(addr)
+	assert.Equal(t, big.NewInt(10), account.(vmcommon.UserAccountHandler).GetBalance())
+}
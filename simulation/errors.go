@@ -0,0 +1,38 @@
+package simulation
+
+import (
+	"errors"
+	"fmt"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+)
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter has been provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrInactiveBuiltInFunction signals that the built in function is not active in the current epoch
+var ErrInactiveBuiltInFunction = errors.New("built in function is not active")
+
+// ErrNilVmInput signals that a nil vm input has been provided
+var ErrNilVmInput = errors.New("nil vm input")
+
+// ErrWrongTypeAssertion signals that the account is not a user account
+var ErrWrongTypeAssertion = errors.New("wrong type assertion")
+
+// ErrUnknownAccount signals that the account was not loaded through the overlay
+var ErrUnknownAccount = errors.New("account was not loaded through the overlay")
+
+// ErrAccountRemoved signals that the account was removed during the simulation
+var ErrAccountRemoved = fmt.Errorf("%w, removed during the simulation", vmcommon.ErrAccountNotFound)
+
+// ErrOperationNotPermitted signals that the operation would change the real state
+var ErrOperationNotPermitted = errors.New("operation not permitted during simulation")
+
+// ErrInsufficientFunds signals that the balance would become negative
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
+// ErrInvalidAddressLength signals that the new owner address does not have the expected length
+var ErrInvalidAddressLength = errors.New("invalid address length")
@@ -0,0 +1,51 @@
+package simulation
+
+import (
+	"sync"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+	"github.com/Dharitri-org/me-vm-common/check"
+)
+
+// gasCounter sums the gas components traced by a simulated built in function and forwards them to the gas tracer of
+// the caller, if any. A built in function which fails returns no output, so the traced gas is the only record of the
+// gas it charged before failing
+type gasCounter struct {
+	mutTotal sync.Mutex
+	total    uint64
+	tracer   vmcommon.GasTracer
+}
+
+func newGasCounter(tracer vmcommon.GasTracer) *gasCounter {
+	return &gasCounter{
+		tracer: tracer,
+	}
+}
+
+// TraceGas adds the gas component to the total
+func (g *gasCounter) TraceGas(entry *vmcommon.GasTraceEntry) {
+	g.mutTotal.Lock()
+	g.total += entry.Total
+	g.mutTotal.Unlock()
+
+	if !check.IfNil(g.tracer) {
+		g.tracer.TraceGas(entry)
+	}
+}
+
+// consumed returns the traced gas, which can not be more than the provided gas
+func (g *gasCounter) consumed(gasProvided uint64) uint64 {
+	g.mutTotal.Lock()
+	defer g.mutTotal.Unlock()
+
+	if g.total > gasProvided {
+		return gasProvided
+	}
+
+	return g.total
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (g *gasCounter) IsInterfaceNil() bool {
+	return g == nil
+}
@@ -0,0 +1,209 @@
+package simulation
+
+import (
+	"bytes"
+	"math/big"
+
+	vmcommon "github.com/Dharitri-org/me-vm-common"
+)
+
+// overlayAccount keeps all the writes done on a user account in memory, reading the untouched data from the
+// wrapped account which is never changed
+type overlayAccount struct {
+	original        vmcommon.UserAccountHandler
+	storage         map[string][]byte
+	storageKeys     [][]byte
+	balance         *big.Int
+	developerReward *big.Int
+	ownerAddress    []byte
+	userName        []byte
+	nonce           uint64
+}
+
+func newOverlayAccount(original vmcommon.UserAccountHandler) *overlayAccount {
+	return &overlayAccount{
+		original:        original,
+		storage:         make(map[string][]byte),
+		storageKeys:     make([][]byte, 0),
+		balance:         copyOrZero(original.GetBalance()),
+		developerReward: copyOrZero(original.GetDeveloperReward()),
+		ownerAddress:    original.GetOwnerAddress(),
+		userName:        original.GetUserName(),
+		nonce:           original.GetNonce(),
+	}
+}
+
+// AddressBytes returns the address of the wrapped account
+func (a *overlayAccount) AddressBytes() []byte {
+	return a.original.AddressBytes()
+}
+
+// IncreaseNonce increases the nonce inside the overlay
+func (a *overlayAccount) IncreaseNonce(nonce uint64) {
+	a.nonce += nonce
+}
+
+// GetNonce returns the nonce as seen inside the overlay
+func (a *overlayAccount) GetNonce() uint64 {
+	return a.nonce
+}
+
+// GetCodeMetadata returns the code metadata of the wrapped account
+func (a *overlayAccount) GetCodeMetadata() []byte {
+	return a.original.GetCodeMetadata()
+}
+
+// GetCodeHash returns the code hash of the wrapped account
+func (a *overlayAccount) GetCodeHash() []byte {
+	return a.original.GetCodeHash()
+}
+
+// GetRootHash returns the root hash of the wrapped account
+func (a *overlayAccount) GetRootHash() []byte {
+	return a.original.GetRootHash()
+}
+
+// AccountDataHandler returns the overlay over the account storage
+func (a *overlayAccount) AccountDataHandler() vmcommon.AccountDataHandler {
+	return a
+}
+
+// RetrieveValue returns the value written inside the overlay or, if there is none, the one of the wrapped account
+func (a *overlayAccount) RetrieveValue(key []byte) ([]byte, error) {
+	value, ok := a.storage[string(key)]
+	if ok {
+		return value, nil
+	}
+
+	return a.original.AccountDataHandler().RetrieveValue(key)
+}
+
+// SaveKeyValue records the value inside the overlay
+func (a *overlayAccount) SaveKeyValue(key []byte, value []byte) error {
+	if _, ok := a.storage[string(key)]; !ok {
+		a.storageKeys = append(a.storageKeys, append([]byte{}, key...))
+	}
+	a.storage[string(key)] = append([]byte{}, value...)
+
+	return nil
+}
+
+// AddToBalance changes the balance inside the overlay
+func (a *overlayAccount) AddToBalance(value *big.Int) error {
+	newBalance := big.NewInt(0).Add(a.balance, value)
+	if newBalance.Sign() < 0 {
+		return ErrInsufficientFunds
+	}
+
+	a.balance = newBalance
+
+	return nil
+}
+
+// GetBalance returns the balance as seen inside the overlay
+func (a *overlayAccount) GetBalance() *big.Int {
+	return big.NewInt(0).Set(a.balance)
+}
+
+// ClaimDeveloperRewards resets the developer reward inside the overlay and returns the claimed value
+func (a *overlayAccount) ClaimDeveloperRewards(sender []byte) (*big.Int, error) {
+	if !bytes.Equal(sender, a.ownerAddress) {
+		return nil, ErrOperationNotPermitted
+	}
+
+	claimed := a.developerReward
+	a.developerReward = big.NewInt(0)
+
+	return claimed, nil
+}
+
+// GetDeveloperReward returns the developer reward as seen inside the overlay
+func (a *overlayAccount) GetDeveloperReward() *big.Int {
+	return big.NewInt(0).Set(a.developerReward)
+}
+
+// ChangeOwnerAddress changes the owner inside the overlay
+func (a *overlayAccount) ChangeOwnerAddress(sender []byte, newAddress []byte) error {
+	if !bytes.Equal(sender, a.ownerAddress) {
+		return ErrOperationNotPermitted
+	}
+	if len(newAddress) != len(a.AddressBytes()) {
+		return ErrInvalidAddressLength
+	}
+
+	a.ownerAddress = newAddress
+
+	return nil
+}
+
+// SetOwnerAddress sets the owner inside the overlay
+func (a *overlayAccount) SetOwnerAddress(address []byte) {
+	a.ownerAddress = address
+}
+
+// GetOwnerAddress returns the owner as seen inside the overlay
+func (a *overlayAccount) GetOwnerAddress() []byte {
+	return a.ownerAddress
+}
+
+// SetUserName sets the user name inside the overlay
+func (a *overlayAccount) SetUserName(userName []byte) {
+	a.userName = append([]byte{}, userName...)
+}
+
+// GetUserName returns the user name as seen inside the overlay
+func (a *overlayAccount) GetUserName() []byte {
+	return a.userName
+}
+
+// storageChanges returns the written values which differ from the ones of the wrapped account, in write order
+func (a *overlayAccount) storageChanges() ([]*StorageChange, error) {
+	changes := make([]*StorageChange, 0)
+	for _, key := range a.storageKeys {
+		oldValue, err := a.original.AccountDataHandler().RetrieveValue(key)
+		if err != nil {
+			return nil, err
+		}
+
+		newValue := a.storage[string(key)]
+		if bytes.Equal(oldValue, newValue) {
+			continue
+		}
+
+		changes = append(changes, &StorageChange{
+			Address:  a.AddressBytes(),
+			Key:      key,
+			OldValue: oldValue,
+			NewValue: newValue,
+		})
+	}
+
+	return changes, nil
+}
+
+// balanceChange returns the change of the balance, nil if the balance was not changed
+func (a *overlayAccount) balanceChange() *BalanceChange {
+	oldBalance := copyOrZero(a.original.GetBalance())
+	if oldBalance.Cmp(a.balance) == 0 {
+		return nil
+	}
+
+	return &BalanceChange{
+		Address:    a.AddressBytes(),
+		OldBalance: oldBalance,
+		NewBalance: a.GetBalance(),
+	}
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (a *overlayAccount) IsInterfaceNil() bool {
+	return a == nil
+}
+
+func copyOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+
+	return big.NewInt(0).Set(value)
+}